@@ -10,7 +10,13 @@ func main() {
 	defer container.Router.Close()
 	defer container.Telemetry.Shutdown(context.Background())
 	defer container.SessionStore.Close()
-	if err := container.Router.Run(context.Background()); err != nil {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go container.Scheduler.Run(ctx)
+
+	if err := container.Router.Run(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	dependency_injection "main/internal/Infrastructure/DependencyInjection"
+)
+
+func main() {
+	container := dependency_injection.GetContainer()
+	defer container.Router.Close()
+	defer container.Telemetry.Shutdown(context.Background())
+	defer container.SessionStore.Close()
+
+	indexed, err := container.PostIndexer.Reindex(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	slog.Info("Post search index rebuilt", "posts", indexed)
+}
@@ -0,0 +1,8 @@
+ALTER TABLE posts ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'published';
+ALTER TABLE posts ADD COLUMN published_at TIMESTAMPTZ;
+
+UPDATE posts SET published_at = created_at;
+
+ALTER TABLE posts ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX idx_posts_status ON posts(status);
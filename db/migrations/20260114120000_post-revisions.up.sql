@@ -0,0 +1,14 @@
+CREATE TABLE post_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    post_id UUID NOT NULL,
+    revision INTEGER NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    CONSTRAINT fk_post_revisions_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    CONSTRAINT uq_post_revisions_post_id_revision UNIQUE (post_id, revision)
+);
+
+INSERT INTO post_revisions (created_at, post_id, revision, slug, title, content)
+SELECT updated_at, id, 1, slug, title, content FROM posts;
@@ -0,0 +1,15 @@
+CREATE TABLE comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    parent_id UUID,
+    content TEXT NOT NULL,
+    CONSTRAINT fk_comments_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_author_id FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent_id FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_comments_post_id_created_at ON comments(post_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX idx_comments_parent_id ON comments(parent_id);
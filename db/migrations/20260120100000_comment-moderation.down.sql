@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS moderation_tokens;
+
+DROP TABLE IF EXISTS moderation_labels;
+
+DROP INDEX IF EXISTS idx_comments_status;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS status;
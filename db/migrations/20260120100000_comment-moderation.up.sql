@@ -0,0 +1,16 @@
+ALTER TABLE comments ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'pending';
+UPDATE comments SET status = 'approved';
+
+CREATE INDEX idx_comments_status ON comments(status);
+
+CREATE TABLE moderation_labels (
+    label VARCHAR(16) PRIMARY KEY,
+    documents BIGINT NOT NULL DEFAULT 0,
+    tokens BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE moderation_tokens (
+    token VARCHAR(64) PRIMARY KEY,
+    approved BIGINT NOT NULL DEFAULT 0,
+    rejected BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,8 @@
+CREATE TABLE slug_history (
+    slug VARCHAR(255) PRIMARY KEY,
+    post_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT fk_slug_history_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_slug_history_post_id ON slug_history (post_id);
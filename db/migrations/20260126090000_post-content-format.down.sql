@@ -0,0 +1,5 @@
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS content_format;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS toc;
+ALTER TABLE posts DROP COLUMN IF EXISTS content_html;
+ALTER TABLE posts DROP COLUMN IF EXISTS content_format;
@@ -0,0 +1,5 @@
+ALTER TABLE posts ADD COLUMN content_format VARCHAR(16) NOT NULL DEFAULT 'markdown';
+ALTER TABLE posts ADD COLUMN content_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN toc JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE post_revisions ADD COLUMN content_format VARCHAR(16) NOT NULL DEFAULT 'markdown';
@@ -0,0 +1,5 @@
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS excerpt;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS reading_time_minutes;
+ALTER TABLE posts DROP COLUMN IF EXISTS word_count;
+ALTER TABLE posts DROP COLUMN IF EXISTS excerpt;
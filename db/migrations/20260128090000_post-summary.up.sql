@@ -0,0 +1,13 @@
+ALTER TABLE posts ADD COLUMN excerpt TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN reading_time_minutes INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE post_revisions ADD COLUMN excerpt TEXT NOT NULL DEFAULT '';
+
+-- Approximation for existing posts; markup is counted as words and the
+-- excerpt is not cut at a sentence boundary. Saving a post recomputes both.
+UPDATE posts SET
+    word_count = coalesce(array_length(regexp_split_to_array(btrim(content), '\s+'), 1), 0),
+    excerpt = left(regexp_replace(btrim(content), '\s+', ' ', 'g'), 280);
+UPDATE posts SET reading_time_minutes = ceil(word_count / 200.0)::int;
+UPDATE post_revisions SET excerpt = left(regexp_replace(btrim(content), '\s+', ' ', 'g'), 280);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS media_variants;
+
+ALTER TABLE media DROP COLUMN IF EXISTS height;
+ALTER TABLE media DROP COLUMN IF EXISTS width;
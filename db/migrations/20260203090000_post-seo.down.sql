@@ -0,0 +1,6 @@
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS fk_posts_cover_media_id;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS canonical_url;
+ALTER TABLE posts DROP COLUMN IF EXISTS meta_description;
+ALTER TABLE posts DROP COLUMN IF EXISTS seo_title;
+ALTER TABLE posts DROP COLUMN IF EXISTS cover_media_id;
@@ -0,0 +1,6 @@
+ALTER TABLE posts ADD COLUMN cover_media_id UUID;
+ALTER TABLE posts ADD COLUMN seo_title TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN meta_description TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN canonical_url TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE posts ADD CONSTRAINT fk_posts_cover_media_id FOREIGN KEY (cover_media_id) REFERENCES media(id) ON DELETE SET NULL;
@@ -0,0 +1,23 @@
+CREATE TABLE series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    author_id UUID NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_series_author_id FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_series_author_id ON series(author_id);
+
+-- A post is part of at most one series, so it has a single previous and next
+-- post to link to.
+CREATE TABLE series_posts (
+    series_id UUID NOT NULL,
+    post_id UUID NOT NULL UNIQUE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (series_id, post_id),
+    CONSTRAINT uq_series_posts_series_id_position UNIQUE (series_id, position),
+    CONSTRAINT fk_series_posts_series_id FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    CONSTRAINT fk_series_posts_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
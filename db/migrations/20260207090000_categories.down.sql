@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_posts_category_id;
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS fk_posts_category_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS categories;
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
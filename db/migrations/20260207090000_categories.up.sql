@@ -0,0 +1,23 @@
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- path lists the ids of the category's ancestors and its own, e.g.
+-- /<root id>/<child id>/, so the descendants of a category are the rows
+-- whose path starts with its path.
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    parent_id UUID,
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    path TEXT NOT NULL,
+    CONSTRAINT uq_categories_slug UNIQUE (slug),
+    CONSTRAINT uq_categories_path UNIQUE (path),
+    CONSTRAINT fk_categories_parent_id FOREIGN KEY (parent_id) REFERENCES categories(id) ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_categories_path ON categories(path text_pattern_ops);
+
+ALTER TABLE posts ADD COLUMN category_id UUID;
+ALTER TABLE posts ADD CONSTRAINT fk_posts_category_id FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL;
+CREATE INDEX idx_posts_category_id ON posts(category_id);
@@ -0,0 +1,14 @@
+-- The author of a post is not listed here, collaborators are the users
+-- invited to work on the post with them.
+CREATE TABLE post_collaborators (
+    post_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (post_id, user_id),
+    CONSTRAINT chk_post_collaborators_role CHECK (role IN ('co-author', 'editor', 'reviewer')),
+    CONSTRAINT fk_post_collaborators_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_post_collaborators_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_collaborators_user_id ON post_collaborators(user_id);
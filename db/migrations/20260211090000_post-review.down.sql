@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_posts_review_queue;
+
+UPDATE posts SET status = 'draft' WHERE status IN ('in_review', 'changes_requested', 'approved');
+
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS fk_posts_reviewer_id;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS review_notes;
+ALTER TABLE posts DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS reviewer_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS submitted_at;
@@ -0,0 +1,12 @@
+ALTER TABLE posts ADD COLUMN submitted_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN reviewer_id UUID;
+ALTER TABLE posts ADD COLUMN reviewed_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN review_notes TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE posts ADD CONSTRAINT fk_posts_reviewer_id FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE SET NULL;
+
+-- The scheduler only publishes approved posts from now on. Drafts that were
+-- scheduled before the review existed keep their schedule.
+UPDATE posts SET status = 'approved' WHERE status = 'draft' AND publish_at IS NOT NULL;
+
+CREATE INDEX idx_posts_review_queue ON posts(submitted_at) WHERE status = 'in_review';
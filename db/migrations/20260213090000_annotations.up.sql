@@ -0,0 +1,22 @@
+-- Review annotations on passages of a post, separate from reader comments.
+-- Offsets count characters of posts.content, end_offset exclusive.
+CREATE TABLE annotations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    quote TEXT NOT NULL,
+    body TEXT NOT NULL,
+    detached BOOLEAN NOT NULL DEFAULT FALSE,
+    resolved_at TIMESTAMPTZ,
+    resolved_by UUID,
+    CONSTRAINT chk_annotations_range CHECK (start_offset >= 0 AND end_offset > start_offset),
+    CONSTRAINT fk_annotations_post_id FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_annotations_author_id FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_annotations_resolved_by FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_annotations_post_id ON annotations(post_id, start_offset);
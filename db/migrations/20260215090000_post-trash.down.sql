@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_posts_deleted_at;
+
+-- Trashed posts would reappear without the column, so they are purged.
+DELETE FROM posts WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE posts ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- Serves the trash listings and the retention job. Live posts are filtered
+-- by deleted_at IS NULL, which needs no index of its own.
+CREATE INDEX idx_posts_deleted_at ON posts(deleted_at) WHERE deleted_at IS NOT NULL;
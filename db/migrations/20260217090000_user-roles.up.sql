@@ -0,0 +1,19 @@
+-- Site wide roles of the users. The permissions of each role are defined in
+-- the code, in entity.Role.
+CREATE TABLE user_roles (
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    granted_at TIMESTAMPTZ NOT NULL,
+    granted_by UUID,
+    PRIMARY KEY (user_id, role),
+    CONSTRAINT chk_user_roles_role CHECK (role IN ('admin', 'editor', 'author', 'reader')),
+    CONSTRAINT fk_user_roles_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_roles_granted_by FOREIGN KEY (granted_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Every existing user could write posts so far and keeps doing so; admins
+-- keep managing categories.
+INSERT INTO user_roles (user_id, role, granted_at) SELECT id, 'author', NOW() FROM users;
+INSERT INTO user_roles (user_id, role, granted_at) SELECT id, 'admin', NOW() FROM users WHERE is_admin;
+
+ALTER TABLE users DROP COLUMN is_admin;
@@ -0,0 +1,38 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var ErrNoActor = errors.New("command carries no acting user")
+
+// Actor is whom a command is sent on behalf of. It travels from the sender
+// to the command handler in the context, and in between in the signed
+// message metadata.
+type Actor struct {
+	UserId uuid.UUID
+	// System is set for the jobs of the site, e.g. the scheduler, which
+	// act on every post.
+	System bool
+}
+
+// SystemActor is the actor of the jobs of the site.
+var SystemActor = Actor{System: true}
+
+func NewUserActor(userId uuid.UUID) Actor {
+	return Actor{UserId: userId}
+}
+
+type actorContextKey struct{}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
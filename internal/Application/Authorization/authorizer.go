@@ -0,0 +1,175 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Authorizer is the access policy of the site. Permissions come from the
+// roles of a user as defined by entity.Role. Command handlers consult it
+// for the Actor in the context, so that commands sent past the HTTP handlers
+// are checked too. HTTP handlers use Can with the user they already loaded.
+// The system actor is allowed everything.
+type Authorizer struct {
+	UserRepository repository.UserRepository
+}
+
+// Authorize returns ErrPermissionDenied unless the command is sent by the
+// given user, e.g. the author of a new post, and one of their roles grants
+// the permission.
+func (a Authorizer) Authorize(ctx context.Context, userId uuid.UUID, permission entity.Permission) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System {
+		return nil
+	}
+	if actor.UserId != userId {
+		return ErrPermissionDenied
+	}
+
+	return a.authorizeUser(ctx, userId, permission)
+}
+
+// AuthorizeActor returns ErrPermissionDenied unless one of the roles of the
+// actor grants the permission. It checks commands that do not name the user
+// sending them, e.g. the changes to the category tree.
+func (a Authorizer) AuthorizeActor(ctx context.Context, permission entity.Permission) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System {
+		return nil
+	}
+
+	return a.authorizeUser(ctx, actor.UserId, permission)
+}
+
+// AuthorizeOwner returns ErrPermissionDenied unless the command is sent by
+// the given user, e.g. the author of a comment or a series or the user
+// resolving an annotation.
+func (a Authorizer) AuthorizeOwner(ctx context.Context, ownerId uuid.UUID) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System || actor.UserId == ownerId {
+		return nil
+	}
+
+	return ErrPermissionDenied
+}
+
+// AuthorizePost returns ErrPermissionDenied unless the actor is the author
+// of the post or one of its collaborators whose role is granted access.
+// Users allowed to edit any post are let through wherever a co-author would
+// be.
+func (a Authorizer) AuthorizePost(ctx context.Context, post entity.Post, access func(role entity.CollaboratorRole) bool) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System || actor.UserId == post.AuthorId {
+		return nil
+	}
+	for _, collaborator := range post.Collaborators {
+		if collaborator.UserId == actor.UserId && access(collaborator.Role) {
+			return nil
+		}
+	}
+	if !access(entity.CollaboratorRoleCoAuthor) {
+		return ErrPermissionDenied
+	}
+
+	return a.authorizeUser(ctx, actor.UserId, entity.PermissionEditAnyPost)
+}
+
+// AuthorizeReview returns ErrPermissionDenied unless the command is sent by
+// the reviewer, who may review the post: users allowed to review any post
+// and the collaborators whose role allows reviewing may. Its authors only
+// may when they are allowed to review any post, so that an admin or an
+// editor running a blog on their own can publish.
+func (a Authorizer) AuthorizeReview(ctx context.Context, post entity.Post, reviewerId uuid.UUID) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System {
+		return nil
+	}
+	if actor.UserId != reviewerId {
+		return ErrPermissionDenied
+	}
+	if slices.Contains(post.AuthorIds(), reviewerId) {
+		return a.authorizeUser(ctx, reviewerId, entity.PermissionReviewAnyPost)
+	}
+	for _, collaborator := range post.Collaborators {
+		if collaborator.UserId == reviewerId && collaborator.Role.CanReview() {
+			return nil
+		}
+	}
+
+	return a.authorizeUser(ctx, reviewerId, entity.PermissionReviewAnyPost)
+}
+
+// AuthorizeModeration returns ErrPermissionDenied unless the actor wrote the
+// post, which makes them the moderator of its comments, or may moderate the
+// comments on every post.
+func (a Authorizer) AuthorizeModeration(ctx context.Context, post entity.Post) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System || actor.UserId == post.AuthorId {
+		return nil
+	}
+
+	return a.authorizeUser(ctx, actor.UserId, entity.PermissionModerateComments)
+}
+
+// AuthorizeAnnotation returns ErrPermissionDenied unless the actor takes part
+// in the review of the post: its authors, every collaborator and users
+// allowed to review any post do.
+func (a Authorizer) AuthorizeAnnotation(ctx context.Context, post entity.Post) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrNoActor
+	}
+	if actor.System || actor.UserId == post.AuthorId {
+		return nil
+	}
+	for _, collaborator := range post.Collaborators {
+		if collaborator.UserId == actor.UserId {
+			return nil
+		}
+	}
+
+	return a.authorizeUser(ctx, actor.UserId, entity.PermissionReviewAnyPost)
+}
+
+func (a Authorizer) authorizeUser(ctx context.Context, userId uuid.UUID, permission entity.Permission) error {
+	user, err := a.UserRepository.FindByID(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if !user.Can(permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// Can tells whether one of the roles of the user grants the permission.
+func Can(user view.UserView, permission entity.Permission) bool {
+	return slices.Contains(user.Permissions, string(permission))
+}
@@ -0,0 +1,17 @@
+package command
+
+import "github.com/google/uuid"
+
+type createAnnotationCommand struct {
+	Id     uuid.UUID `json:"id"`
+	PostId uuid.UUID `json:"post_id"`
+	Author uuid.UUID `json:"author"`
+	Start  int       `json:"start"`
+	End    int       `json:"end"`
+	Quote  string    `json:"quote"`
+	Body   string    `json:"body"`
+}
+
+func NewCreateAnnotationCommand(id uuid.UUID, postId uuid.UUID, author uuid.UUID, start int, end int, quote string, body string) createAnnotationCommand {
+	return createAnnotationCommand{Id: id, PostId: postId, Author: author, Start: start, End: end, Quote: quote, Body: body}
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type CreateAnnotationCommandHandler struct {
+	EventBus             *cqrs.EventBus
+	PostRepository       repository.PostRepository
+	AnnotationRepository repository.AnnotationRepository
+	Authorizer           authorization.Authorizer
+}
+
+func (h CreateAnnotationCommandHandler) Handle(ctx context.Context, command *createAnnotationCommand) error {
+	if err := h.Authorizer.AuthorizeOwner(ctx, command.Author); err != nil {
+		return err
+	}
+
+	if _, err := h.AnnotationRepository.FindByID(ctx, command.Id); err == nil {
+		return nil
+	}
+
+	post, err := h.PostRepository.FindByID(ctx, command.PostId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeAnnotation(ctx, post); err != nil {
+		return err
+	}
+
+	annotation, err := entity.NewAnnotation(
+		command.Id,
+		time.Now(),
+		post.ID,
+		command.Author,
+		post.Content,
+		command.Start,
+		command.End,
+		command.Quote,
+		command.Body,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = h.AnnotationRepository.Save(ctx, annotation)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewAnnotationWasCreated(
+			annotation.ID,
+			annotation.CreatedAt,
+			annotation.PostId,
+			annotation.AuthorId,
+			annotation.Start,
+			annotation.End,
+			annotation.Quote,
+			annotation.Body,
+		),
+	)
+}
@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type resolveAnnotationCommand struct {
+	Id         uuid.UUID `json:"id"`
+	ResolvedBy uuid.UUID `json:"resolved_by"`
+}
+
+func NewResolveAnnotationCommand(id uuid.UUID, resolvedBy uuid.UUID) resolveAnnotationCommand {
+	return resolveAnnotationCommand{Id: id, ResolvedBy: resolvedBy}
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type ResolveAnnotationCommandHandler struct {
+	EventBus             *cqrs.EventBus
+	PostRepository       repository.PostRepository
+	AnnotationRepository repository.AnnotationRepository
+	Authorizer           authorization.Authorizer
+}
+
+func (h ResolveAnnotationCommandHandler) Handle(ctx context.Context, command *resolveAnnotationCommand) error {
+	if err := h.Authorizer.AuthorizeOwner(ctx, command.ResolvedBy); err != nil {
+		return err
+	}
+
+	annotation, err := h.AnnotationRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	post, err := h.PostRepository.FindByID(ctx, annotation.PostId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeAnnotation(ctx, post); err != nil {
+		return err
+	}
+
+	if annotation.IsResolved() {
+		return nil
+	}
+
+	annotation.Resolve(command.ResolvedBy, time.Now())
+
+	err = h.AnnotationRepository.Update(ctx, annotation)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewAnnotationWasResolved(
+			annotation.ID,
+			*annotation.ResolvedAt,
+			annotation.PostId,
+			command.ResolvedBy,
+		),
+	)
+}
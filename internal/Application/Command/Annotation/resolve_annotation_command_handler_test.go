@@ -0,0 +1,219 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockAnnotationRepository struct {
+	annotation entity.Annotation
+	updated    []entity.Annotation
+}
+
+func (m *mockAnnotationRepository) Save(ctx context.Context, annotation entity.Annotation) error {
+	return nil
+}
+
+func (m *mockAnnotationRepository) Update(ctx context.Context, annotation entity.Annotation) error {
+	m.updated = append(m.updated, annotation)
+	return nil
+}
+
+func (m *mockAnnotationRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Annotation, error) {
+	if id != m.annotation.ID {
+		return entity.Annotation{}, errors.New("record not found")
+	}
+	return m.annotation, nil
+}
+
+func (m *mockAnnotationRepository) FindAllByPostId(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+	return nil, nil
+}
+
+type mockPostRepositoryAnnotation struct {
+	post entity.Post
+}
+
+func (m *mockPostRepositoryAnnotation) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryAnnotation) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryAnnotation) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if id != m.post.ID {
+		return entity.Post{}, errors.New("record not found")
+	}
+	return m.post, nil
+}
+
+func (m *mockPostRepositoryAnnotation) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryAnnotation) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryAnnotation) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryAnnotation) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryAnnotation) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockUserRepositoryAnnotation struct {
+	user entity.User
+}
+
+func (m *mockUserRepositoryAnnotation) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryAnnotation) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	if id != m.user.ID {
+		return entity.User{}, errors.New("record not found")
+	}
+	return m.user, nil
+}
+
+func (m *mockUserRepositoryAnnotation) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
+type ResolveAnnotationCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         ResolveAnnotationCommandHandler
+	MockRepository  *mockAnnotationRepository
+	MockPosts       *mockPostRepositoryAnnotation
+	MockUsers       *mockUserRepositoryAnnotation
+	Reviewer        uuid.UUID
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *ResolveAnnotationCommandHandlerTestSuite) SetupTest() {
+	s.Reviewer = uuid.New()
+	post := entity.Post{
+		ID:            uuid.New(),
+		AuthorId:      uuid.New(),
+		Content:       "Hello world",
+		Collaborators: []entity.PostCollaborator{{UserId: s.Reviewer, Role: entity.CollaboratorRoleReviewer}},
+	}
+	s.MockPosts = &mockPostRepositoryAnnotation{post: post}
+	s.MockUsers = &mockUserRepositoryAnnotation{}
+	annotation, err := entity.NewAnnotation(uuid.New(), time.Now(), post.ID, s.Reviewer, post.Content, 0, 5, "Hello", "Say hi instead")
+	if err != nil {
+		panic(err)
+	}
+	s.MockRepository = &mockAnnotationRepository{annotation: annotation}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = ResolveAnnotationCommandHandler{
+		EventBus:             s.EventBus,
+		PostRepository:       s.MockPosts,
+		AnnotationRepository: s.MockRepository,
+		Authorizer:           authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *ResolveAnnotationCommandHandlerTestSuite) TestHandle() {
+	resolvedBy := s.Reviewer
+
+	command := NewResolveAnnotationCommand(s.MockRepository.annotation.ID, resolvedBy)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(s.Reviewer)), &command)
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), s.MockRepository.updated, 1) {
+		resolved := s.MockRepository.updated[0]
+		assert.True(s.T(), resolved.IsResolved())
+		assert.Equal(s.T(), &resolvedBy, resolved.ResolvedBy)
+	}
+	if assert.Len(s.T(), s.PublishedEvents, 1) {
+		resolvedEvent, ok := s.PublishedEvents[0].(event.AnnotationWasResolved)
+		assert.True(s.T(), ok)
+		assert.Equal(s.T(), s.MockRepository.annotation.ID, resolvedEvent.ID)
+		assert.Equal(s.T(), s.MockRepository.annotation.PostId, resolvedEvent.PostId)
+		assert.Equal(s.T(), resolvedBy, resolvedEvent.ResolvedBy)
+	}
+}
+
+func (s *ResolveAnnotationCommandHandlerTestSuite) TestHandleAlreadyResolved() {
+	s.MockRepository.annotation.Resolve(uuid.New(), time.Now())
+
+	command := NewResolveAnnotationCommand(s.MockRepository.annotation.ID, s.Reviewer)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(s.Reviewer)), &command)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *ResolveAnnotationCommandHandlerTestSuite) TestHandleNotFound() {
+	command := NewResolveAnnotationCommand(uuid.New(), s.Reviewer)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(s.Reviewer)), &command)
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func (s *ResolveAnnotationCommandHandlerTestSuite) TestHandleNotAuthorized() {
+	reader := uuid.New()
+	s.MockUsers.user = entity.User{ID: reader, Roles: []entity.UserRole{{UserId: reader, Role: entity.RoleReader}}}
+	command := NewResolveAnnotationCommand(s.MockRepository.annotation.ID, reader)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(reader)), &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestResolveAnnotationCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ResolveAnnotationCommandHandlerTestSuite))
+}
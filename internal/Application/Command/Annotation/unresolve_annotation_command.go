@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type unresolveAnnotationCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewUnresolveAnnotationCommand(id uuid.UUID) unresolveAnnotationCommand {
+	return unresolveAnnotationCommand{Id: id}
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type UnresolveAnnotationCommandHandler struct {
+	EventBus             *cqrs.EventBus
+	PostRepository       repository.PostRepository
+	AnnotationRepository repository.AnnotationRepository
+	Authorizer           authorization.Authorizer
+}
+
+func (h UnresolveAnnotationCommandHandler) Handle(ctx context.Context, command *unresolveAnnotationCommand) error {
+	annotation, err := h.AnnotationRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	post, err := h.PostRepository.FindByID(ctx, annotation.PostId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeAnnotation(ctx, post); err != nil {
+		return err
+	}
+
+	if !annotation.IsResolved() {
+		return nil
+	}
+
+	annotation.Unresolve(time.Now())
+
+	err = h.AnnotationRepository.Update(ctx, annotation)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewAnnotationWasUnresolved(
+			annotation.ID,
+			annotation.UpdatedAt,
+			annotation.PostId,
+		),
+	)
+}
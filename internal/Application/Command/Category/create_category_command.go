@@ -0,0 +1,14 @@
+package command
+
+import "github.com/google/uuid"
+
+type createCategoryCommand struct {
+	Id       uuid.UUID  `json:"id"`
+	ParentId *uuid.UUID `json:"parent_id"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+}
+
+func NewCreateCategoryCommand(id uuid.UUID, parentId *uuid.UUID, name string, slug string) createCategoryCommand {
+	return createCategoryCommand{Id: id, ParentId: parentId, Name: name, Slug: slug}
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type CreateCategoryCommandHandler struct {
+	EventBus           *cqrs.EventBus
+	CategoryRepository repository.CategoryRepository
+	Authorizer         authorization.Authorizer
+}
+
+func (h CreateCategoryCommandHandler) Handle(ctx context.Context, command *createCategoryCommand) error {
+	if err := h.Authorizer.AuthorizeActor(ctx, entity.PermissionManageCategories); err != nil {
+		return err
+	}
+
+	if _, err := h.CategoryRepository.FindByID(ctx, command.Id); err == nil {
+		return nil
+	}
+
+	var parent *entity.Category
+	if command.ParentId != nil {
+		found, err := h.CategoryRepository.FindByID(ctx, *command.ParentId)
+		if err != nil {
+			return err
+		}
+		parent = &found
+	}
+
+	category := entity.NewCategory(command.Id, time.Now(), parent, command.Name, command.Slug)
+
+	err := h.CategoryRepository.Save(ctx, category)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCategoryWasCreated(
+			category.ID,
+			category.CreatedAt,
+			category.ParentId,
+			category.Name,
+			category.Slug,
+			category.Path,
+		),
+	)
+}
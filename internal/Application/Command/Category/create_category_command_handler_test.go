@@ -0,0 +1,214 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCategoryRepositoryCreate struct {
+	categories map[uuid.UUID]entity.Category
+	saved      []entity.Category
+	updated    []entity.Category
+}
+
+func (m *mockCategoryRepositoryCreate) Save(ctx context.Context, category entity.Category) error {
+	m.saved = append(m.saved, category)
+	return nil
+}
+
+func (m *mockCategoryRepositoryCreate) Update(ctx context.Context, category entity.Category) error {
+	m.updated = append(m.updated, category)
+	return nil
+}
+
+func (m *mockCategoryRepositoryCreate) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCategoryRepositoryCreate) FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error) {
+	category, ok := m.categories[id]
+	if !ok {
+		return entity.Category{}, errors.New("record not found")
+	}
+	return category, nil
+}
+
+func (m *mockCategoryRepositoryCreate) FindAll(ctx context.Context) ([]entity.Category, error) {
+	return nil, nil
+}
+
+func (m *mockCategoryRepositoryCreate) FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error) {
+	return nil, nil
+}
+
+type mockUserRepositoryCategory struct {
+	users map[uuid.UUID]entity.User
+}
+
+func (m *mockUserRepositoryCategory) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryCategory) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return entity.User{}, errors.New("record not found")
+	}
+	return user, nil
+}
+
+func (m *mockUserRepositoryCategory) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
+type CreateCategoryCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         CreateCategoryCommandHandler
+	MockRepository  *mockCategoryRepositoryCreate
+	MockUsers       *mockUserRepositoryCategory
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+	Root            entity.Category
+	Child           entity.Category
+	Admin           uuid.UUID
+	Author          uuid.UUID
+	AdminCtx        context.Context
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) SetupTest() {
+	s.Root = entity.NewCategory(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), time.Now(), nil, "Programming", "programming")
+	s.Child = entity.NewCategory(uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"), time.Now(), &s.Root, "Go", "go")
+	s.MockRepository = &mockCategoryRepositoryCreate{categories: map[uuid.UUID]entity.Category{
+		s.Root.ID:  s.Root,
+		s.Child.ID: s.Child,
+	}}
+	s.Admin = uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	s.Author = uuid.MustParse("323e4567-e89b-12d3-a456-426614174001")
+	s.MockUsers = &mockUserRepositoryCategory{users: map[uuid.UUID]entity.User{
+		s.Admin:  {ID: s.Admin, Roles: []entity.UserRole{{UserId: s.Admin, Role: entity.RoleAdmin}}},
+		s.Author: {ID: s.Author, Roles: []entity.UserRole{{UserId: s.Author, Role: entity.RoleAuthor}}},
+	}}
+	s.AdminCtx = authorization.WithActor(context.Background(), authorization.NewUserActor(s.Admin))
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = CreateCategoryCommandHandler{
+		EventBus:           s.EventBus,
+		CategoryRepository: s.MockRepository,
+		Authorizer:         authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleRoot() {
+	id := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	command := NewCreateCategoryCommand(id, nil, "Design", "design")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockRepository.saved, 1)
+	assert.Nil(s.T(), s.MockRepository.saved[0].ParentId)
+	assert.Equal(s.T(), "/"+id.String()+"/", s.MockRepository.saved[0].Path)
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	createdEvent, ok := s.PublishedEvents[0].(event.CategoryWasCreated)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), id, createdEvent.ID)
+	assert.Equal(s.T(), "design", createdEvent.Slug)
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleBelowParent() {
+	id := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	command := NewCreateCategoryCommand(id, &s.Child.ID, "Concurrency", "concurrency")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockRepository.saved, 1)
+	saved := s.MockRepository.saved[0]
+	assert.Equal(s.T(), &s.Child.ID, saved.ParentId)
+	assert.Equal(s.T(), "/"+s.Root.ID.String()+"/"+s.Child.ID.String()+"/"+id.String()+"/", saved.Path)
+	assert.Equal(s.T(), []uuid.UUID{s.Root.ID, s.Child.ID}, saved.AncestorIds())
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleParentNotFound() {
+	parentId := uuid.New()
+	command := NewCreateCategoryCommand(uuid.New(), &parentId, "Concurrency", "concurrency")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.Error(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleAlreadyExists() {
+	command := NewCreateCategoryCommand(s.Root.ID, nil, "Programming", "programming")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleNotAuthorized() {
+	command := NewCreateCategoryCommand(uuid.New(), nil, "Design", "design")
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(s.Author))
+
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *CreateCategoryCommandHandlerTestSuite) TestHandleWithoutActor() {
+	command := NewCreateCategoryCommand(uuid.New(), nil, "Design", "design")
+
+	err := s.Handler.Handle(context.Background(), &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrNoActor)
+	assert.Empty(s.T(), s.MockRepository.saved)
+}
+
+func TestCreateCategoryCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateCategoryCommandHandlerTestSuite))
+}
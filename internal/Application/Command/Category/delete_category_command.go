@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type deleteCategoryCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewDeleteCategoryCommand(id uuid.UUID) deleteCategoryCommand {
+	return deleteCategoryCommand{Id: id}
+}
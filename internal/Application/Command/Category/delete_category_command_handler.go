@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type DeleteCategoryCommandHandler struct {
+	EventBus           *cqrs.EventBus
+	CategoryRepository repository.CategoryRepository
+	Authorizer         authorization.Authorizer
+}
+
+func (h DeleteCategoryCommandHandler) Handle(ctx context.Context, command *deleteCategoryCommand) error {
+	if err := h.Authorizer.AuthorizeActor(ctx, entity.PermissionManageCategories); err != nil {
+		return err
+	}
+
+	category, err := h.CategoryRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	err = h.CategoryRepository.Delete(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCategoryWasDeleted(
+			category.ID,
+			time.Now(),
+			category.Slug,
+		),
+	)
+}
@@ -0,0 +1,14 @@
+package command
+
+import "github.com/google/uuid"
+
+type updateCategoryCommand struct {
+	Id       uuid.UUID  `json:"id"`
+	ParentId *uuid.UUID `json:"parent_id"`
+	Name     string     `json:"name"`
+	Slug     string     `json:"slug"`
+}
+
+func NewUpdateCategoryCommand(id uuid.UUID, parentId *uuid.UUID, name string, slug string) updateCategoryCommand {
+	return updateCategoryCommand{Id: id, ParentId: parentId, Name: name, Slug: slug}
+}
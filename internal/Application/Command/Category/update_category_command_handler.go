@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type UpdateCategoryCommandHandler struct {
+	EventBus           *cqrs.EventBus
+	CategoryRepository repository.CategoryRepository
+	Authorizer         authorization.Authorizer
+}
+
+// Handle renames the category and moves it, together with its subtree, below
+// the new parent. A nil parent makes it a root category.
+func (h UpdateCategoryCommandHandler) Handle(ctx context.Context, command *updateCategoryCommand) error {
+	if err := h.Authorizer.AuthorizeActor(ctx, entity.PermissionManageCategories); err != nil {
+		return err
+	}
+
+	category, err := h.CategoryRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	var parent *entity.Category
+	if command.ParentId != nil {
+		found, err := h.CategoryRepository.FindByID(ctx, *command.ParentId)
+		if err != nil {
+			return err
+		}
+		parent = &found
+	}
+
+	now := time.Now()
+	category.Rename(command.Name, command.Slug, now)
+	if err := category.MoveTo(parent, now); err != nil {
+		return err
+	}
+
+	err = h.CategoryRepository.Update(ctx, category)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCategoryWasUpdated(
+			category.ID,
+			category.UpdatedAt,
+			category.ParentId,
+			category.Name,
+			category.Slug,
+			category.Path,
+		),
+	)
+}
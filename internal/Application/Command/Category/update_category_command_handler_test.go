@@ -0,0 +1,188 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCategoryRepositoryUpdate struct {
+	categories map[uuid.UUID]entity.Category
+	saved      []entity.Category
+	updated    []entity.Category
+}
+
+func (m *mockCategoryRepositoryUpdate) Save(ctx context.Context, category entity.Category) error {
+	m.saved = append(m.saved, category)
+	return nil
+}
+
+func (m *mockCategoryRepositoryUpdate) Update(ctx context.Context, category entity.Category) error {
+	m.updated = append(m.updated, category)
+	return nil
+}
+
+func (m *mockCategoryRepositoryUpdate) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCategoryRepositoryUpdate) FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error) {
+	category, ok := m.categories[id]
+	if !ok {
+		return entity.Category{}, errors.New("record not found")
+	}
+	return category, nil
+}
+
+func (m *mockCategoryRepositoryUpdate) FindAll(ctx context.Context) ([]entity.Category, error) {
+	return nil, nil
+}
+
+func (m *mockCategoryRepositoryUpdate) FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error) {
+	return nil, nil
+}
+
+type UpdateCategoryCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         UpdateCategoryCommandHandler
+	MockRepository  *mockCategoryRepositoryUpdate
+	MockUsers       *mockUserRepositoryCategory
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+	Root            entity.Category
+	Child           entity.Category
+	Admin           uuid.UUID
+	Author          uuid.UUID
+	AdminCtx        context.Context
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) SetupTest() {
+	s.Root = entity.NewCategory(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), time.Now(), nil, "Programming", "programming")
+	s.Child = entity.NewCategory(uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"), time.Now(), &s.Root, "Go", "go")
+	s.MockRepository = &mockCategoryRepositoryUpdate{categories: map[uuid.UUID]entity.Category{
+		s.Root.ID:  s.Root,
+		s.Child.ID: s.Child,
+	}}
+	s.Admin = uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	s.Author = uuid.MustParse("323e4567-e89b-12d3-a456-426614174001")
+	s.MockUsers = &mockUserRepositoryCategory{users: map[uuid.UUID]entity.User{
+		s.Admin:  {ID: s.Admin, Roles: []entity.UserRole{{UserId: s.Admin, Role: entity.RoleAdmin}}},
+		s.Author: {ID: s.Author, Roles: []entity.UserRole{{UserId: s.Author, Role: entity.RoleAuthor}}},
+	}}
+	s.AdminCtx = authorization.WithActor(context.Background(), authorization.NewUserActor(s.Admin))
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = UpdateCategoryCommandHandler{
+		EventBus:           s.EventBus,
+		CategoryRepository: s.MockRepository,
+		Authorizer:         authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleRename() {
+	command := NewUpdateCategoryCommand(s.Child.ID, &s.Root.ID, "Golang", "golang")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockRepository.updated, 1)
+	updated := s.MockRepository.updated[0]
+	assert.Equal(s.T(), "Golang", updated.Name)
+	assert.Equal(s.T(), "golang", updated.Slug)
+	assert.Equal(s.T(), s.Child.Path, updated.Path)
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	updatedEvent, ok := s.PublishedEvents[0].(event.CategoryWasUpdated)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "golang", updatedEvent.Slug)
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleMoveToRoot() {
+	command := NewUpdateCategoryCommand(s.Child.ID, nil, "Go", "go")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.NoError(s.T(), err)
+	updated := s.MockRepository.updated[0]
+	assert.Nil(s.T(), updated.ParentId)
+	assert.Equal(s.T(), "/"+s.Child.ID.String()+"/", updated.Path)
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleMoveBelowDescendant() {
+	command := NewUpdateCategoryCommand(s.Root.ID, &s.Child.ID, "Programming", "programming")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrCategoryCycle)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleMoveBelowItself() {
+	command := NewUpdateCategoryCommand(s.Child.ID, &s.Child.ID, "Go", "go")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrCategoryCycle)
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleNotFound() {
+	command := NewUpdateCategoryCommand(uuid.New(), nil, "Go", "go")
+
+	err := s.Handler.Handle(s.AdminCtx, &command)
+
+	assert.Error(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.updated)
+}
+
+func (s *UpdateCategoryCommandHandlerTestSuite) TestHandleNotAuthorized() {
+	command := NewUpdateCategoryCommand(s.Child.ID, nil, "Go", "go")
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(s.Author))
+
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestUpdateCategoryCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCategoryCommandHandlerTestSuite))
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type approveCommentCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewApproveCommentCommand(id uuid.UUID) approveCommentCommand {
+	return approveCommentCommand{Id: id}
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type ApproveCommentCommandHandler struct {
+	EventBus          *cqrs.EventBus
+	CommentRepository repository.CommentRepository
+	PostRepository    repository.PostRepository
+	Authorizer        authorization.Authorizer
+}
+
+func (h ApproveCommentCommandHandler) Handle(ctx context.Context, command *approveCommentCommand) error {
+	comment, err := h.CommentRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	post, err := h.PostRepository.FindByID(ctx, comment.PostId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeModeration(ctx, post); err != nil {
+		return err
+	}
+
+	if comment.IsApproved() {
+		return nil
+	}
+
+	comment.Approve(time.Now())
+
+	err = h.CommentRepository.Update(ctx, comment)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCommentWasApproved(
+			comment.ID,
+			comment.UpdatedAt,
+			comment.PostId,
+			comment.AuthorId,
+			comment.Content,
+		),
+	)
+}
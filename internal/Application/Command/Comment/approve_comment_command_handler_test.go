@@ -0,0 +1,283 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCommentRepositoryApprove struct {
+	updateFunc   func(ctx context.Context, comment entity.Comment) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Comment, error)
+}
+
+func (m *mockCommentRepositoryApprove) Save(ctx context.Context, comment entity.Comment) error {
+	return nil
+}
+
+func (m *mockCommentRepositoryApprove) Update(ctx context.Context, comment entity.Comment) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, comment)
+	}
+	return nil
+}
+
+func (m *mockCommentRepositoryApprove) FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Comment{}, errors.New("not implemented")
+}
+
+func (m *mockCommentRepositoryApprove) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCommentRepositoryApprove) FindThreadsByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	return repository.PaginatedResult[entity.Comment]{}, nil
+}
+
+func (m *mockCommentRepositoryApprove) FindAllPendingByPostAuthorId(ctx context.Context, authorId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	return repository.PaginatedResult[entity.Comment]{}, nil
+}
+
+type mockPostRepositoryComment struct {
+	post entity.Post
+}
+
+func (m *mockPostRepositoryComment) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryComment) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryComment) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if id != m.post.ID {
+		return entity.Post{}, errors.New("record not found")
+	}
+	return m.post, nil
+}
+
+func (m *mockPostRepositoryComment) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryComment) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryComment) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryComment) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryComment) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockUserRepositoryComment struct {
+	users map[uuid.UUID]entity.User
+}
+
+func (m *mockUserRepositoryComment) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryComment) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return entity.User{}, errors.New("record not found")
+	}
+	return user, nil
+}
+
+func (m *mockUserRepositoryComment) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
+func newUserWithRole(id uuid.UUID, role entity.Role) entity.User {
+	return entity.User{ID: id, Roles: []entity.UserRole{{UserId: id, Role: role}}}
+}
+
+type ApproveCommentCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         ApproveCommentCommandHandler
+	MockRepository  *mockCommentRepositoryApprove
+	MockPosts       *mockPostRepositoryComment
+	MockUsers       *mockUserRepositoryComment
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *ApproveCommentCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockCommentRepositoryApprove{}
+	s.MockPosts = &mockPostRepositoryComment{post: entity.Post{
+		ID:       uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+		AuthorId: uuid.MustParse("423e4567-e89b-12d3-a456-426614174000"),
+	}}
+	s.MockUsers = &mockUserRepositoryComment{users: map[uuid.UUID]entity.User{}}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = ApproveCommentCommandHandler{
+		EventBus:          s.EventBus,
+		CommentRepository: s.MockRepository,
+		PostRepository:    s.MockPosts,
+		Authorizer:        authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *ApproveCommentCommandHandlerTestSuite) TestHandle() {
+	testCommentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testPostID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	testPostAuthorID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	testEditorID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174001")
+	testReaderID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174002")
+	s.MockUsers.users[testEditorID] = newUserWithRole(testEditorID, entity.RoleEditor)
+	s.MockUsers.users[testReaderID] = newUserWithRole(testReaderID, entity.RoleReader)
+	newComment := func(status entity.CommentStatus) entity.Comment {
+		return entity.Comment{ID: testCommentID, PostId: testPostID, Content: "Nice post", Status: status}
+	}
+
+	tests := []struct {
+		name            string
+		existing        entity.Comment
+		actor           uuid.UUID
+		findErr         error
+		updateErr       error
+		expectedError   bool
+		expectedUpdate  bool
+		expectedApprove bool
+	}{
+		{
+			name:            "ApprovePending",
+			existing:        newComment(entity.CommentStatusPending),
+			expectedUpdate:  true,
+			expectedApprove: true,
+		},
+		{
+			name:            "ApproveRejected",
+			existing:        newComment(entity.CommentStatusRejected),
+			expectedUpdate:  true,
+			expectedApprove: true,
+		},
+		{
+			name:            "ApproveAsModerator",
+			existing:        newComment(entity.CommentStatusPending),
+			actor:           testEditorID,
+			expectedUpdate:  true,
+			expectedApprove: true,
+		},
+		{
+			name:          "NotAuthorized",
+			existing:      newComment(entity.CommentStatusPending),
+			actor:         testReaderID,
+			expectedError: true,
+		},
+		{
+			name:     "AlreadyApproved",
+			existing: newComment(entity.CommentStatusApproved),
+		},
+		{
+			name:          "CommentNotFound",
+			findErr:       errors.New("comment not found"),
+			expectedError: true,
+		},
+		{
+			name:           "UpdateError",
+			existing:       newComment(entity.CommentStatusPending),
+			updateErr:      errors.New("database error"),
+			expectedError:  true,
+			expectedUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			updated := false
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+				assert.Equal(t, testCommentID, id)
+				return tt.existing, tt.findErr
+			}
+			s.MockRepository.updateFunc = func(ctx context.Context, comment entity.Comment) error {
+				updated = true
+				assert.Equal(t, entity.CommentStatusApproved, comment.Status)
+				return tt.updateErr
+			}
+
+			actor := testPostAuthorID
+			if tt.actor != uuid.Nil {
+				actor = tt.actor
+			}
+			command := NewApproveCommentCommand(testCommentID)
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(actor)), &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdate, updated)
+
+			if tt.expectedApprove {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					approvedEvent, ok := s.PublishedEvents[0].(event.CommentWasApproved)
+					assert.True(t, ok)
+					assert.Equal(t, testCommentID, approvedEvent.ID)
+					assert.Equal(t, "Nice post", approvedEvent.Content)
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func TestApproveCommentCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ApproveCommentCommandHandlerTestSuite))
+}
@@ -0,0 +1,15 @@
+package command
+
+import "github.com/google/uuid"
+
+type createCommentCommand struct {
+	Id       uuid.UUID  `json:"id"`
+	PostId   uuid.UUID  `json:"post_id"`
+	Author   uuid.UUID  `json:"author"`
+	ParentId *uuid.UUID `json:"parent_id"`
+	Content  string     `json:"content"`
+}
+
+func NewCreateCommentCommand(id uuid.UUID, postId uuid.UUID, author uuid.UUID, parentId *uuid.UUID, content string) createCommentCommand {
+	return createCommentCommand{Id: id, PostId: postId, Author: author, ParentId: parentId, Content: content}
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	moderation "main/internal/Domain/Moderation"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type CreateCommentCommandHandler struct {
+	EventBus          *cqrs.EventBus
+	CommentRepository repository.CommentRepository
+	ModerationPolicy  moderation.ModerationPolicy
+	Authorizer        authorization.Authorizer
+}
+
+func (h CreateCommentCommandHandler) Handle(ctx context.Context, command *createCommentCommand) error {
+	if err := h.Authorizer.Authorize(ctx, command.Author, entity.PermissionCreateComments); err != nil {
+		return err
+	}
+
+	if _, err := h.CommentRepository.FindByID(ctx, command.Id); err == nil {
+		return nil
+	}
+
+	comment := entity.NewComment(
+		command.Id,
+		time.Now(),
+		command.PostId,
+		command.Author,
+		command.Content,
+	)
+	if command.ParentId != nil {
+		parent, err := h.CommentRepository.FindByID(ctx, *command.ParentId)
+		if err != nil {
+			return err
+		}
+		if err := comment.ReplyTo(parent); err != nil {
+			return err
+		}
+	}
+
+	decision, err := h.ModerationPolicy.Decide(ctx, comment)
+	if err != nil {
+		return err
+	}
+	decision.Apply(&comment, comment.CreatedAt)
+
+	err = h.CommentRepository.Save(ctx, comment)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCommentWasPosted(
+			comment.ID,
+			comment.CreatedAt,
+			comment.PostId,
+			comment.AuthorId,
+			comment.ParentId,
+			comment.Content,
+			string(comment.Status),
+		),
+	)
+}
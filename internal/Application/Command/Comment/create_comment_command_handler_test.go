@@ -0,0 +1,252 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	moderation "main/internal/Domain/Moderation"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCommentRepositoryCreate struct {
+	saveFunc     func(ctx context.Context, comment entity.Comment) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Comment, error)
+}
+
+func (m *mockCommentRepositoryCreate) Save(ctx context.Context, comment entity.Comment) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, comment)
+	}
+	return nil
+}
+
+func (m *mockCommentRepositoryCreate) Update(ctx context.Context, comment entity.Comment) error {
+	return nil
+}
+
+func (m *mockCommentRepositoryCreate) FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Comment{}, errors.New("not found")
+}
+
+func (m *mockCommentRepositoryCreate) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCommentRepositoryCreate) FindThreadsByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	return repository.PaginatedResult[entity.Comment]{}, nil
+}
+
+func (m *mockCommentRepositoryCreate) FindAllPendingByPostAuthorId(ctx context.Context, authorId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	return repository.PaginatedResult[entity.Comment]{}, nil
+}
+
+type mockModerationPolicyCreate struct {
+	decision moderation.Decision
+}
+
+func (m *mockModerationPolicyCreate) Decide(ctx context.Context, comment entity.Comment) (moderation.Decision, error) {
+	return m.decision, nil
+}
+
+type CreateCommentCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         CreateCommentCommandHandler
+	MockRepository  *mockCommentRepositoryCreate
+	MockPolicy      *mockModerationPolicyCreate
+	MockUsers       *mockUserRepositoryComment
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *CreateCommentCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockCommentRepositoryCreate{}
+	s.MockPolicy = &mockModerationPolicyCreate{}
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	s.MockUsers = &mockUserRepositoryComment{users: map[uuid.UUID]entity.User{
+		testAuthorID: newUserWithRole(testAuthorID, entity.RoleReader),
+	}}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = CreateCommentCommandHandler{
+		EventBus:          s.EventBus,
+		CommentRepository: s.MockRepository,
+		ModerationPolicy:  s.MockPolicy,
+		Authorizer:        authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *CreateCommentCommandHandlerTestSuite) TestHandle() {
+	testCommentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testParentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	testPostID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	testOtherPostID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name           string
+		parentId       *uuid.UUID
+		existing       map[uuid.UUID]entity.Comment
+		decision       moderation.Decision
+		expectedStatus entity.CommentStatus
+		saveErr        error
+		expectedError  bool
+		expectedSave   bool
+		expectedParent *uuid.UUID
+	}{
+		{
+			name:           "TopLevel",
+			expectedSave:   true,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:           "HeldForModeration",
+			decision:       moderation.DecisionHold,
+			expectedSave:   true,
+			expectedStatus: entity.CommentStatusPending,
+		},
+		{
+			name:           "AutoRejected",
+			decision:       moderation.DecisionReject,
+			expectedSave:   true,
+			expectedStatus: entity.CommentStatusRejected,
+		},
+		{
+			name:     "Reply",
+			parentId: &testParentID,
+			existing: map[uuid.UUID]entity.Comment{
+				testParentID: {ID: testParentID, PostId: testPostID},
+			},
+			expectedSave:   true,
+			expectedParent: &testParentID,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:     "ReplyToCommentOfOtherPost",
+			parentId: &testParentID,
+			existing: map[uuid.UUID]entity.Comment{
+				testParentID: {ID: testParentID, PostId: testOtherPostID},
+			},
+			expectedError: true,
+		},
+		{
+			name:          "ParentNotFound",
+			parentId:      &testParentID,
+			expectedError: true,
+		},
+		{
+			name: "AlreadyExists",
+			existing: map[uuid.UUID]entity.Comment{
+				testCommentID: {ID: testCommentID, PostId: testPostID},
+			},
+		},
+		{
+			name:           "SaveError",
+			saveErr:        errors.New("database error"),
+			expectedError:  true,
+			expectedSave:   true,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			saved := false
+			s.MockPolicy.decision = tt.decision
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+				if comment, ok := tt.existing[id]; ok {
+					return comment, nil
+				}
+				return entity.Comment{}, errors.New("not found")
+			}
+			s.MockRepository.saveFunc = func(ctx context.Context, comment entity.Comment) error {
+				saved = true
+				assert.Equal(t, testCommentID, comment.ID)
+				assert.Equal(t, testPostID, comment.PostId)
+				assert.Equal(t, testAuthorID, comment.AuthorId)
+				assert.Equal(t, tt.expectedParent, comment.ParentId)
+				assert.Equal(t, "Nice post", comment.Content)
+				assert.Equal(t, tt.expectedStatus, comment.Status)
+				return tt.saveErr
+			}
+
+			command := NewCreateCommentCommand(testCommentID, testPostID, testAuthorID, tt.parentId, "Nice post")
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID)), &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSave, saved)
+
+			if tt.expectedSave && !tt.expectedError {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					postedEvent, ok := s.PublishedEvents[0].(event.CommentWasPosted)
+					assert.True(t, ok)
+					assert.Equal(t, testCommentID, postedEvent.ID)
+					assert.Equal(t, testPostID, postedEvent.PostId)
+					assert.Equal(t, tt.expectedParent, postedEvent.ParentId)
+					assert.Equal(t, string(tt.expectedStatus), postedEvent.Status)
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func (s *CreateCommentCommandHandlerTestSuite) TestHandleOnBehalfOfAnotherUser() {
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	command := NewCreateCommentCommand(uuid.New(), uuid.New(), uuid.New(), nil, "Nice post")
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID)), &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestCreateCommentCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateCommentCommandHandlerTestSuite))
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type deleteCommentCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewDeleteCommentCommand(id uuid.UUID) deleteCommentCommand {
+	return deleteCommentCommand{Id: id}
+}
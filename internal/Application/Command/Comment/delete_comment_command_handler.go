@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type DeleteCommentCommandHandler struct {
+	EventBus          *cqrs.EventBus
+	CommentRepository repository.CommentRepository
+	Authorizer        authorization.Authorizer
+}
+
+func (h DeleteCommentCommandHandler) Handle(ctx context.Context, command *deleteCommentCommand) error {
+	comment, err := h.CommentRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeOwner(ctx, comment.AuthorId); err != nil {
+		return err
+	}
+
+	err = h.CommentRepository.Delete(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCommentWasDeleted(
+			comment.ID,
+			time.Now(),
+			comment.PostId,
+			comment.AuthorId,
+		),
+	)
+}
@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type editCommentCommand struct {
+	Id      uuid.UUID `json:"id"`
+	Content string    `json:"content"`
+}
+
+func NewEditCommentCommand(id uuid.UUID, content string) editCommentCommand {
+	return editCommentCommand{Id: id, Content: content}
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	moderation "main/internal/Domain/Moderation"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type EditCommentCommandHandler struct {
+	EventBus          *cqrs.EventBus
+	CommentRepository repository.CommentRepository
+	ModerationPolicy  moderation.ModerationPolicy
+	Authorizer        authorization.Authorizer
+}
+
+func (h EditCommentCommandHandler) Handle(ctx context.Context, command *editCommentCommand) error {
+	comment, err := h.CommentRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeOwner(ctx, comment.AuthorId); err != nil {
+		return err
+	}
+
+	comment.Edit(command.Content, time.Now())
+
+	// An edit can turn an approved comment into spam, so it is moderated again.
+	decision, err := h.ModerationPolicy.Decide(ctx, comment)
+	if err != nil {
+		return err
+	}
+	decision.Apply(&comment, comment.UpdatedAt)
+
+	err = h.CommentRepository.Update(ctx, comment)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewCommentWasEdited(
+			comment.ID,
+			comment.UpdatedAt,
+			comment.PostId,
+			comment.AuthorId,
+			comment.Content,
+			string(comment.Status),
+		),
+	)
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type rejectCommentCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewRejectCommentCommand(id uuid.UUID) rejectCommentCommand {
+	return rejectCommentCommand{Id: id}
+}
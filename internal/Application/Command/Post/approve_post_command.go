@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type approvePostCommand struct {
+	Id         uuid.UUID `json:"id"`
+	ReviewerId uuid.UUID `json:"reviewer_id"`
+}
+
+func NewApprovePostCommand(id uuid.UUID, reviewerId uuid.UUID) approvePostCommand {
+	return approvePostCommand{Id: id, ReviewerId: reviewerId}
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type ApprovePostCommandHandler struct {
+	EventBus       *cqrs.EventBus
+	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
+}
+
+func (h ApprovePostCommandHandler) Handle(ctx context.Context, command *approvePostCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeReview(ctx, post, command.ReviewerId); err != nil {
+		return err
+	}
+
+	if post.Status == entity.PostStatusApproved {
+		return nil
+	}
+
+	if err = post.Approve(command.ReviewerId, time.Now()); err != nil {
+		return err
+	}
+
+	err = h.PostRepository.Update(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasApproved(
+			post.ID,
+			*post.ReviewedAt,
+			command.ReviewerId,
+			post.AuthorIds(),
+		),
+	)
+}
@@ -0,0 +1,215 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryApprove struct {
+	post    entity.Post
+	updated []entity.Post
+}
+
+func (m *mockPostRepositoryApprove) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryApprove) Update(ctx context.Context, post entity.Post) error {
+	m.updated = append(m.updated, post)
+	return nil
+}
+
+func (m *mockPostRepositoryApprove) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if id != m.post.ID {
+		return entity.Post{}, errors.New("record not found")
+	}
+	return m.post, nil
+}
+
+func (m *mockPostRepositoryApprove) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryApprove) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryApprove) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryApprove) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryApprove) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type ApprovePostCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         ApprovePostCommandHandler
+	MockRepository  *mockPostRepositoryApprove
+	MockUsers       *mockUserRepositoryAuthorizer
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+	ReviewerId      uuid.UUID
+	Ctx             context.Context
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) SetupTest() {
+	s.ReviewerId = uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	s.MockRepository = &mockPostRepositoryApprove{post: entity.Post{
+		ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Slug:        "test-slug",
+		Title:       "Test Title",
+		AuthorId:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Status:      entity.PostStatusInReview,
+		ReviewNotes: "Needs a conclusion",
+		Collaborators: []entity.PostCollaborator{
+			{UserId: s.ReviewerId, Role: entity.CollaboratorRoleReviewer},
+		},
+	}}
+	s.Ctx = authorization.WithActor(context.Background(), authorization.NewUserActor(s.ReviewerId))
+	authorId := s.MockRepository.post.AuthorId
+	s.MockUsers = &mockUserRepositoryAuthorizer{user: entity.User{
+		ID:    authorId,
+		Roles: []entity.UserRole{{UserId: authorId, Role: entity.RoleAuthor}},
+	}}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = ApprovePostCommandHandler{
+		EventBus:       s.EventBus,
+		PostRepository: s.MockRepository,
+		Authorizer:     authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandle() {
+	command := NewApprovePostCommand(s.MockRepository.post.ID, s.ReviewerId)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockRepository.updated, 1)
+	approvedPost := s.MockRepository.updated[0]
+	assert.Equal(s.T(), entity.PostStatusApproved, approvedPost.Status)
+	assert.Equal(s.T(), &s.ReviewerId, approvedPost.ReviewerId)
+	assert.NotNil(s.T(), approvedPost.ReviewedAt)
+	assert.Empty(s.T(), approvedPost.ReviewNotes)
+
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	approvedEvent, ok := s.PublishedEvents[0].(event.PostWasApproved)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), approvedPost.ID, approvedEvent.ID)
+	assert.Equal(s.T(), s.ReviewerId, approvedEvent.ReviewerId)
+	assert.Equal(s.T(), []uuid.UUID{approvedPost.AuthorId}, approvedEvent.AuthorIds)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandleAlreadyApproved() {
+	s.MockRepository.post.Status = entity.PostStatusApproved
+
+	command := NewApprovePostCommand(s.MockRepository.post.ID, s.ReviewerId)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandleDraft() {
+	s.MockRepository.post.Status = entity.PostStatusDraft
+
+	command := NewApprovePostCommand(s.MockRepository.post.ID, s.ReviewerId)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrInvalidPostStatusTransition)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandleByAuthor() {
+	authorId := s.MockRepository.post.AuthorId
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(authorId))
+
+	command := NewApprovePostCommand(s.MockRepository.post.ID, authorId)
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockRepository.updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandleByAdminAuthor() {
+	authorId := s.MockRepository.post.AuthorId
+	s.MockUsers.user.Roles = []entity.UserRole{{UserId: authorId, Role: entity.RoleAdmin}}
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(authorId))
+
+	command := NewApprovePostCommand(s.MockRepository.post.ID, authorId)
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), s.MockRepository.updated, 1) {
+		assert.Equal(s.T(), entity.PostStatusApproved, s.MockRepository.updated[0].Status)
+		assert.Equal(s.T(), &authorId, s.MockRepository.updated[0].ReviewerId)
+	}
+	assert.Len(s.T(), s.PublishedEvents, 1)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandleOnBehalfOfAnotherReviewer() {
+	command := NewApprovePostCommand(s.MockRepository.post.ID, uuid.New())
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockRepository.updated)
+}
+
+func (s *ApprovePostCommandHandlerTestSuite) TestHandlePostNotFound() {
+	command := NewApprovePostCommand(uuid.New(), s.ReviewerId)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func TestApprovePostCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ApprovePostCommandHandlerTestSuite))
+}
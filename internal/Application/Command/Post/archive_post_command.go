@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type archivePostCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewArchivePostCommand(id uuid.UUID) archivePostCommand {
+	return archivePostCommand{Id: id}
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type ArchivePostCommandHandler struct {
+	EventBus       *cqrs.EventBus
+	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
+}
+
+func (h ArchivePostCommandHandler) Handle(ctx context.Context, command *archivePostCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	if post.Status == entity.PostStatusArchived {
+		return nil
+	}
+
+	if err = post.Archive(time.Now()); err != nil {
+		return err
+	}
+
+	err = h.PostRepository.Update(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasArchived(
+			post.ID,
+			post.UpdatedAt,
+			post.Slug,
+			post.AuthorId,
+		),
+	)
+}
@@ -1,15 +1,28 @@
 package command
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type createPostCommand struct {
-	Id      uuid.UUID `json:"id"`
-	Slug    string    `json:"slug"`
-	Title   string    `json:"title"`
-	Content string    `json:"content"`
-	Author  uuid.UUID `json:"author"`
+	Id              uuid.UUID  `json:"id"`
+	Slug            string     `json:"slug"`
+	Title           string     `json:"title"`
+	Content         string     `json:"content"`
+	ContentFormat   string     `json:"content_format"`
+	Excerpt         string     `json:"excerpt"`
+	Author          uuid.UUID  `json:"author"`
+	PublishAt       *time.Time `json:"publish_at"`
+	Tags            []string   `json:"tags"`
+	CoverMediaId    *uuid.UUID `json:"cover_media_id"`
+	SeoTitle        string     `json:"seo_title"`
+	MetaDescription string     `json:"meta_description"`
+	CanonicalURL    string     `json:"canonical_url"`
+	CategoryId      *uuid.UUID `json:"category_id"`
 }
 
-func NewCreatePostCommand(id uuid.UUID, slug string, title string, content string, author uuid.UUID) createPostCommand {
-	return createPostCommand{Id: id, Slug: slug, Title: title, Content: content, Author: author}
+func NewCreatePostCommand(id uuid.UUID, slug string, title string, content string, contentFormat string, excerpt string, author uuid.UUID, publishAt *time.Time, tags []string, coverMediaId *uuid.UUID, seoTitle string, metaDescription string, canonicalURL string, categoryId *uuid.UUID) createPostCommand {
+	return createPostCommand{Id: id, Slug: slug, Title: title, Content: content, ContentFormat: contentFormat, Excerpt: excerpt, Author: author, PublishAt: publishAt, Tags: tags, CoverMediaId: coverMediaId, SeoTitle: seoTitle, MetaDescription: metaDescription, CanonicalURL: canonicalURL, CategoryId: categoryId}
 }
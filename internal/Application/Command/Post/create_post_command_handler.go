@@ -2,20 +2,31 @@ package command
 
 import (
 	"context"
+	authorization "main/internal/Application/Authorization"
+	rendering "main/internal/Application/Rendering"
 	entity "main/internal/Domain/Entity"
 	event "main/internal/Domain/Event"
 	repository "main/internal/Domain/Repository"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
 )
 
 type CreatePostCommandHandler struct {
-	EventBus       *cqrs.EventBus
-	PostRepository repository.PostRepository
+	EventBus               *cqrs.EventBus
+	PostRepository         repository.PostRepository
+	PostRevisionRepository repository.PostRevisionRepository
+	TagRepository          repository.TagRepository
+	ContentRenderer        rendering.ContentRenderer
+	Authorizer             authorization.Authorizer
 }
 
 func (h CreatePostCommandHandler) Handle(ctx context.Context, command *createPostCommand) error {
+	if err := h.Authorizer.Authorize(ctx, command.Author, entity.PermissionCreatePosts); err != nil {
+		return err
+	}
+
 	post := entity.NewPost(
 		command.Id,
 		time.Now(),
@@ -23,14 +34,40 @@ func (h CreatePostCommandHandler) Handle(ctx context.Context, command *createPos
 		command.Slug,
 		command.Title,
 		command.Content,
+		entity.ContentFormat(command.ContentFormat),
 		command.Author,
 	)
+	post.CoverMediaId = command.CoverMediaId
+	post.SeoTitle = command.SeoTitle
+	post.MetaDescription = command.MetaDescription
+	post.CanonicalURL = command.CanonicalURL
+	post.CategoryId = command.CategoryId
+	if err := post.SchedulePublish(command.PublishAt); err != nil {
+		return err
+	}
+
+	text, err := h.ContentRenderer.PlainText(post.ContentFormat, post.Content)
+	if err != nil {
+		return err
+	}
+	post.Summarize(text, command.Excerpt)
 
 	if _, err := h.PostRepository.FindByID(ctx, command.Id); err == nil {
 		return nil
 	}
 
-	err := h.PostRepository.Save(ctx, post)
+	tags, err := h.TagRepository.FindOrCreateByNames(ctx, entity.NormalizeTagNames(command.Tags))
+	if err != nil {
+		return err
+	}
+	post.Tags = tags
+
+	err = h.PostRepository.Save(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.PostRevisionRepository.Save(ctx, entity.NewPostRevision(uuid.New(), post.UpdatedAt, post))
 	if err != nil {
 		return err
 	}
@@ -44,7 +81,10 @@ func (h CreatePostCommandHandler) Handle(ctx context.Context, command *createPos
 			post.Slug,
 			post.Title,
 			post.Content,
+			string(post.ContentFormat),
 			post.AuthorId,
+			post.AuthorIds(),
+			post.TagNames(),
 		),
 	)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	authorization "main/internal/Application/Authorization"
+	rendering "main/internal/Application/Rendering"
 	entity "main/internal/Domain/Entity"
 	event "main/internal/Domain/Event"
 	repository "main/internal/Domain/Repository"
@@ -41,7 +43,11 @@ func (m *mockPostRepositoryCreate) FindByID(ctx context.Context, id uuid.UUID) (
 	return entity.Post{}, errors.New("not implemented")
 }
 
-func (m *mockPostRepositoryCreate) FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+func (m *mockPostRepositoryCreate) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryCreate) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 	return repository.PaginatedResult[entity.Post]{}, nil
 }
 
@@ -49,16 +55,63 @@ func (m *mockPostRepositoryCreate) Delete(ctx context.Context, id uuid.UUID) err
 	return nil
 }
 
+func (m *mockPostRepositoryCreate) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryCreate) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockPostRevisionRepositoryCreate struct {
+	savedRevisions []entity.PostRevision
+}
+
+func (m *mockPostRevisionRepositoryCreate) Save(ctx context.Context, revision entity.PostRevision) (int, error) {
+	m.savedRevisions = append(m.savedRevisions, revision)
+	return len(m.savedRevisions), nil
+}
+
+func (m *mockPostRevisionRepositoryCreate) FindByPostIdAndRevision(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+	return entity.PostRevision{}, errors.New("not implemented")
+}
+
+func (m *mockPostRevisionRepositoryCreate) FindAllByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.PostRevision], error) {
+	return repository.PaginatedResult[entity.PostRevision]{}, nil
+}
+
+type mockTagRepositoryCreate struct{}
+
+func (m *mockTagRepositoryCreate) FindOrCreateByNames(ctx context.Context, names []string) ([]entity.Tag, error) {
+	tags := make([]entity.Tag, len(names))
+	for i, name := range names {
+		tags[i] = entity.NewTag(uuid.New(), time.Now(), name)
+	}
+	return tags, nil
+}
+
+func (m *mockTagRepositoryCreate) FindAllWithPostCount(ctx context.Context) ([]repository.TagWithPostCount, error) {
+	return nil, nil
+}
+
 type CreatePostCommandHandlerTestSuite struct {
 	suite.Suite
 	Handler         CreatePostCommandHandler
 	MockRepository  *mockPostRepositoryCreate
+	MockRevisions   *mockPostRevisionRepositoryCreate
+	MockUsers       *mockUserRepositoryAuthorizer
 	EventBus        *cqrs.EventBus
 	PublishedEvents []interface{}
 }
 
 func (s *CreatePostCommandHandlerTestSuite) SetupTest() {
 	s.MockRepository = &mockPostRepositoryCreate{}
+	s.MockRevisions = &mockPostRevisionRepositoryCreate{}
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	s.MockUsers = &mockUserRepositoryAuthorizer{user: entity.User{
+		ID:    testAuthorID,
+		Roles: []entity.UserRole{{UserId: testAuthorID, Role: entity.RoleAuthor}},
+	}}
 	s.PublishedEvents = make([]interface{}, 0)
 
 	db, _ := sql.Open("sqlite", ":memory:")
@@ -88,14 +141,21 @@ func (s *CreatePostCommandHandlerTestSuite) SetupTest() {
 	s.EventBus = eventBus
 
 	s.Handler = CreatePostCommandHandler{
-		EventBus:       s.EventBus,
-		PostRepository: s.MockRepository,
+		EventBus:               s.EventBus,
+		PostRepository:         s.MockRepository,
+		PostRevisionRepository: s.MockRevisions,
+		TagRepository:          &mockTagRepositoryCreate{},
+		ContentRenderer:        rendering.NewContentRenderer(),
+		Authorizer:             authorization.Authorizer{UserRepository: s.MockUsers},
 	}
 }
 
 func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
 	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	publishAt := time.Now().Add(time.Hour)
+	testCoverMediaID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	testCategoryID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174003")
 	existingPost := entity.Post{
 		ID:        testPostID,
 		CreatedAt: time.Now(),
@@ -122,7 +182,16 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 				"test-slug",
 				"Test Title",
 				"Test Content",
+				"",
+				"",
 				testAuthorID,
+				nil,
+				nil,
+				nil,
+				"",
+				"",
+				"",
+				nil,
 			),
 			setupMock: func() {
 				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
@@ -133,6 +202,10 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 					assert.Equal(s.T(), "test-slug", post.Slug)
 					assert.Equal(s.T(), "Test Title", post.Title)
 					assert.Equal(s.T(), "Test Content", post.Content)
+					assert.Equal(s.T(), entity.ContentFormatMarkdown, post.ContentFormat)
+					assert.Equal(s.T(), "Test Content", post.Excerpt)
+					assert.Equal(s.T(), 2, post.WordCount)
+					assert.Equal(s.T(), 1, post.ReadingTimeMinutes)
 					assert.Equal(s.T(), testAuthorID, post.AuthorId)
 					return nil
 				}
@@ -142,6 +215,47 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 			expectedSaveID:  testPostID,
 			expectedPublish: true,
 		},
+		{
+			name: "ScheduledSuccess",
+			command: NewCreatePostCommand(
+				testPostID,
+				"test-slug",
+				"Test Title",
+				"Test Content",
+				"html",
+				"  A hand written\n excerpt ",
+				testAuthorID,
+				&publishAt,
+				[]string{" Go ", "SQL", "go"},
+				&testCoverMediaID,
+				"SEO Title",
+				"A meta description",
+				"https://example.com/original",
+				&testCategoryID,
+			),
+			setupMock: func() {
+				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+					return entity.Post{}, errors.New("post not found")
+				}
+				s.MockRepository.saveFunc = func(ctx context.Context, post entity.Post) error {
+					assert.Equal(s.T(), entity.PostStatusDraft, post.Status)
+					assert.Equal(s.T(), &publishAt, post.PublishAt)
+					assert.Equal(s.T(), entity.ContentFormatHTML, post.ContentFormat)
+					assert.Equal(s.T(), "A hand written excerpt", post.Excerpt)
+					assert.Equal(s.T(), []string{"go", "sql"}, post.TagNames())
+					assert.Equal(s.T(), &testCoverMediaID, post.CoverMediaId)
+					assert.Equal(s.T(), "SEO Title", post.SeoTitle)
+					assert.Equal(s.T(), "A meta description", post.MetaDescription)
+					assert.Equal(s.T(), "https://example.com/original", post.CanonicalURL)
+					assert.Equal(s.T(), &testCategoryID, post.CategoryId)
+					return nil
+				}
+			},
+			expectedError:   false,
+			expectedSave:    true,
+			expectedSaveID:  testPostID,
+			expectedPublish: true,
+		},
 		{
 			name: "PostAlreadyExists",
 			command: NewCreatePostCommand(
@@ -149,7 +263,16 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 				"test-slug",
 				"Test Title",
 				"Test Content",
+				"",
+				"",
 				testAuthorID,
+				nil,
+				nil,
+				nil,
+				"",
+				"",
+				"",
+				nil,
 			),
 			setupMock: func() {
 				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
@@ -165,6 +288,34 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 			expectedSave:    false,
 			expectedPublish: false,
 		},
+		{
+			name: "OnBehalfOfAnotherAuthor",
+			command: NewCreatePostCommand(
+				testPostID,
+				"test-slug",
+				"Test Title",
+				"Test Content",
+				"",
+				"",
+				uuid.New(),
+				nil,
+				nil,
+				nil,
+				"",
+				"",
+				"",
+				nil,
+			),
+			setupMock: func() {
+				s.MockRepository.saveFunc = func(ctx context.Context, post entity.Post) error {
+					s.T().Error("Save should not be called for a post on behalf of another author")
+					return nil
+				}
+			},
+			expectedError:   true,
+			expectedSave:    false,
+			expectedPublish: false,
+		},
 		{
 			name: "SaveError",
 			command: NewCreatePostCommand(
@@ -172,7 +323,16 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 				"test-slug",
 				"Test Title",
 				"Test Content",
+				"",
+				"",
 				testAuthorID,
+				nil,
+				nil,
+				nil,
+				"",
+				"",
+				"",
+				nil,
 			),
 			setupMock: func() {
 				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
@@ -191,9 +351,10 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 	for _, tt := range tests {
 		s.T().Run(tt.name, func(t *testing.T) {
 			s.PublishedEvents = make([]interface{}, 0)
+			s.MockRevisions.savedRevisions = nil
 			tt.setupMock()
 
-			ctx := context.Background()
+			ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID))
 			err := s.Handler.Handle(ctx, &tt.command)
 
 			if tt.expectedError {
@@ -202,6 +363,16 @@ func (s *CreatePostCommandHandlerTestSuite) TestHandle() {
 				assert.NoError(t, err)
 			}
 
+			if tt.expectedSave && !tt.expectedError {
+				assert.Len(t, s.MockRevisions.savedRevisions, 1)
+				if len(s.MockRevisions.savedRevisions) > 0 {
+					assert.Equal(t, testPostID, s.MockRevisions.savedRevisions[0].PostId)
+					assert.Equal(t, "Test Content", s.MockRevisions.savedRevisions[0].Content)
+				}
+			} else {
+				assert.Len(t, s.MockRevisions.savedRevisions, 0)
+			}
+
 			if tt.expectedPublish {
 				assert.Greater(t, len(s.PublishedEvents), 0)
 				if len(s.PublishedEvents) > 0 {
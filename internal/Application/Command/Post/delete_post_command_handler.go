@@ -2,6 +2,8 @@ package command
 
 import (
 	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
 	event "main/internal/Domain/Event"
 	repository "main/internal/Domain/Repository"
 
@@ -11,6 +13,7 @@ import (
 type DeletePostCommandHandler struct {
 	EventBus       *cqrs.EventBus
 	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
 }
 
 func (h DeletePostCommandHandler) Handle(ctx context.Context, command *deletePostCommand) error {
@@ -19,6 +22,10 @@ func (h DeletePostCommandHandler) Handle(ctx context.Context, command *deletePos
 		return err
 	}
 
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
 	err = h.PostRepository.Delete(ctx, command.Id)
 	if err != nil {
 		return err
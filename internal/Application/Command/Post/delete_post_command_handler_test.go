@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	authorization "main/internal/Application/Authorization"
 	entity "main/internal/Domain/Entity"
 	event "main/internal/Domain/Event"
 	repository "main/internal/Domain/Repository"
@@ -38,7 +39,11 @@ func (m *mockPostRepositoryDelete) FindByID(ctx context.Context, id uuid.UUID) (
 	return entity.Post{}, errors.New("not implemented")
 }
 
-func (m *mockPostRepositoryDelete) FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+func (m *mockPostRepositoryDelete) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryDelete) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 	return repository.PaginatedResult[entity.Post]{}, nil
 }
 
@@ -49,16 +54,49 @@ func (m *mockPostRepositoryDelete) Delete(ctx context.Context, id uuid.UUID) err
 	return nil
 }
 
+func (m *mockPostRepositoryDelete) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryDelete) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockUserRepositoryAuthorizer struct {
+	user entity.User
+}
+
+func (m *mockUserRepositoryAuthorizer) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryAuthorizer) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	if id != m.user.ID {
+		return entity.User{}, errors.New("record not found")
+	}
+	return m.user, nil
+}
+
+func (m *mockUserRepositoryAuthorizer) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
 type DeletePostCommandHandlerTestSuite struct {
 	suite.Suite
 	Handler         DeletePostCommandHandler
 	MockRepository  *mockPostRepositoryDelete
+	MockUsers       *mockUserRepositoryAuthorizer
 	EventBus        *cqrs.EventBus
 	PublishedEvents []interface{}
 }
 
 func (s *DeletePostCommandHandlerTestSuite) SetupTest() {
 	s.MockRepository = &mockPostRepositoryDelete{}
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	s.MockUsers = &mockUserRepositoryAuthorizer{user: entity.User{
+		ID:    testAuthorID,
+		Roles: []entity.UserRole{{UserId: testAuthorID, Role: entity.RoleAuthor}},
+	}}
 	s.PublishedEvents = make([]interface{}, 0)
 
 	db, _ := sql.Open("sqlite", ":memory:")
@@ -90,6 +128,7 @@ func (s *DeletePostCommandHandlerTestSuite) SetupTest() {
 	s.Handler = DeletePostCommandHandler{
 		EventBus:       s.EventBus,
 		PostRepository: s.MockRepository,
+		Authorizer:     authorization.Authorizer{UserRepository: s.MockUsers},
 	}
 }
 
@@ -148,6 +187,24 @@ func (s *DeletePostCommandHandlerTestSuite) TestHandle() {
 			expectedID:      testPostID,
 			expectedPublish: false,
 		},
+		{
+			name:    "NotAuthorized",
+			command: NewDeletePostCommand(testPostID),
+			setupMock: func() {
+				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+					otherPost := existingPost
+					otherPost.AuthorId = uuid.New()
+					return otherPost, nil
+				}
+				s.MockRepository.deleteFunc = func(ctx context.Context, id uuid.UUID) error {
+					s.T().Error("Delete should not be called when the actor may not delete the post")
+					return nil
+				}
+			},
+			expectedError:   true,
+			expectedID:      testPostID,
+			expectedPublish: false,
+		},
 		{
 			name:    "PostNotFound",
 			command: NewDeletePostCommand(testPostID),
@@ -172,7 +229,7 @@ func (s *DeletePostCommandHandlerTestSuite) TestHandle() {
 			s.PublishedEvents = make([]interface{}, 0)
 			tt.setupMock()
 
-			ctx := context.Background()
+			ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID))
 			err := s.Handler.Handle(ctx, &tt.command)
 
 			if tt.expectedError {
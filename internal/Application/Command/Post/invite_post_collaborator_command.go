@@ -0,0 +1,13 @@
+package command
+
+import "github.com/google/uuid"
+
+type invitePostCollaboratorCommand struct {
+	Id     uuid.UUID `json:"id"`
+	UserId uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+func NewInvitePostCollaboratorCommand(id uuid.UUID, userId uuid.UUID, role string) invitePostCollaboratorCommand {
+	return invitePostCollaboratorCommand{Id: id, UserId: userId, Role: role}
+}
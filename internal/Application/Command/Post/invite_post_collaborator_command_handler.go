@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type InvitePostCollaboratorCommandHandler struct {
+	EventBus                   *cqrs.EventBus
+	PostRepository             repository.PostRepository
+	PostCollaboratorRepository repository.PostCollaboratorRepository
+	Authorizer                 authorization.Authorizer
+}
+
+// Handle adds the user to the collaborators of the post, or changes their
+// role when they already are one.
+func (h InvitePostCollaboratorCommandHandler) Handle(ctx context.Context, command *invitePostCollaboratorCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	collaborator, err := post.InviteCollaborator(command.UserId, entity.CollaboratorRole(command.Role), time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.PostCollaboratorRepository.Save(ctx, collaborator)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostCollaboratorWasInvited(
+			post.ID,
+			time.Now(),
+			collaborator.UserId,
+			string(collaborator.Role),
+			post.AuthorIds(),
+		),
+	)
+}
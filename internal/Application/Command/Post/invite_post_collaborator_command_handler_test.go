@@ -0,0 +1,192 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryInvite struct {
+	post entity.Post
+}
+
+func (m *mockPostRepositoryInvite) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryInvite) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryInvite) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if id != m.post.ID {
+		return entity.Post{}, errors.New("record not found")
+	}
+	return m.post, nil
+}
+
+func (m *mockPostRepositoryInvite) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryInvite) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryInvite) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryInvite) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryInvite) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockPostCollaboratorRepository struct {
+	saved []entity.PostCollaborator
+}
+
+func (m *mockPostCollaboratorRepository) Save(ctx context.Context, collaborator entity.PostCollaborator) error {
+	m.saved = append(m.saved, collaborator)
+	return nil
+}
+
+func (m *mockPostCollaboratorRepository) Delete(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error {
+	return nil
+}
+
+type InvitePostCollaboratorCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler                    InvitePostCollaboratorCommandHandler
+	MockRepository             *mockPostRepositoryInvite
+	MockCollaboratorRepository *mockPostCollaboratorRepository
+	EventBus                   *cqrs.EventBus
+	PublishedEvents            []interface{}
+	AuthorId                   uuid.UUID
+	CoAuthorId                 uuid.UUID
+	Ctx                        context.Context
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) SetupTest() {
+	s.AuthorId = uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	s.CoAuthorId = uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	postId := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	s.MockRepository = &mockPostRepositoryInvite{post: entity.Post{
+		ID:       postId,
+		AuthorId: s.AuthorId,
+		Collaborators: []entity.PostCollaborator{
+			{PostId: postId, UserId: s.CoAuthorId, Role: entity.CollaboratorRoleCoAuthor},
+		},
+	}}
+	s.MockCollaboratorRepository = &mockPostCollaboratorRepository{}
+	s.Ctx = authorization.WithActor(context.Background(), authorization.NewUserActor(s.AuthorId))
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = InvitePostCollaboratorCommandHandler{
+		EventBus:                   s.EventBus,
+		PostRepository:             s.MockRepository,
+		PostCollaboratorRepository: s.MockCollaboratorRepository,
+	}
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) TestHandle() {
+	editorId := uuid.New()
+	command := NewInvitePostCollaboratorCommand(s.MockRepository.post.ID, editorId, "editor")
+
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockCollaboratorRepository.saved, 1)
+	assert.Equal(s.T(), editorId, s.MockCollaboratorRepository.saved[0].UserId)
+	assert.Equal(s.T(), entity.CollaboratorRoleEditor, s.MockCollaboratorRepository.saved[0].Role)
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	invitedEvent, ok := s.PublishedEvents[0].(event.PostCollaboratorWasInvited)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), editorId, invitedEvent.UserId)
+	assert.Equal(s.T(), "editor", invitedEvent.Role)
+	assert.Equal(s.T(), []uuid.UUID{s.AuthorId, s.CoAuthorId}, invitedEvent.AuthorIds)
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) TestHandleChangesRole() {
+	command := NewInvitePostCollaboratorCommand(s.MockRepository.post.ID, s.CoAuthorId, "reviewer")
+
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), entity.CollaboratorRoleReviewer, s.MockCollaboratorRepository.saved[0].Role)
+	invitedEvent := s.PublishedEvents[0].(event.PostCollaboratorWasInvited)
+	assert.Equal(s.T(), []uuid.UUID{s.AuthorId}, invitedEvent.AuthorIds)
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) TestHandleAuthor() {
+	command := NewInvitePostCollaboratorCommand(s.MockRepository.post.ID, s.AuthorId, "editor")
+
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrCollaboratorIsAuthor)
+	assert.Empty(s.T(), s.MockCollaboratorRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) TestHandleInvalidRole() {
+	command := NewInvitePostCollaboratorCommand(s.MockRepository.post.ID, uuid.New(), "owner")
+
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrInvalidCollaboratorRole)
+	assert.Empty(s.T(), s.MockCollaboratorRepository.saved)
+}
+
+func (s *InvitePostCollaboratorCommandHandlerTestSuite) TestHandlePostNotFound() {
+	command := NewInvitePostCollaboratorCommand(uuid.New(), uuid.New(), "editor")
+
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func TestInvitePostCollaboratorCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitePostCollaboratorCommandHandlerTestSuite))
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type publishPostCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewPublishPostCommand(id uuid.UUID) publishPostCommand {
+	return publishPostCommand{Id: id}
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type PublishPostCommandHandler struct {
+	EventBus       *cqrs.EventBus
+	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
+}
+
+func (h PublishPostCommandHandler) Handle(ctx context.Context, command *publishPostCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	if post.IsPublished() {
+		return nil
+	}
+
+	if err = post.Publish(time.Now()); err != nil {
+		return err
+	}
+
+	err = h.PostRepository.Update(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasPublished(
+			post.ID,
+			post.UpdatedAt,
+			*post.PublishedAt,
+			post.Slug,
+			post.AuthorId,
+			post.AuthorIds(),
+		),
+	)
+}
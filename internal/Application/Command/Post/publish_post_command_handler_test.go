@@ -0,0 +1,243 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryPublish struct {
+	updateFunc   func(ctx context.Context, post entity.Post) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Post, error)
+}
+
+func (m *mockPostRepositoryPublish) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryPublish) Update(ctx context.Context, post entity.Post) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, post)
+	}
+	return nil
+}
+
+func (m *mockPostRepositoryPublish) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryPublish) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryPublish) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryPublish) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryPublish) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryPublish) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type PublishPostCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         PublishPostCommandHandler
+	MockRepository  *mockPostRepositoryPublish
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *PublishPostCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryPublish{}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = PublishPostCommandHandler{
+		EventBus:       s.EventBus,
+		PostRepository: s.MockRepository,
+	}
+}
+
+func (s *PublishPostCommandHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	newPost := func(status entity.PostStatus) entity.Post {
+		return entity.Post{
+			ID:        testPostID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			Slug:      "test-slug",
+			Title:     "Test Title",
+			Content:   "Test Content",
+			AuthorId:  testAuthorID,
+			Status:    status,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		existingPost    entity.Post
+		findErr         error
+		updateErr       error
+		expectedError   bool
+		expectedUpdate  bool
+		expectedPublish bool
+	}{
+		{
+			name:            "PublishApproved",
+			existingPost:    newPost(entity.PostStatusApproved),
+			expectedUpdate:  true,
+			expectedPublish: true,
+		},
+		{
+			name:          "PublishDraft",
+			existingPost:  newPost(entity.PostStatusDraft),
+			expectedError: true,
+		},
+		{
+			name:          "PublishInReview",
+			existingPost:  newPost(entity.PostStatusInReview),
+			expectedError: true,
+		},
+		{
+			name:          "PublishArchived",
+			existingPost:  newPost(entity.PostStatusArchived),
+			expectedError: true,
+		},
+		{
+			name:         "AlreadyPublished",
+			existingPost: newPost(entity.PostStatusPublished),
+		},
+		{
+			name:          "PostNotFound",
+			findErr:       errors.New("post not found"),
+			expectedError: true,
+		},
+		{
+			name:           "UpdateError",
+			existingPost:   newPost(entity.PostStatusApproved),
+			updateErr:      errors.New("database error"),
+			expectedError:  true,
+			expectedUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			updated := false
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+				assert.Equal(t, testPostID, id)
+				return tt.existingPost, tt.findErr
+			}
+			s.MockRepository.updateFunc = func(ctx context.Context, post entity.Post) error {
+				updated = true
+				assert.Equal(t, entity.PostStatusPublished, post.Status)
+				assert.NotNil(t, post.PublishedAt)
+				return tt.updateErr
+			}
+
+			command := NewPublishPostCommand(testPostID)
+			ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID))
+			err := s.Handler.Handle(ctx, &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdate, updated)
+
+			if tt.expectedPublish {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					publishedEvent, ok := s.PublishedEvents[0].(event.PostWasPublished)
+					assert.True(t, ok)
+					assert.Equal(t, testPostID, publishedEvent.ID)
+					assert.Equal(t, tt.existingPost.Slug, publishedEvent.Slug)
+					assert.Equal(t, testAuthorID, publishedEvent.AuthorId)
+					assert.Equal(t, []uuid.UUID{testAuthorID}, publishedEvent.AuthorIds)
+					assert.False(t, publishedEvent.PublishedAt.IsZero())
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func (s *PublishPostCommandHandlerTestSuite) TestHandleArchivedDraft() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	post := entity.NewPost(testPostID, time.Now(), time.Now(), "test-slug", "Test Title", "Test Content", entity.ContentFormatMarkdown, testAuthorID)
+	assert.NoError(s.T(), post.Archive(time.Now()))
+
+	updated := false
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+		return post, nil
+	}
+	s.MockRepository.updateFunc = func(ctx context.Context, post entity.Post) error {
+		updated = true
+		return nil
+	}
+
+	command := NewPublishPostCommand(testPostID)
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID))
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrPostNotApproved)
+	assert.False(s.T(), updated)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestPublishPostCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PublishPostCommandHandlerTestSuite))
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type purgePostCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewPurgePostCommand(id uuid.UUID) purgePostCommand {
+	return purgePostCommand{Id: id}
+}
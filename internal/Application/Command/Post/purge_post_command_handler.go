@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+// PurgePostCommandHandler deletes a trashed post for good. Posts that are not
+// in the trash are never purged, so a post restored in the meantime
+// survives.
+type PurgePostCommandHandler struct {
+	EventBus            *cqrs.EventBus
+	PostTrashRepository repository.PostTrashRepository
+	Authorizer          authorization.Authorizer
+}
+
+func (h PurgePostCommandHandler) Handle(ctx context.Context, command *purgePostCommand) error {
+	post, err := h.PostTrashRepository.FindByID(ctx, command.Id)
+	if errors.Is(err, repository.ErrPostNotInTrash) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	err = h.PostTrashRepository.Purge(ctx, post.ID)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasPurged(
+			post.ID,
+			time.Now(),
+			post.Slug,
+			post.AuthorIds(),
+		),
+	)
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"context"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// reanchorAnnotations moves the annotations of a post along with an edit of
+// its content. Resolved annotations move too, so they point at the right
+// passage should they be reopened.
+func reanchorAnnotations(ctx context.Context, annotationRepository repository.AnnotationRepository, postId uuid.UUID, oldContent string, newContent string, at time.Time) error {
+	if oldContent == newContent {
+		return nil
+	}
+
+	annotations, err := annotationRepository.FindAllByPostId(ctx, postId, true)
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		if !annotation.Reanchor(oldContent, newContent, at) {
+			continue
+		}
+		if err := annotationRepository.Update(ctx, annotation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
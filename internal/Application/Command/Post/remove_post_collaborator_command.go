@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type removePostCollaboratorCommand struct {
+	Id     uuid.UUID `json:"id"`
+	UserId uuid.UUID `json:"user_id"`
+}
+
+func NewRemovePostCollaboratorCommand(id uuid.UUID, userId uuid.UUID) removePostCollaboratorCommand {
+	return removePostCollaboratorCommand{Id: id, UserId: userId}
+}
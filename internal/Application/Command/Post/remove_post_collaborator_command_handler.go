@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type RemovePostCollaboratorCommandHandler struct {
+	EventBus                   *cqrs.EventBus
+	PostRepository             repository.PostRepository
+	PostCollaboratorRepository repository.PostCollaboratorRepository
+	Authorizer                 authorization.Authorizer
+}
+
+// Handle takes the user off the collaborators of the post. Removing a user
+// that is no collaborator, e.g. when the message is redelivered, is a no-op.
+func (h RemovePostCollaboratorCommandHandler) Handle(ctx context.Context, command *removePostCollaboratorCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	if err := post.RemoveCollaborator(command.UserId); err != nil {
+		if errors.Is(err, entity.ErrCollaboratorNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	err = h.PostCollaboratorRepository.Delete(ctx, post.ID, command.UserId)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostCollaboratorWasRemoved(
+			post.ID,
+			time.Now(),
+			command.UserId,
+			post.AuthorIds(),
+		),
+	)
+}
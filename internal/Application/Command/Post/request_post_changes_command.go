@@ -0,0 +1,13 @@
+package command
+
+import "github.com/google/uuid"
+
+type requestPostChangesCommand struct {
+	Id         uuid.UUID `json:"id"`
+	ReviewerId uuid.UUID `json:"reviewer_id"`
+	Notes      string    `json:"notes"`
+}
+
+func NewRequestPostChangesCommand(id uuid.UUID, reviewerId uuid.UUID, notes string) requestPostChangesCommand {
+	return requestPostChangesCommand{Id: id, ReviewerId: reviewerId, Notes: notes}
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type RequestPostChangesCommandHandler struct {
+	EventBus       *cqrs.EventBus
+	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
+}
+
+func (h RequestPostChangesCommandHandler) Handle(ctx context.Context, command *requestPostChangesCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeReview(ctx, post, command.ReviewerId); err != nil {
+		return err
+	}
+
+	if post.Status == entity.PostStatusChangesRequested {
+		return nil
+	}
+
+	if err = post.RequestChanges(command.ReviewerId, command.Notes, time.Now()); err != nil {
+		return err
+	}
+
+	err = h.PostRepository.Update(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostChangesWereRequested(
+			post.ID,
+			*post.ReviewedAt,
+			command.ReviewerId,
+			post.ReviewNotes,
+			post.AuthorIds(),
+		),
+	)
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type restorePostCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewRestorePostCommand(id uuid.UUID) restorePostCommand {
+	return restorePostCommand{Id: id}
+}
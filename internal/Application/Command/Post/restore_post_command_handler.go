@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+// RestorePostCommandHandler takes a post out of the trash with the status it
+// had when it was deleted. A post that is no longer in the trash, restored
+// or purged already, is left alone.
+type RestorePostCommandHandler struct {
+	EventBus            *cqrs.EventBus
+	PostTrashRepository repository.PostTrashRepository
+	Authorizer          authorization.Authorizer
+}
+
+func (h RestorePostCommandHandler) Handle(ctx context.Context, command *restorePostCommand) error {
+	post, err := h.PostTrashRepository.FindByID(ctx, command.Id)
+	if errors.Is(err, repository.ErrPostNotInTrash) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanManage); err != nil {
+		return err
+	}
+
+	restoredAt := time.Now()
+	err = h.PostTrashRepository.Restore(ctx, post.ID, restoredAt)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasRestored(
+			post.ID,
+			restoredAt,
+			post.Slug,
+			post.Title,
+			post.AuthorIds(),
+		),
+	)
+}
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type mockPostTrashRepositoryRestore struct {
+	post     entity.Post
+	restored []uuid.UUID
+}
+
+func (m *mockPostTrashRepositoryRestore) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if id != m.post.ID || !m.post.IsTrashed() {
+		return entity.Post{}, repository.ErrPostNotInTrash
+	}
+	return m.post, nil
+}
+
+func (m *mockPostTrashRepositoryRestore) FindAllByOwnerId(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostTrashRepositoryRestore) FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]entity.Post, error) {
+	return nil, nil
+}
+
+func (m *mockPostTrashRepositoryRestore) Restore(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.restored = append(m.restored, id)
+	return nil
+}
+
+func (m *mockPostTrashRepositoryRestore) Purge(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+type RestorePostCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         RestorePostCommandHandler
+	MockRepository  *mockPostTrashRepositoryRestore
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+	Ctx             context.Context
+}
+
+func (s *RestorePostCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostTrashRepositoryRestore{post: entity.Post{
+		ID:       uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Slug:     "test-slug",
+		Title:    "Test Title",
+		AuthorId: uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Status:   entity.PostStatusPublished,
+		Collaborators: []entity.PostCollaborator{
+			{UserId: uuid.MustParse("323e4567-e89b-12d3-a456-426614174002"), Role: entity.CollaboratorRoleCoAuthor},
+		},
+		DeletedAt: gorm.DeletedAt{Time: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), Valid: true},
+	}}
+	s.PublishedEvents = make([]interface{}, 0)
+	// The co-author restores the post.
+	s.Ctx = authorization.WithActor(context.Background(), authorization.NewUserActor(uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")))
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = RestorePostCommandHandler{
+		EventBus:            s.EventBus,
+		PostTrashRepository: s.MockRepository,
+	}
+}
+
+func (s *RestorePostCommandHandlerTestSuite) TestHandle() {
+	command := NewRestorePostCommand(s.MockRepository.post.ID)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []uuid.UUID{s.MockRepository.post.ID}, s.MockRepository.restored)
+
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	restoredEvent, ok := s.PublishedEvents[0].(event.PostWasRestored)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), s.MockRepository.post.ID, restoredEvent.ID)
+	assert.Equal(s.T(), "test-slug", restoredEvent.Slug)
+	assert.Equal(s.T(), s.MockRepository.post.AuthorIds(), restoredEvent.AuthorIds)
+}
+
+func (s *RestorePostCommandHandlerTestSuite) TestHandleNotInTrash() {
+	s.MockRepository.post.DeletedAt = gorm.DeletedAt{}
+
+	command := NewRestorePostCommand(s.MockRepository.post.ID)
+	err := s.Handler.Handle(s.Ctx, &command)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MockRepository.restored)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestRestorePostCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RestorePostCommandHandlerTestSuite))
+}
@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type restorePostRevisionCommand struct {
+	PostId   uuid.UUID `json:"post_id"`
+	Revision int       `json:"revision"`
+}
+
+func NewRestorePostRevisionCommand(postId uuid.UUID, revision int) restorePostRevisionCommand {
+	return restorePostRevisionCommand{PostId: postId, Revision: revision}
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	rendering "main/internal/Application/Rendering"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+)
+
+type RestorePostRevisionCommandHandler struct {
+	EventBus               *cqrs.EventBus
+	PostRepository         repository.PostRepository
+	PostRevisionRepository repository.PostRevisionRepository
+	SlugHistoryRepository  repository.SlugHistoryRepository
+	AnnotationRepository   repository.AnnotationRepository
+	ContentRenderer        rendering.ContentRenderer
+	Authorizer             authorization.Authorizer
+}
+
+// Handle copies the revision back onto the post. The restore is recorded as a
+// new revision so history is never rewritten.
+func (h RestorePostRevisionCommandHandler) Handle(ctx context.Context, command *restorePostRevisionCommand) error {
+	existingPost, err := h.PostRepository.FindByID(ctx, command.PostId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, existingPost, entity.CollaboratorRole.CanEdit); err != nil {
+		return err
+	}
+
+	revision, err := h.PostRevisionRepository.FindByPostIdAndRevision(ctx, command.PostId, command.Revision)
+	if err != nil {
+		return err
+	}
+
+	restoredPost := existingPost
+	restoredPost.UpdatedAt = time.Now()
+	restoredPost.Slug = revision.Slug
+	restoredPost.Title = revision.Title
+	restoredPost.Content = revision.Content
+	restoredPost.ContentFormat = revision.ContentFormat
+
+	text, err := h.ContentRenderer.PlainText(restoredPost.ContentFormat, restoredPost.Content)
+	if err != nil {
+		return err
+	}
+	restoredPost.Summarize(text, revision.Excerpt)
+	if restoredPost.Title != existingPost.Title || restoredPost.Content != existingPost.Content || restoredPost.ContentFormat != existingPost.ContentFormat {
+		restoredPost.WithdrawApproval(restoredPost.UpdatedAt)
+	}
+
+	err = h.PostRepository.Update(ctx, restoredPost)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.PostRevisionRepository.Save(ctx, entity.NewPostRevision(uuid.New(), restoredPost.UpdatedAt, restoredPost))
+	if err != nil {
+		return err
+	}
+
+	err = reanchorAnnotations(ctx, h.AnnotationRepository, restoredPost.ID, existingPost.Content, restoredPost.Content, restoredPost.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if existingPost.Slug != restoredPost.Slug {
+		err = h.SlugHistoryRepository.Save(ctx, entity.NewSlugHistory(existingPost.Slug, restoredPost.ID, restoredPost.UpdatedAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasUpdated(
+			restoredPost.ID,
+			restoredPost.CreatedAt,
+			restoredPost.UpdatedAt,
+			restoredPost.Slug,
+			restoredPost.Title,
+			restoredPost.Content,
+			string(restoredPost.ContentFormat),
+			restoredPost.AuthorId,
+			restoredPost.AuthorIds(),
+			restoredPost.TagNames(),
+		),
+	)
+}
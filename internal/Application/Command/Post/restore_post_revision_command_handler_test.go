@@ -0,0 +1,329 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	rendering "main/internal/Application/Rendering"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryRestore struct {
+	updateFunc   func(ctx context.Context, post entity.Post) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Post, error)
+}
+
+func (m *mockPostRepositoryRestore) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryRestore) Update(ctx context.Context, post entity.Post) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, post)
+	}
+	return nil
+}
+
+func (m *mockPostRepositoryRestore) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryRestore) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryRestore) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryRestore) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryRestore) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryRestore) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockPostRevisionRepositoryRestore struct {
+	findFunc       func(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error)
+	savedRevisions []entity.PostRevision
+}
+
+func (m *mockPostRevisionRepositoryRestore) Save(ctx context.Context, revision entity.PostRevision) (int, error) {
+	m.savedRevisions = append(m.savedRevisions, revision)
+	return len(m.savedRevisions), nil
+}
+
+func (m *mockPostRevisionRepositoryRestore) FindByPostIdAndRevision(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+	if m.findFunc != nil {
+		return m.findFunc(ctx, postId, revision)
+	}
+	return entity.PostRevision{}, errors.New("not implemented")
+}
+
+func (m *mockPostRevisionRepositoryRestore) FindAllByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.PostRevision], error) {
+	return repository.PaginatedResult[entity.PostRevision]{}, nil
+}
+
+type mockSlugHistoryRepositoryRestore struct {
+	savedSlugs []entity.SlugHistory
+}
+
+func (m *mockSlugHistoryRepositoryRestore) Save(ctx context.Context, slugHistory entity.SlugHistory) error {
+	m.savedSlugs = append(m.savedSlugs, slugHistory)
+	return nil
+}
+
+func (m *mockSlugHistoryRepositoryRestore) FindPostIdBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	return uuid.Nil, errors.New("not implemented")
+}
+
+type mockAnnotationRepositoryRestore struct {
+	annotations []entity.Annotation
+	updated     []entity.Annotation
+}
+
+func (m *mockAnnotationRepositoryRestore) Save(ctx context.Context, annotation entity.Annotation) error {
+	return nil
+}
+
+func (m *mockAnnotationRepositoryRestore) Update(ctx context.Context, annotation entity.Annotation) error {
+	m.updated = append(m.updated, annotation)
+	return nil
+}
+
+func (m *mockAnnotationRepositoryRestore) FindByID(ctx context.Context, id uuid.UUID) (entity.Annotation, error) {
+	return entity.Annotation{}, errors.New("not implemented")
+}
+
+func (m *mockAnnotationRepositoryRestore) FindAllByPostId(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+	return m.annotations, nil
+}
+
+type RestorePostRevisionCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         RestorePostRevisionCommandHandler
+	MockRepository  *mockPostRepositoryRestore
+	MockRevisions   *mockPostRevisionRepositoryRestore
+	MockSlugs       *mockSlugHistoryRepositoryRestore
+	MockAnnotations *mockAnnotationRepositoryRestore
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *RestorePostRevisionCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryRestore{}
+	s.MockRevisions = &mockPostRevisionRepositoryRestore{}
+	s.MockSlugs = &mockSlugHistoryRepositoryRestore{}
+	s.MockAnnotations = &mockAnnotationRepositoryRestore{}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = RestorePostRevisionCommandHandler{
+		EventBus:               s.EventBus,
+		PostRepository:         s.MockRepository,
+		PostRevisionRepository: s.MockRevisions,
+		SlugHistoryRepository:  s.MockSlugs,
+		AnnotationRepository:   s.MockAnnotations,
+		ContentRenderer:        rendering.NewContentRenderer(),
+	}
+}
+
+func (s *RestorePostRevisionCommandHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	existingPost := entity.Post{
+		ID:        testPostID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Slug:      "current-slug",
+		Title:     "Current Title",
+		Content:   "Current Content",
+		AuthorId:  testAuthorID,
+		Status:    entity.PostStatusPublished,
+	}
+	oldRevision := entity.PostRevision{
+		ID:       uuid.New(),
+		PostId:   testPostID,
+		Revision: 1,
+		Slug:     "old-slug",
+		Title:    "Old Title",
+		Content:  "Old Content",
+		Excerpt:  "Old excerpt",
+
+		ContentFormat: entity.ContentFormatMarkdown,
+	}
+
+	tests := []struct {
+		name            string
+		findPostErr     error
+		findRevisionErr error
+		updateErr       error
+		expectedError   bool
+		expectedRestore bool
+	}{
+		{
+			name:            "Success",
+			expectedRestore: true,
+		},
+		{
+			name:          "PostNotFound",
+			findPostErr:   errors.New("post not found"),
+			expectedError: true,
+		},
+		{
+			name:            "RevisionNotFound",
+			findRevisionErr: errors.New("revision not found"),
+			expectedError:   true,
+		},
+		{
+			name:          "UpdateError",
+			updateErr:     errors.New("database error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			s.MockRevisions.savedRevisions = nil
+			s.MockSlugs.savedSlugs = nil
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+				assert.Equal(t, testPostID, id)
+				return existingPost, tt.findPostErr
+			}
+			s.MockRevisions.findFunc = func(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+				assert.Equal(t, testPostID, postId)
+				assert.Equal(t, 1, revision)
+				return oldRevision, tt.findRevisionErr
+			}
+			s.MockRepository.updateFunc = func(ctx context.Context, post entity.Post) error {
+				assert.Equal(t, "old-slug", post.Slug)
+				assert.Equal(t, "Old Title", post.Title)
+				assert.Equal(t, "Old Content", post.Content)
+				assert.Equal(t, "Old excerpt", post.Excerpt)
+				assert.Equal(t, 2, post.WordCount)
+				assert.Equal(t, entity.PostStatusPublished, post.Status)
+				return tt.updateErr
+			}
+
+			command := NewRestorePostRevisionCommand(testPostID, 1)
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID)), &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.expectedRestore {
+				assert.Len(t, s.MockRevisions.savedRevisions, 1)
+				if assert.Len(t, s.MockSlugs.savedSlugs, 1) {
+					assert.Equal(t, "current-slug", s.MockSlugs.savedSlugs[0].Slug)
+					assert.Equal(t, testPostID, s.MockSlugs.savedSlugs[0].PostId)
+				}
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					publishedEvent, ok := s.PublishedEvents[0].(event.PostWasUpdated)
+					assert.True(t, ok)
+					assert.Equal(t, testPostID, publishedEvent.ID)
+					assert.Equal(t, "old-slug", publishedEvent.Slug)
+					assert.Equal(t, "Old Title", publishedEvent.Title)
+					assert.Equal(t, "Old Content", publishedEvent.Content)
+				}
+			} else {
+				assert.Len(t, s.MockRevisions.savedRevisions, 0)
+				assert.Len(t, s.MockSlugs.savedSlugs, 0)
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func (s *RestorePostRevisionCommandHandlerTestSuite) TestHandleReanchorsAnnotations() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+		return entity.Post{ID: testPostID, Slug: "slug", Title: "Title", Content: "Current intro. Shared ending."}, nil
+	}
+	s.MockRevisions.findFunc = func(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+		return entity.PostRevision{PostId: testPostID, Slug: "slug", Title: "Title", Content: "A much longer old intro. Shared ending.", ContentFormat: entity.ContentFormatMarkdown}, nil
+	}
+	newAnnotation := func(start int, end int, quote string) entity.Annotation {
+		annotation, err := entity.NewAnnotation(uuid.New(), time.Now(), testPostID, uuid.New(), "Current intro. Shared ending.", start, end, quote, "Note")
+		if err != nil {
+			panic(err)
+		}
+		return annotation
+	}
+	s.MockAnnotations.annotations = []entity.Annotation{
+		newAnnotation(15, 21, "Shared"),
+		newAnnotation(0, 7, "Current"),
+		newAnnotation(8, 13, "intro"),
+	}
+
+	command := NewRestorePostRevisionCommand(testPostID, 1)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.SystemActor), &command)
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), s.MockAnnotations.updated, 3) {
+		shifted := s.MockAnnotations.updated[0]
+		assert.Equal(s.T(), []int{25, 31}, []int{shifted.Start, shifted.End})
+		assert.False(s.T(), shifted.Detached)
+
+		removed := s.MockAnnotations.updated[1]
+		assert.Equal(s.T(), []int{0, 7}, []int{removed.Start, removed.End})
+		assert.True(s.T(), removed.Detached)
+
+		moved := s.MockAnnotations.updated[2]
+		assert.Equal(s.T(), []int{18, 23}, []int{moved.Start, moved.End})
+		assert.False(s.T(), moved.Detached)
+	}
+}
+
+func TestRestorePostRevisionCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RestorePostRevisionCommandHandlerTestSuite))
+}
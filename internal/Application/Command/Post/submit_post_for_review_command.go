@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type submitPostForReviewCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewSubmitPostForReviewCommand(id uuid.UUID) submitPostForReviewCommand {
+	return submitPostForReviewCommand{Id: id}
+}
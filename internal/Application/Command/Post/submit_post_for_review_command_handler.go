@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type SubmitPostForReviewCommandHandler struct {
+	EventBus       *cqrs.EventBus
+	PostRepository repository.PostRepository
+	Authorizer     authorization.Authorizer
+}
+
+func (h SubmitPostForReviewCommandHandler) Handle(ctx context.Context, command *submitPostForReviewCommand) error {
+	post, err := h.PostRepository.FindByID(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizePost(ctx, post, entity.CollaboratorRole.CanEdit); err != nil {
+		return err
+	}
+
+	if post.Status == entity.PostStatusInReview {
+		return nil
+	}
+
+	if err = post.SubmitForReview(time.Now()); err != nil {
+		return err
+	}
+
+	err = h.PostRepository.Update(ctx, post)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasSubmittedForReview(
+			post.ID,
+			*post.SubmittedAt,
+			post.Slug,
+			post.Title,
+			post.AuthorIds(),
+		),
+	)
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+type unpublishPostCommand struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewUnpublishPostCommand(id uuid.UUID) unpublishPostCommand {
+	return unpublishPostCommand{Id: id}
+}
@@ -1,14 +1,27 @@
 package command
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type updatePostCommand struct {
-	Id      uuid.UUID `json:"id"`
-	Slug    string    `json:"slug"`
-	Title   string    `json:"title"`
-	Content string    `json:"content"`
+	Id              uuid.UUID  `json:"id"`
+	Slug            string     `json:"slug"`
+	Title           string     `json:"title"`
+	Content         string     `json:"content"`
+	ContentFormat   string     `json:"content_format"`
+	Excerpt         string     `json:"excerpt"`
+	PublishAt       *time.Time `json:"publish_at"`
+	Tags            []string   `json:"tags"`
+	CoverMediaId    *uuid.UUID `json:"cover_media_id"`
+	SeoTitle        string     `json:"seo_title"`
+	MetaDescription string     `json:"meta_description"`
+	CanonicalURL    string     `json:"canonical_url"`
+	CategoryId      *uuid.UUID `json:"category_id"`
 }
 
-func NewUpdatePostCommand(id uuid.UUID, slug string, title string, content string) updatePostCommand {
-	return updatePostCommand{Id: id, Slug: slug, Title: title, Content: content}
+func NewUpdatePostCommand(id uuid.UUID, slug string, title string, content string, contentFormat string, excerpt string, publishAt *time.Time, tags []string, coverMediaId *uuid.UUID, seoTitle string, metaDescription string, canonicalURL string, categoryId *uuid.UUID) updatePostCommand {
+	return updatePostCommand{Id: id, Slug: slug, Title: title, Content: content, ContentFormat: contentFormat, Excerpt: excerpt, PublishAt: publishAt, Tags: tags, CoverMediaId: coverMediaId, SeoTitle: seoTitle, MetaDescription: metaDescription, CanonicalURL: canonicalURL, CategoryId: categoryId}
 }
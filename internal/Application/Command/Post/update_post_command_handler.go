@@ -2,17 +2,26 @@ package command
 
 import (
 	"context"
+	authorization "main/internal/Application/Authorization"
+	rendering "main/internal/Application/Rendering"
 	entity "main/internal/Domain/Entity"
 	event "main/internal/Domain/Event"
 	repository "main/internal/Domain/Repository"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
 )
 
 type UpdatePostCommandHandler struct {
-	EventBus       *cqrs.EventBus
-	PostRepository repository.PostRepository
+	EventBus               *cqrs.EventBus
+	PostRepository         repository.PostRepository
+	PostRevisionRepository repository.PostRevisionRepository
+	TagRepository          repository.TagRepository
+	SlugHistoryRepository  repository.SlugHistoryRepository
+	AnnotationRepository   repository.AnnotationRepository
+	ContentRenderer        rendering.ContentRenderer
+	Authorizer             authorization.Authorizer
 }
 
 func (h UpdatePostCommandHandler) Handle(ctx context.Context, command *updatePostCommand) error {
@@ -21,21 +30,64 @@ func (h UpdatePostCommandHandler) Handle(ctx context.Context, command *updatePos
 		return err
 	}
 
-	updatedPost := entity.NewPost(
-		existingPost.ID,
-		existingPost.CreatedAt,
-		time.Now(),
-		command.Slug,
-		command.Title,
-		command.Content,
-		existingPost.AuthorId,
-	)
+	if err := h.Authorizer.AuthorizePost(ctx, existingPost, entity.CollaboratorRole.CanEdit); err != nil {
+		return err
+	}
+
+	tags, err := h.TagRepository.FindOrCreateByNames(ctx, entity.NormalizeTagNames(command.Tags))
+	if err != nil {
+		return err
+	}
+
+	updatedPost := existingPost
+	updatedPost.UpdatedAt = time.Now()
+	updatedPost.Slug = command.Slug
+	updatedPost.Title = command.Title
+	updatedPost.Content = command.Content
+	if command.ContentFormat != "" {
+		updatedPost.ContentFormat = entity.ContentFormat(command.ContentFormat)
+	}
+	updatedPost.Tags = tags
+	updatedPost.CoverMediaId = command.CoverMediaId
+	updatedPost.SeoTitle = command.SeoTitle
+	updatedPost.MetaDescription = command.MetaDescription
+	updatedPost.CanonicalURL = command.CanonicalURL
+	updatedPost.CategoryId = command.CategoryId
+	if err = updatedPost.SchedulePublish(command.PublishAt); err != nil {
+		return err
+	}
+
+	text, err := h.ContentRenderer.PlainText(updatedPost.ContentFormat, updatedPost.Content)
+	if err != nil {
+		return err
+	}
+	updatedPost.Summarize(text, command.Excerpt)
+	if updatedPost.Title != existingPost.Title || updatedPost.Content != existingPost.Content || updatedPost.ContentFormat != existingPost.ContentFormat {
+		updatedPost.WithdrawApproval(updatedPost.UpdatedAt)
+	}
 
 	err = h.PostRepository.Update(ctx, updatedPost)
 	if err != nil {
 		return err
 	}
 
+	_, err = h.PostRevisionRepository.Save(ctx, entity.NewPostRevision(uuid.New(), updatedPost.UpdatedAt, updatedPost))
+	if err != nil {
+		return err
+	}
+
+	err = reanchorAnnotations(ctx, h.AnnotationRepository, updatedPost.ID, existingPost.Content, updatedPost.Content, updatedPost.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if existingPost.Slug != updatedPost.Slug {
+		err = h.SlugHistoryRepository.Save(ctx, entity.NewSlugHistory(existingPost.Slug, updatedPost.ID, updatedPost.UpdatedAt))
+		if err != nil {
+			return err
+		}
+	}
+
 	return h.EventBus.Publish(
 		context.Background(),
 		event.NewPostWasUpdated(
@@ -45,7 +97,10 @@ func (h UpdatePostCommandHandler) Handle(ctx context.Context, command *updatePos
 			updatedPost.Slug,
 			updatedPost.Title,
 			updatedPost.Content,
+			string(updatedPost.ContentFormat),
 			updatedPost.AuthorId,
+			updatedPost.AuthorIds(),
+			updatedPost.TagNames(),
 		),
 	)
 }
@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type addPostToSeriesCommand struct {
+	SeriesId uuid.UUID `json:"series_id"`
+	PostId   uuid.UUID `json:"post_id"`
+}
+
+func NewAddPostToSeriesCommand(seriesId uuid.UUID, postId uuid.UUID) addPostToSeriesCommand {
+	return addPostToSeriesCommand{SeriesId: seriesId, PostId: postId}
+}
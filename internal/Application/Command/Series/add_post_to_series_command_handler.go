@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type AddPostToSeriesCommandHandler struct {
+	EventBus         *cqrs.EventBus
+	SeriesRepository repository.SeriesRepository
+	Authorizer       authorization.Authorizer
+}
+
+func (h AddPostToSeriesCommandHandler) Handle(ctx context.Context, command *addPostToSeriesCommand) error {
+	series, err := h.SeriesRepository.FindByID(ctx, command.SeriesId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeOwner(ctx, series.AuthorId); err != nil {
+		return err
+	}
+
+	err = series.AddPost(command.PostId, time.Now())
+	if errors.Is(err, entity.ErrPostAlreadyInSeries) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = h.SeriesRepository.Update(ctx, series)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasAddedToSeries(
+			series.ID,
+			series.UpdatedAt,
+			command.PostId,
+			len(series.Posts),
+		),
+	)
+}
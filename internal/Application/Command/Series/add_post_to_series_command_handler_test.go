@@ -0,0 +1,203 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockSeriesRepositoryAdd struct {
+	saveFunc     func(ctx context.Context, series entity.Series) error
+	updateFunc   func(ctx context.Context, series entity.Series) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Series, error)
+}
+
+func (m *mockSeriesRepositoryAdd) Save(ctx context.Context, series entity.Series) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryAdd) Update(ctx context.Context, series entity.Series) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryAdd) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Series{}, errors.New("not found")
+}
+
+func (m *mockSeriesRepositoryAdd) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	return entity.Series{}, errors.New("not found")
+}
+
+type AddPostToSeriesCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         AddPostToSeriesCommandHandler
+	MockRepository  *mockSeriesRepositoryAdd
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *AddPostToSeriesCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockSeriesRepositoryAdd{}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = AddPostToSeriesCommandHandler{
+		EventBus:         s.EventBus,
+		SeriesRepository: s.MockRepository,
+		Authorizer:       authorization.Authorizer{},
+	}
+}
+
+func (s *AddPostToSeriesCommandHandlerTestSuite) TestHandle() {
+	testSeriesID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testFirstPostID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	testPostID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name             string
+		postId           uuid.UUID
+		notFound         bool
+		actor            uuid.UUID
+		updateErr        error
+		expectedError    bool
+		expectedUpdate   bool
+		expectedPostIds  []uuid.UUID
+		expectedPosition int
+	}{
+		{
+			name:             "Success",
+			postId:           testPostID,
+			expectedUpdate:   true,
+			expectedPostIds:  []uuid.UUID{testFirstPostID, testPostID},
+			expectedPosition: 2,
+		},
+		{
+			name:   "AlreadyInSeries",
+			postId: testFirstPostID,
+		},
+		{
+			name:          "SeriesNotFound",
+			postId:        testPostID,
+			notFound:      true,
+			expectedError: true,
+		},
+		{
+			name:          "NotOwner",
+			postId:        testPostID,
+			actor:         uuid.New(),
+			expectedError: true,
+		},
+		{
+			name:            "UpdateError",
+			postId:          testPostID,
+			updateErr:       errors.New("database error"),
+			expectedError:   true,
+			expectedUpdate:  true,
+			expectedPostIds: []uuid.UUID{testFirstPostID, testPostID},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			updated := false
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+				if tt.notFound {
+					return entity.Series{}, errors.New("not found")
+				}
+				series := entity.NewSeries(id, time.Now(), testAuthorID, "Go tutorial", "")
+				if err := series.AddPost(testFirstPostID, time.Now()); err != nil {
+					panic(err)
+				}
+				return series, nil
+			}
+			s.MockRepository.updateFunc = func(ctx context.Context, series entity.Series) error {
+				updated = true
+				assert.Equal(t, tt.expectedPostIds, series.PostIds())
+				for i, seriesPost := range series.Posts {
+					assert.Equal(t, testSeriesID, seriesPost.SeriesId)
+					assert.Equal(t, i+1, seriesPost.Position)
+				}
+				return tt.updateErr
+			}
+
+			actor := testAuthorID
+			if tt.actor != uuid.Nil {
+				actor = tt.actor
+			}
+			command := NewAddPostToSeriesCommand(testSeriesID, tt.postId)
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(actor)), &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdate, updated)
+
+			if tt.expectedUpdate && !tt.expectedError {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					addedEvent, ok := s.PublishedEvents[0].(event.PostWasAddedToSeries)
+					assert.True(t, ok)
+					assert.Equal(t, testSeriesID, addedEvent.ID)
+					assert.Equal(t, tt.postId, addedEvent.PostId)
+					assert.Equal(t, tt.expectedPosition, addedEvent.Position)
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func TestAddPostToSeriesCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AddPostToSeriesCommandHandlerTestSuite))
+}
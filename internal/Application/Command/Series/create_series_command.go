@@ -0,0 +1,14 @@
+package command
+
+import "github.com/google/uuid"
+
+type createSeriesCommand struct {
+	Id          uuid.UUID `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Author      uuid.UUID `json:"author"`
+}
+
+func NewCreateSeriesCommand(id uuid.UUID, title string, description string, author uuid.UUID) createSeriesCommand {
+	return createSeriesCommand{Id: id, Title: title, Description: description, Author: author}
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type CreateSeriesCommandHandler struct {
+	EventBus         *cqrs.EventBus
+	SeriesRepository repository.SeriesRepository
+	Authorizer       authorization.Authorizer
+}
+
+func (h CreateSeriesCommandHandler) Handle(ctx context.Context, command *createSeriesCommand) error {
+	if err := h.Authorizer.Authorize(ctx, command.Author, entity.PermissionCreatePosts); err != nil {
+		return err
+	}
+
+	if _, err := h.SeriesRepository.FindByID(ctx, command.Id); err == nil {
+		return nil
+	}
+
+	series := entity.NewSeries(command.Id, time.Now(), command.Author, command.Title, command.Description)
+
+	err := h.SeriesRepository.Save(ctx, series)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewSeriesWasCreated(
+			series.ID,
+			series.CreatedAt,
+			series.AuthorId,
+			series.Title,
+			series.Description,
+		),
+	)
+}
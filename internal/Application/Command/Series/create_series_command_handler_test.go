@@ -0,0 +1,213 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockSeriesRepositoryCreate struct {
+	saveFunc     func(ctx context.Context, series entity.Series) error
+	updateFunc   func(ctx context.Context, series entity.Series) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Series, error)
+}
+
+func (m *mockSeriesRepositoryCreate) Save(ctx context.Context, series entity.Series) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryCreate) Update(ctx context.Context, series entity.Series) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryCreate) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Series{}, errors.New("not found")
+}
+
+func (m *mockSeriesRepositoryCreate) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	return entity.Series{}, errors.New("not found")
+}
+
+type mockUserRepositorySeries struct {
+	user entity.User
+}
+
+func (m *mockUserRepositorySeries) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositorySeries) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	if id != m.user.ID {
+		return entity.User{}, errors.New("record not found")
+	}
+	return m.user, nil
+}
+
+func (m *mockUserRepositorySeries) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
+type CreateSeriesCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         CreateSeriesCommandHandler
+	MockRepository  *mockSeriesRepositoryCreate
+	MockUsers       *mockUserRepositorySeries
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *CreateSeriesCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockSeriesRepositoryCreate{}
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	s.MockUsers = &mockUserRepositorySeries{user: entity.User{
+		ID:    testAuthorID,
+		Roles: []entity.UserRole{{UserId: testAuthorID, Role: entity.RoleAuthor}},
+	}}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = CreateSeriesCommandHandler{
+		EventBus:         s.EventBus,
+		SeriesRepository: s.MockRepository,
+		Authorizer:       authorization.Authorizer{UserRepository: s.MockUsers},
+	}
+}
+
+func (s *CreateSeriesCommandHandlerTestSuite) TestHandle() {
+	testSeriesID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name          string
+		existing      bool
+		saveErr       error
+		expectedError bool
+		expectedSave  bool
+	}{
+		{
+			name:         "Success",
+			expectedSave: true,
+		},
+		{
+			name:     "AlreadyExists",
+			existing: true,
+		},
+		{
+			name:          "SaveError",
+			saveErr:       errors.New("database error"),
+			expectedError: true,
+			expectedSave:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			saved := false
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+				if tt.existing {
+					return entity.Series{ID: id}, nil
+				}
+				return entity.Series{}, errors.New("not found")
+			}
+			s.MockRepository.saveFunc = func(ctx context.Context, series entity.Series) error {
+				saved = true
+				assert.Equal(t, testSeriesID, series.ID)
+				assert.Equal(t, testAuthorID, series.AuthorId)
+				assert.Equal(t, "Go tutorial", series.Title)
+				assert.Equal(t, "Learn Go step by step", series.Description)
+				assert.Empty(t, series.Posts)
+				return tt.saveErr
+			}
+
+			command := NewCreateSeriesCommand(testSeriesID, "Go tutorial", "Learn Go step by step", testAuthorID)
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(testAuthorID)), &command)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSave, saved)
+
+			if tt.expectedSave && !tt.expectedError {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					createdEvent, ok := s.PublishedEvents[0].(event.SeriesWasCreated)
+					assert.True(t, ok)
+					assert.Equal(t, testSeriesID, createdEvent.ID)
+					assert.Equal(t, testAuthorID, createdEvent.AuthorId)
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func (s *CreateSeriesCommandHandlerTestSuite) TestHandleNotAuthorized() {
+	reader := uuid.MustParse("323e4567-e89b-12d3-a456-426614174001")
+	s.MockUsers.user = entity.User{ID: reader, Roles: []entity.UserRole{{UserId: reader, Role: entity.RoleReader}}}
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+		return entity.Series{}, errors.New("not found")
+	}
+	s.MockRepository.saveFunc = func(ctx context.Context, series entity.Series) error {
+		s.T().Error("Save should not be called when the actor may not create series")
+		return nil
+	}
+
+	command := NewCreateSeriesCommand(uuid.New(), "Go tutorial", "", reader)
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(reader)), &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestCreateSeriesCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateSeriesCommandHandlerTestSuite))
+}
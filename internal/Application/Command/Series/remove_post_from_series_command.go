@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type removePostFromSeriesCommand struct {
+	SeriesId uuid.UUID `json:"series_id"`
+	PostId   uuid.UUID `json:"post_id"`
+}
+
+func NewRemovePostFromSeriesCommand(seriesId uuid.UUID, postId uuid.UUID) removePostFromSeriesCommand {
+	return removePostFromSeriesCommand{SeriesId: seriesId, PostId: postId}
+}
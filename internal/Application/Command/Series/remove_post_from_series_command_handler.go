@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type RemovePostFromSeriesCommandHandler struct {
+	EventBus         *cqrs.EventBus
+	SeriesRepository repository.SeriesRepository
+	Authorizer       authorization.Authorizer
+}
+
+func (h RemovePostFromSeriesCommandHandler) Handle(ctx context.Context, command *removePostFromSeriesCommand) error {
+	series, err := h.SeriesRepository.FindByID(ctx, command.SeriesId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeOwner(ctx, series.AuthorId); err != nil {
+		return err
+	}
+
+	err = series.RemovePost(command.PostId, time.Now())
+	if errors.Is(err, entity.ErrPostNotInSeries) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = h.SeriesRepository.Update(ctx, series)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewPostWasRemovedFromSeries(
+			series.ID,
+			series.UpdatedAt,
+			command.PostId,
+		),
+	)
+}
@@ -0,0 +1,12 @@
+package command
+
+import "github.com/google/uuid"
+
+type reorderSeriesPostsCommand struct {
+	SeriesId uuid.UUID   `json:"series_id"`
+	PostIds  []uuid.UUID `json:"post_ids"`
+}
+
+func NewReorderSeriesPostsCommand(seriesId uuid.UUID, postIds []uuid.UUID) reorderSeriesPostsCommand {
+	return reorderSeriesPostsCommand{SeriesId: seriesId, PostIds: postIds}
+}
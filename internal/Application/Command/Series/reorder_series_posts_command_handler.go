@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"slices"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type ReorderSeriesPostsCommandHandler struct {
+	EventBus         *cqrs.EventBus
+	SeriesRepository repository.SeriesRepository
+	Authorizer       authorization.Authorizer
+}
+
+// Handle fails with entity.ErrSeriesOrderMismatch when the membership changed
+// since the order was sent, the order is not applied partially.
+func (h ReorderSeriesPostsCommandHandler) Handle(ctx context.Context, command *reorderSeriesPostsCommand) error {
+	series, err := h.SeriesRepository.FindByID(ctx, command.SeriesId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Authorizer.AuthorizeOwner(ctx, series.AuthorId); err != nil {
+		return err
+	}
+
+	if slices.Equal(series.PostIds(), command.PostIds) {
+		return nil
+	}
+
+	err = series.Reorder(command.PostIds, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.SeriesRepository.Update(ctx, series)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewSeriesWasReordered(
+			series.ID,
+			series.UpdatedAt,
+			series.PostIds(),
+		),
+	)
+}
@@ -0,0 +1,196 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockSeriesRepositoryReorder struct {
+	saveFunc     func(ctx context.Context, series entity.Series) error
+	updateFunc   func(ctx context.Context, series entity.Series) error
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Series, error)
+}
+
+func (m *mockSeriesRepositoryReorder) Save(ctx context.Context, series entity.Series) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryReorder) Update(ctx context.Context, series entity.Series) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepositoryReorder) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Series{}, errors.New("not found")
+}
+
+func (m *mockSeriesRepositoryReorder) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	return entity.Series{}, errors.New("not found")
+}
+
+type ReorderSeriesPostsCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler         ReorderSeriesPostsCommandHandler
+	MockRepository  *mockSeriesRepositoryReorder
+	EventBus        *cqrs.EventBus
+	PublishedEvents []interface{}
+}
+
+func (s *ReorderSeriesPostsCommandHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockSeriesRepositoryReorder{}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = ReorderSeriesPostsCommandHandler{
+		EventBus:         s.EventBus,
+		SeriesRepository: s.MockRepository,
+		Authorizer:       authorization.Authorizer{},
+	}
+}
+
+func (s *ReorderSeriesPostsCommandHandlerTestSuite) TestHandle() {
+	testSeriesID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	first := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	second := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	third := uuid.MustParse("223e4567-e89b-12d3-a456-426614174002")
+	testAuthorID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name           string
+		postIds        []uuid.UUID
+		actor          uuid.UUID
+		expectedError  error
+		expectedUpdate bool
+	}{
+		{
+			name:           "Success",
+			postIds:        []uuid.UUID{third, first, second},
+			expectedUpdate: true,
+		},
+		{
+			name:    "SameOrder",
+			postIds: []uuid.UUID{first, second, third},
+		},
+		{
+			name:          "MissingPost",
+			postIds:       []uuid.UUID{third, first},
+			expectedError: entity.ErrSeriesOrderMismatch,
+		},
+		{
+			name:          "DuplicatePost",
+			postIds:       []uuid.UUID{third, first, first},
+			expectedError: entity.ErrSeriesOrderMismatch,
+		},
+		{
+			name:          "NotOwner",
+			postIds:       []uuid.UUID{third, first, second},
+			actor:         uuid.New(),
+			expectedError: authorization.ErrPermissionDenied,
+		},
+		{
+			name:          "UnknownPost",
+			postIds:       []uuid.UUID{third, first, uuid.New()},
+			expectedError: entity.ErrSeriesOrderMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.PublishedEvents = make([]interface{}, 0)
+			updated := false
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+				series := entity.NewSeries(id, time.Now(), testAuthorID, "Go tutorial", "")
+				for _, postId := range []uuid.UUID{first, second, third} {
+					if err := series.AddPost(postId, time.Now()); err != nil {
+						panic(err)
+					}
+				}
+				return series, nil
+			}
+			s.MockRepository.updateFunc = func(ctx context.Context, series entity.Series) error {
+				updated = true
+				assert.Equal(t, tt.postIds, series.PostIds())
+				for i, seriesPost := range series.Posts {
+					assert.Equal(t, i+1, seriesPost.Position)
+				}
+				return nil
+			}
+
+			actor := testAuthorID
+			if tt.actor != uuid.Nil {
+				actor = tt.actor
+			}
+			command := NewReorderSeriesPostsCommand(testSeriesID, tt.postIds)
+			err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(actor)), &command)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdate, updated)
+
+			if tt.expectedUpdate {
+				assert.Len(t, s.PublishedEvents, 1)
+				if len(s.PublishedEvents) > 0 {
+					reorderedEvent, ok := s.PublishedEvents[0].(event.SeriesWasReordered)
+					assert.True(t, ok)
+					assert.Equal(t, testSeriesID, reorderedEvent.ID)
+					assert.Equal(t, tt.postIds, reorderedEvent.PostIds)
+				}
+			} else {
+				assert.Equal(t, 0, len(s.PublishedEvents))
+			}
+		})
+	}
+}
+
+func TestReorderSeriesPostsCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReorderSeriesPostsCommandHandlerTestSuite))
+}
@@ -34,6 +34,11 @@ func (h CreateUserCommandHandler) Handle(ctx context.Context, command *CreateUse
 		return nil
 	}
 
+	// The default role is saved together with the user.
+	if _, err := user.GrantRole(entity.DefaultRole, nil, user.CreatedAt); err != nil {
+		return err
+	}
+
 	err := h.UserRepository.Save(ctx, user)
 	if err != nil {
 		return err
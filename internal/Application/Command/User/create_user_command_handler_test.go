@@ -135,6 +135,7 @@ func (s *CreateUserCommandHandlerTestSuite) TestHandle() {
 					assert.Equal(s.T(), "User", user.LastName)
 					assert.Equal(s.T(), "provider123", user.ProviderUserId)
 					assert.Equal(s.T(), "https://example.com/avatar.jpg", user.AvatarURL)
+					assert.Equal(s.T(), []string{"author"}, user.RoleNames())
 					return nil
 				}
 			},
@@ -0,0 +1,13 @@
+package command
+
+import "github.com/google/uuid"
+
+type GrantRoleCommand struct {
+	UserId    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	GrantedBy uuid.UUID `json:"granted_by"`
+}
+
+func NewGrantRoleCommand(userId uuid.UUID, role string, grantedBy uuid.UUID) GrantRoleCommand {
+	return GrantRoleCommand{UserId: userId, Role: role, GrantedBy: grantedBy}
+}
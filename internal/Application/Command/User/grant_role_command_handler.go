@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type GrantRoleCommandHandler struct {
+	EventBus           *cqrs.EventBus
+	UserRepository     repository.UserRepository
+	UserRoleRepository repository.UserRoleRepository
+	Authorizer         authorization.Authorizer
+}
+
+// Handle grants the role once the granting user is allowed to manage roles.
+// Granting a role the user already has, e.g. when the message is
+// redelivered, is a no-op.
+func (h GrantRoleCommandHandler) Handle(ctx context.Context, command *GrantRoleCommand) error {
+	if err := h.Authorizer.Authorize(ctx, command.GrantedBy, entity.PermissionManageRoles); err != nil {
+		return err
+	}
+
+	user, err := h.UserRepository.FindByID(ctx, command.UserId)
+	if err != nil {
+		return err
+	}
+
+	role := entity.Role(command.Role)
+	if user.HasRole(role) {
+		return nil
+	}
+
+	userRole, err := user.GrantRole(role, &command.GrantedBy, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.UserRoleRepository.Save(ctx, userRole)
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewRoleWasGranted(
+			user.ID,
+			command.Role,
+			userRole.GrantedAt,
+			command.GrantedBy,
+		),
+	)
+}
@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockUserRepositoryRole struct {
+	users map[uuid.UUID]entity.User
+}
+
+func (m *mockUserRepositoryRole) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryRole) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return entity.User{}, errors.New("record not found")
+	}
+	return user, nil
+}
+
+func (m *mockUserRepositoryRole) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, errors.New("not implemented")
+}
+
+type mockUserRoleRepository struct {
+	saved []entity.UserRole
+}
+
+func (m *mockUserRoleRepository) Save(ctx context.Context, userRole entity.UserRole) error {
+	m.saved = append(m.saved, userRole)
+	return nil
+}
+
+func (m *mockUserRoleRepository) Delete(ctx context.Context, userId uuid.UUID, role entity.Role) error {
+	return nil
+}
+
+type GrantRoleCommandHandlerTestSuite struct {
+	suite.Suite
+	Handler                GrantRoleCommandHandler
+	MockRepository         *mockUserRepositoryRole
+	MockUserRoleRepository *mockUserRoleRepository
+	EventBus               *cqrs.EventBus
+	PublishedEvents        []any
+	AdminId                uuid.UUID
+	AuthorId               uuid.UUID
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) SetupTest() {
+	s.AdminId = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	s.AuthorId = uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	s.MockRepository = &mockUserRepositoryRole{users: map[uuid.UUID]entity.User{
+		s.AdminId: {
+			ID:    s.AdminId,
+			Roles: []entity.UserRole{{UserId: s.AdminId, Role: entity.RoleAdmin}},
+		},
+		s.AuthorId: {
+			ID:    s.AuthorId,
+			Roles: []entity.UserRole{{UserId: s.AuthorId, Role: entity.RoleAuthor}},
+		},
+	}}
+	s.MockUserRoleRepository = &mockUserRoleRepository{}
+	s.PublishedEvents = make([]interface{}, 0)
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	marshaller := cqrs.JSONMarshaler{}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: marshaller,
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.EventBus = eventBus
+
+	s.Handler = GrantRoleCommandHandler{
+		EventBus:           s.EventBus,
+		UserRepository:     s.MockRepository,
+		UserRoleRepository: s.MockUserRoleRepository,
+		Authorizer:         authorization.Authorizer{UserRepository: s.MockRepository},
+	}
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandle() {
+	command := NewGrantRoleCommand(s.AuthorId, "editor", s.AdminId)
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(command.GrantedBy)), &command)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MockUserRoleRepository.saved, 1)
+	assert.Equal(s.T(), s.AuthorId, s.MockUserRoleRepository.saved[0].UserId)
+	assert.Equal(s.T(), entity.RoleEditor, s.MockUserRoleRepository.saved[0].Role)
+	assert.Equal(s.T(), &s.AdminId, s.MockUserRoleRepository.saved[0].GrantedBy)
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	grantedEvent, ok := s.PublishedEvents[0].(event.RoleWasGranted)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), s.AuthorId, grantedEvent.UserId)
+	assert.Equal(s.T(), "editor", grantedEvent.Role)
+	assert.Equal(s.T(), s.AdminId, grantedEvent.GrantedBy)
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandleRoleAlreadyGranted() {
+	command := NewGrantRoleCommand(s.AuthorId, "author", s.AdminId)
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(command.GrantedBy)), &command)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MockUserRoleRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandleInvalidRole() {
+	command := NewGrantRoleCommand(s.AuthorId, "owner", s.AdminId)
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(command.GrantedBy)), &command)
+
+	assert.ErrorIs(s.T(), err, entity.ErrInvalidRole)
+	assert.Empty(s.T(), s.MockUserRoleRepository.saved)
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandlePermissionDenied() {
+	command := NewGrantRoleCommand(s.AuthorId, "admin", s.AuthorId)
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(command.GrantedBy)), &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockUserRoleRepository.saved)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandleOnBehalfOfAnotherUser() {
+	ctx := authorization.WithActor(context.Background(), authorization.NewUserActor(s.AuthorId))
+	command := NewGrantRoleCommand(s.AuthorId, "admin", s.AdminId)
+
+	err := s.Handler.Handle(ctx, &command)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrPermissionDenied)
+	assert.Empty(s.T(), s.MockUserRoleRepository.saved)
+}
+
+func (s *GrantRoleCommandHandlerTestSuite) TestHandleUserNotFound() {
+	command := NewGrantRoleCommand(uuid.New(), "editor", s.AdminId)
+
+	err := s.Handler.Handle(authorization.WithActor(context.Background(), authorization.NewUserActor(command.GrantedBy)), &command)
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func TestGrantRoleCommandHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GrantRoleCommandHandlerTestSuite))
+}
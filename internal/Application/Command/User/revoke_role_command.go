@@ -0,0 +1,13 @@
+package command
+
+import "github.com/google/uuid"
+
+type RevokeRoleCommand struct {
+	UserId    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	RevokedBy uuid.UUID `json:"revoked_by"`
+}
+
+func NewRevokeRoleCommand(userId uuid.UUID, role string, revokedBy uuid.UUID) RevokeRoleCommand {
+	return RevokeRoleCommand{UserId: userId, Role: role, RevokedBy: revokedBy}
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+type RevokeRoleCommandHandler struct {
+	EventBus           *cqrs.EventBus
+	UserRepository     repository.UserRepository
+	UserRoleRepository repository.UserRoleRepository
+	Authorizer         authorization.Authorizer
+}
+
+// Handle revokes the role once the revoking user is allowed to manage roles.
+// Revoking a role the user does not have, e.g. when the message is
+// redelivered, is a no-op.
+func (h RevokeRoleCommandHandler) Handle(ctx context.Context, command *RevokeRoleCommand) error {
+	if err := h.Authorizer.Authorize(ctx, command.RevokedBy, entity.PermissionManageRoles); err != nil {
+		return err
+	}
+
+	user, err := h.UserRepository.FindByID(ctx, command.UserId)
+	if err != nil {
+		return err
+	}
+
+	revokedAt := time.Now()
+	if err := user.RevokeRole(entity.Role(command.Role), command.RevokedBy, revokedAt); err != nil {
+		if errors.Is(err, entity.ErrRoleNotGranted) {
+			return nil
+		}
+		return err
+	}
+
+	err = h.UserRoleRepository.Delete(ctx, user.ID, entity.Role(command.Role))
+	if err != nil {
+		return err
+	}
+
+	return h.EventBus.Publish(
+		ctx,
+		event.NewRoleWasRevoked(
+			user.ID,
+			command.Role,
+			revokedAt,
+			command.RevokedBy,
+		),
+	)
+}
@@ -0,0 +1,23 @@
+package event_handler
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	moderation "main/internal/Domain/Moderation"
+	repository "main/internal/Domain/Repository"
+)
+
+// TrainModerationEventHandler feeds the decisions post authors make in the
+// moderation queue into the naive-Bayes classifier.
+type TrainModerationEventHandler struct {
+	ModerationTrainingRepository repository.ModerationTrainingRepository
+}
+
+func (h TrainModerationEventHandler) HandleCommentWasApproved(ctx context.Context, e *event.CommentWasApproved) error {
+	return h.ModerationTrainingRepository.Train(ctx, entity.CommentStatusApproved, moderation.Tokenize(e.Content))
+}
+
+func (h TrainModerationEventHandler) HandleCommentWasRejected(ctx context.Context, e *event.CommentWasRejected) error {
+	return h.ModerationTrainingRepository.Train(ctx, entity.CommentStatusRejected, moderation.Tokenize(e.Content))
+}
@@ -0,0 +1,17 @@
+package event_handler
+
+import (
+	"context"
+	media "main/internal/Application/Media"
+	event "main/internal/Domain/Event"
+)
+
+// GenerateMediaVariantsEventHandler resizes uploaded images in the consumer,
+// so uploads do not wait for the image processing.
+type GenerateMediaVariantsEventHandler struct {
+	MediaVariantGenerator media.MediaVariantGenerator
+}
+
+func (h GenerateMediaVariantsEventHandler) HandleMediaWasUploaded(ctx context.Context, e *event.MediaWasUploaded) error {
+	return h.MediaVariantGenerator.Generate(ctx, e.ID)
+}
@@ -0,0 +1,41 @@
+package event_handler
+
+import (
+	"context"
+	sitemap "main/internal/Application/Sitemap"
+	event "main/internal/Domain/Event"
+)
+
+// GenerateSitemapEventHandler rebuilds the sitemap whenever a post may have
+// been added to it, changed or removed from it.
+type GenerateSitemapEventHandler struct {
+	SitemapGenerator sitemap.SitemapGenerator
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasCreated(ctx context.Context, e *event.PostWasCreated) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasUpdated(ctx context.Context, e *event.PostWasUpdated) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasPublished(ctx context.Context, e *event.PostWasPublished) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasUnpublished(ctx context.Context, e *event.PostWasUnpublished) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasArchived(ctx context.Context, e *event.PostWasArchived) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasDeleted(ctx context.Context, e *event.PostWasDeleted) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
+
+func (h GenerateSitemapEventHandler) HandlePostWasRestored(ctx context.Context, e *event.PostWasRestored) error {
+	return h.SitemapGenerator.Generate(ctx)
+}
@@ -0,0 +1,42 @@
+package event_handler
+
+import (
+	"context"
+	search "main/internal/Application/Search"
+	event "main/internal/Domain/Event"
+)
+
+// IndexPostEventHandler keeps the post search index in sync with the posts
+// table. Status changes are handled too, as the index filters unpublished
+// posts the same way the database queries do.
+type IndexPostEventHandler struct {
+	PostIndexer search.PostIndexer
+}
+
+func (h IndexPostEventHandler) HandlePostWasCreated(ctx context.Context, e *event.PostWasCreated) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasUpdated(ctx context.Context, e *event.PostWasUpdated) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasPublished(ctx context.Context, e *event.PostWasPublished) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasUnpublished(ctx context.Context, e *event.PostWasUnpublished) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasArchived(ctx context.Context, e *event.PostWasArchived) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasDeleted(ctx context.Context, e *event.PostWasDeleted) error {
+	return h.PostIndexer.RemovePost(ctx, e.ID)
+}
+
+func (h IndexPostEventHandler) HandlePostWasRestored(ctx context.Context, e *event.PostWasRestored) error {
+	return h.PostIndexer.IndexPost(ctx, e.ID)
+}
@@ -0,0 +1,38 @@
+package event_handler
+
+import (
+	"context"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+)
+
+// InvalidateFeedsEventHandler drops the cached feeds whenever a post may have
+// entered, changed in or left them. Created posts are drafts and never
+// appear in a feed.
+type InvalidateFeedsEventHandler struct {
+	FeedCache repository.FeedCache
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasUpdated(ctx context.Context, e *event.PostWasUpdated) error {
+	return h.FeedCache.Invalidate(ctx)
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasPublished(ctx context.Context, e *event.PostWasPublished) error {
+	return h.FeedCache.Invalidate(ctx)
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasUnpublished(ctx context.Context, e *event.PostWasUnpublished) error {
+	return h.FeedCache.Invalidate(ctx)
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasArchived(ctx context.Context, e *event.PostWasArchived) error {
+	return h.FeedCache.Invalidate(ctx)
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasDeleted(ctx context.Context, e *event.PostWasDeleted) error {
+	return h.FeedCache.Invalidate(ctx)
+}
+
+func (h InvalidateFeedsEventHandler) HandlePostWasRestored(ctx context.Context, e *event.PostWasRestored) error {
+	return h.FeedCache.Invalidate(ctx)
+}
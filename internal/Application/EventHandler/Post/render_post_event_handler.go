@@ -0,0 +1,21 @@
+package event_handler
+
+import (
+	"context"
+	rendering "main/internal/Application/Rendering"
+	event "main/internal/Domain/Event"
+)
+
+// RenderPostEventHandler refreshes the cached HTML and table of contents
+// whenever the content of a post may have changed.
+type RenderPostEventHandler struct {
+	PostRenderer rendering.PostRenderer
+}
+
+func (h RenderPostEventHandler) HandlePostWasCreated(ctx context.Context, e *event.PostWasCreated) error {
+	return h.PostRenderer.RenderPost(ctx, e.ID)
+}
+
+func (h RenderPostEventHandler) HandlePostWasUpdated(ctx context.Context, e *event.PostWasUpdated) error {
+	return h.PostRenderer.RenderPost(ctx, e.ID)
+}
@@ -0,0 +1,104 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"io"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+)
+
+const maxFileNameLength = 255
+
+// MediaUploader stores uploaded files. Unlike other writes, uploads do not
+// go through the command bus, as files are too large to be sent as messages;
+// MediaWasUploaded is published once both the file and its record are stored.
+type MediaUploader struct {
+	MediaRepository repository.MediaRepository
+	Storage         repository.Storage
+	EventBus        *cqrs.EventBus
+	// MaxSize limits a single file, Quota the files of one user, in bytes.
+	MaxSize int64
+	Quota   int64
+}
+
+// Upload reads at most MaxSize bytes of content and sniffs its type from the
+// data, ignoring whatever type the client claimed. Metadata is stripped
+// before the file is stored, as originals are public right away.
+func (u MediaUploader) Upload(ctx context.Context, id uuid.UUID, ownerId uuid.UUID, fileName string, content io.Reader) (entity.Media, error) {
+	data, err := io.ReadAll(io.LimitReader(content, u.MaxSize+1))
+	if err != nil {
+		return entity.Media{}, err
+	}
+	if int64(len(data)) > u.MaxSize {
+		return entity.Media{}, entity.ErrMediaTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	data = stripMetadata(data, contentType)
+
+	media, err := entity.NewMedia(id, time.Now(), ownerId, sanitizeFileName(fileName), contentType, int64(len(data)))
+	if err != nil {
+		return entity.Media{}, err
+	}
+
+	// Checked up front to avoid storing a file that is rejected anyway;
+	// SaveWithinQuota has the final say.
+	used, err := u.MediaRepository.UsedBytes(ctx, ownerId)
+	if err != nil {
+		return entity.Media{}, err
+	}
+	if used+media.Size > u.Quota {
+		return entity.Media{}, entity.ErrMediaQuotaExceeded
+	}
+
+	if err := u.Storage.Put(ctx, media.StorageKey, bytes.NewReader(data), media.Size, media.ContentType); err != nil {
+		return entity.Media{}, err
+	}
+
+	if err := u.MediaRepository.SaveWithinQuota(ctx, media, u.Quota); err != nil {
+		// Best effort, the record is what counts against the quota.
+		_ = u.Storage.Delete(ctx, media.StorageKey)
+		return entity.Media{}, err
+	}
+
+	err = u.EventBus.Publish(
+		ctx,
+		event.NewMediaWasUploaded(
+			media.ID,
+			media.CreatedAt,
+			media.OwnerId,
+			media.StorageKey,
+			media.ContentType,
+			media.Size,
+		),
+	)
+	if err != nil {
+		return entity.Media{}, err
+	}
+
+	return media, nil
+}
+
+// sanitizeFileName keeps the base name of what the client sent, as browsers
+// may send full paths, and caps it at a length that fits any file system.
+func sanitizeFileName(fileName string) string {
+	fileName = path.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if fileName == "." || fileName == "/" {
+		return ""
+	}
+	for len(fileName) > maxFileNameLength {
+		_, size := utf8.DecodeLastRuneInString(fileName)
+		fileName = fileName[:len(fileName)-size]
+	}
+	return fileName
+}
@@ -0,0 +1,208 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	entity "main/internal/Domain/Entity"
+	event "main/internal/Domain/Event"
+	repository "main/internal/Domain/Repository"
+	"strings"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsqlitemodernc "github.com/ThreeDotsLabs/watermill-sqlite/wmsqlitemodernc"
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type mockMediaRepository struct {
+	saved         []entity.Media
+	used          int64
+	saveErr       error
+	savedVariants []entity.Media
+}
+
+func (m *mockMediaRepository) SaveWithinQuota(ctx context.Context, media entity.Media, quota int64) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.saved = append(m.saved, media)
+	return nil
+}
+
+func (m *mockMediaRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Media, error) {
+	for _, media := range m.saved {
+		if media.ID == id {
+			return media, nil
+		}
+	}
+	return entity.Media{}, errors.New("record not found")
+}
+
+func (m *mockMediaRepository) UsedBytes(ctx context.Context, ownerId uuid.UUID) (int64, error) {
+	return m.used, nil
+}
+
+func (m *mockMediaRepository) SaveVariants(ctx context.Context, media entity.Media) error {
+	m.savedVariants = append(m.savedVariants, media)
+	return nil
+}
+
+type mockStorage struct {
+	objects map[string][]byte
+}
+
+func (m *mockStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	m.objects[key] = data
+	return nil
+}
+
+func (m *mockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, repository.ErrStorageObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *mockStorage) Delete(ctx context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *mockStorage) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+type MediaUploaderTestSuite struct {
+	suite.Suite
+	Uploader        MediaUploader
+	MediaRepository *mockMediaRepository
+	Storage         *mockStorage
+	PublishedEvents []interface{}
+	OwnerID         uuid.UUID
+	MediaID         uuid.UUID
+}
+
+func (s *MediaUploaderTestSuite) SetupTest() {
+	s.MediaRepository = &mockMediaRepository{}
+	s.Storage = &mockStorage{objects: map[string][]byte{}}
+	s.PublishedEvents = make([]interface{}, 0)
+	s.OwnerID = uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	s.MediaID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	db, _ := sql.Open("sqlite", ":memory:")
+	db.SetMaxOpenConns(1)
+	publisher, err := wmsqlitemodernc.NewPublisher(db, wmsqlitemodernc.PublisherOptions{
+		InitializeSchema: true,
+		Logger:           watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := cqrs.NewEventBusWithConfig(publisher, cqrs.EventBusConfig{
+		GeneratePublishTopic: func(params cqrs.GenerateEventPublishTopicParams) (string, error) {
+			return "events." + params.EventName, nil
+		},
+		OnPublish: func(params cqrs.OnEventSendParams) error {
+			s.PublishedEvents = append(s.PublishedEvents, params.Event)
+			return nil
+		},
+		Marshaler: cqrs.JSONMarshaler{},
+		Logger:    watermill.NopLogger{},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	s.Uploader = MediaUploader{
+		MediaRepository: s.MediaRepository,
+		Storage:         s.Storage,
+		EventBus:        eventBus,
+		MaxSize:         64,
+		Quota:           100,
+	}
+}
+
+func (s *MediaUploaderTestSuite) TestUpload() {
+	media, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, `C:\Users\jane\photo.png`, bytes.NewReader(pngHeader))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "image/png", media.ContentType)
+	assert.Equal(s.T(), "photo.png", media.FileName)
+	assert.Equal(s.T(), int64(len(pngHeader)), media.Size)
+	assert.Equal(s.T(), s.OwnerID.String()+"/"+s.MediaID.String()+".png", media.StorageKey)
+	assert.Equal(s.T(), pngHeader, s.Storage.objects[media.StorageKey])
+	assert.Equal(s.T(), []entity.Media{media}, s.MediaRepository.saved)
+
+	assert.Len(s.T(), s.PublishedEvents, 1)
+	uploaded, ok := s.PublishedEvents[0].(event.MediaWasUploaded)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), s.MediaID, uploaded.ID)
+	assert.Equal(s.T(), media.StorageKey, uploaded.StorageKey)
+}
+
+func (s *MediaUploaderTestSuite) TestUploadStripsMetadata() {
+	s.Uploader.MaxSize = 1 << 20
+	s.Uploader.Quota = 1 << 20
+
+	media, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, "photo.jpg", bytes.NewReader(jpegWithExif(testImage(8, 4), 6)))
+
+	assert.NoError(s.T(), err)
+	stored := s.Storage.objects[media.StorageKey]
+	assert.NotContains(s.T(), string(stored), "Canon")
+	assert.Equal(s.T(), 6, imageOrientation(stored, "image/jpeg"))
+	assert.Equal(s.T(), int64(len(stored)), media.Size)
+}
+
+func (s *MediaUploaderTestSuite) TestUploadTooLarge() {
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0}, 64)...)
+
+	_, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, "photo.png", bytes.NewReader(content))
+
+	assert.ErrorIs(s.T(), err, entity.ErrMediaTooLarge)
+	assert.Empty(s.T(), s.Storage.objects)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *MediaUploaderTestSuite) TestUploadIgnoresClaimedType() {
+	_, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, "photo.png", strings.NewReader("<svg onload=\"alert(1)\"></svg>"))
+
+	assert.ErrorIs(s.T(), err, entity.ErrMediaTypeNotAllowed)
+	assert.Empty(s.T(), s.Storage.objects)
+}
+
+func (s *MediaUploaderTestSuite) TestUploadQuotaExceeded() {
+	s.MediaRepository.used = 90
+
+	_, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, "photo.png", bytes.NewReader(pngHeader))
+
+	assert.ErrorIs(s.T(), err, entity.ErrMediaQuotaExceeded)
+	assert.Empty(s.T(), s.Storage.objects)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func (s *MediaUploaderTestSuite) TestUploadSaveErrorDeletesFile() {
+	s.MediaRepository.saveErr = errors.New("save error")
+
+	_, err := s.Uploader.Upload(context.Background(), s.MediaID, s.OwnerID, "photo.png", bytes.NewReader(pngHeader))
+
+	assert.EqualError(s.T(), err, "save error")
+	assert.Empty(s.T(), s.Storage.objects)
+	assert.Empty(s.T(), s.PublishedEvents)
+}
+
+func TestMediaUploaderTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaUploaderTestSuite))
+}
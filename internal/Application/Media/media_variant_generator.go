@@ -0,0 +1,198 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	webp "main/internal/Infrastructure/Webp"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// maxVariantSourcePixels keeps decompression bombs, small files that
+	// decode to huge images, from exhausting the consumer's memory.
+	maxVariantSourcePixels = 50_000_000
+	variantJPEGQuality     = 85
+)
+
+// variantSizes are the variants generated for every image, each fitting a
+// square of maxDimension pixels. Variants that would be larger than the
+// original are skipped, except for the thumbnail.
+var variantSizes = []variantSize{
+	{name: "thumbnail", maxDimension: 320},
+	{name: "medium", maxDimension: 768},
+	{name: "large", maxDimension: 1536},
+}
+
+type variantSize struct {
+	name         string
+	maxDimension int
+}
+
+// MediaVariantGenerator resizes uploaded images for responsive images. Every
+// size is encoded as WebP and, except for WebP originals, in the format of
+// the original too, for clients without WebP support. GIFs lose their
+// animation, variants show the first frame.
+type MediaVariantGenerator struct {
+	MediaRepository repository.MediaRepository
+	Storage         repository.Storage
+}
+
+// Generate replaces the variants of the media, so it can run again for the
+// same media, e.g. when a message is redelivered. Files that cannot be
+// decoded get no variants instead of an error, as retrying would not help.
+func (g MediaVariantGenerator) Generate(ctx context.Context, mediaId uuid.UUID) error {
+	media, err := g.MediaRepository.FindByID(ctx, mediaId)
+	if err != nil {
+		return err
+	}
+
+	reader, err := g.Storage.Get(ctx, media.StorageKey)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		return err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width*config.Height > maxVariantSourcePixels {
+		return nil
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	img = applyOrientation(img, imageOrientation(data, media.ContentType))
+
+	bounds := img.Bounds()
+	media.Width, media.Height = bounds.Dx(), bounds.Dy()
+	media.Variants = nil
+	for i, size := range variantSizes {
+		if i > 0 && max(media.Width, media.Height) <= size.maxDimension {
+			break
+		}
+
+		resized := resize(img, size.maxDimension)
+		for _, contentType := range variantContentTypes(media.ContentType) {
+			variant, err := g.storeVariant(ctx, media, size.name, contentType, resized)
+			if err != nil {
+				return err
+			}
+			media.Variants = append(media.Variants, variant)
+		}
+	}
+
+	return g.MediaRepository.SaveVariants(ctx, media)
+}
+
+func (g MediaVariantGenerator) storeVariant(ctx context.Context, media entity.Media, name string, contentType string, img image.Image) (entity.MediaVariant, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: variantJPEGQuality})
+	case "image/png":
+		err = png.Encode(&buf, img)
+	default:
+		err = webp.Encode(&buf, img)
+	}
+	if err != nil {
+		return entity.MediaVariant{}, err
+	}
+
+	bounds := img.Bounds()
+	variant := entity.NewMediaVariant(uuid.New(), media, name, contentType, bounds.Dx(), bounds.Dy(), int64(buf.Len()))
+	if err := g.Storage.Put(ctx, variant.StorageKey, &buf, variant.Size, variant.ContentType); err != nil {
+		return entity.MediaVariant{}, err
+	}
+	return variant, nil
+}
+
+// variantContentTypes returns the formats variants of an original of the
+// given type are encoded in. GIF variants are PNGs, as the standard library
+// GIF encoder would reduce them to 256 colors after resampling.
+func variantContentTypes(contentType string) []string {
+	switch contentType {
+	case "image/jpeg":
+		return []string{"image/jpeg", "image/webp"}
+	case "image/png", "image/gif":
+		return []string{"image/png", "image/webp"}
+	default:
+		return []string{"image/webp"}
+	}
+}
+
+// resize scales img down to fit a square of maxDimension pixels, keeping its
+// aspect ratio. Smaller images are returned as they are.
+func resize(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxDimension && height <= maxDimension {
+		return img
+	}
+	if width >= height {
+		height = max(1, height*maxDimension/width)
+		width = maxDimension
+	} else {
+		width = max(1, width*maxDimension/height)
+		height = maxDimension
+	}
+
+	resized := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(resized, resized.Bounds(), img, bounds, draw.Src, nil)
+	return resized
+}
+
+// applyOrientation turns the stored pixels upright, so that variants look
+// the same without their EXIF orientation.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	// Orientations 5 to 8 swap the axes.
+	transposed := orientation >= 5
+	outWidth, outHeight := width, height
+	if transposed {
+		outWidth, outHeight = height, width
+	}
+
+	oriented := image.NewRGBA(image.Rect(0, 0, outWidth, outHeight))
+	for y := range height {
+		for x := range width {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			oriented.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return oriented
+}
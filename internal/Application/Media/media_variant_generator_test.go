@@ -0,0 +1,152 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	xwebp "golang.org/x/image/webp"
+)
+
+type MediaVariantGeneratorTestSuite struct {
+	suite.Suite
+	Generator       MediaVariantGenerator
+	MediaRepository *mockMediaRepository
+	Storage         *mockStorage
+}
+
+func (s *MediaVariantGeneratorTestSuite) SetupTest() {
+	s.MediaRepository = &mockMediaRepository{}
+	s.Storage = &mockStorage{objects: map[string][]byte{}}
+	s.Generator = MediaVariantGenerator{
+		MediaRepository: s.MediaRepository,
+		Storage:         s.Storage,
+	}
+}
+
+func (s *MediaVariantGeneratorTestSuite) store(contentType string, data []byte) entity.Media {
+	media, err := entity.NewMedia(uuid.New(), time.Now(), uuid.New(), "photo", contentType, int64(len(data)))
+	if err != nil {
+		panic(err)
+	}
+	s.MediaRepository.saved = append(s.MediaRepository.saved, media)
+	s.Storage.objects[media.StorageKey] = data
+	return media
+}
+
+func (s *MediaVariantGeneratorTestSuite) TestGenerate() {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(1000, 500)); err != nil {
+		panic(err)
+	}
+	media := s.store("image/png", buf.Bytes())
+
+	err := s.Generator.Generate(context.Background(), media.ID)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.MediaRepository.savedVariants, 1)
+	saved := s.MediaRepository.savedVariants[0]
+	assert.Equal(s.T(), 1000, saved.Width)
+	assert.Equal(s.T(), 500, saved.Height)
+
+	// The original is smaller than the large size, which is skipped.
+	names := make([]string, len(saved.Variants))
+	for i, variant := range saved.Variants {
+		names[i] = variant.Name + " " + variant.ContentType
+	}
+	assert.Equal(s.T(), []string{"thumbnail image/png", "thumbnail image/webp", "medium image/png", "medium image/webp"}, names)
+
+	thumbnail := saved.Variants[1]
+	assert.Equal(s.T(), 320, thumbnail.Width)
+	assert.Equal(s.T(), 160, thumbnail.Height)
+	assert.Equal(s.T(), media.OwnerId.String()+"/"+media.ID.String()+"-thumbnail.webp", thumbnail.StorageKey)
+	assert.Equal(s.T(), int64(len(s.Storage.objects[thumbnail.StorageKey])), thumbnail.Size)
+	img, err := xwebp.Decode(bytes.NewReader(s.Storage.objects[thumbnail.StorageKey]))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), image.Rect(0, 0, 320, 160), img.Bounds())
+}
+
+func (s *MediaVariantGeneratorTestSuite) TestGenerateSmallImage() {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(100, 50)); err != nil {
+		panic(err)
+	}
+	media := s.store("image/png", buf.Bytes())
+
+	err := s.Generator.Generate(context.Background(), media.ID)
+
+	assert.NoError(s.T(), err)
+	saved := s.MediaRepository.savedVariants[0]
+	assert.Len(s.T(), saved.Variants, 2)
+	assert.Equal(s.T(), "thumbnail", saved.Variants[0].Name)
+	assert.Equal(s.T(), 100, saved.Variants[0].Width)
+}
+
+func (s *MediaVariantGeneratorTestSuite) TestGenerateAppliesOrientation() {
+	media := s.store("image/jpeg", jpegWithExif(testImage(400, 200), 6))
+
+	err := s.Generator.Generate(context.Background(), media.ID)
+
+	assert.NoError(s.T(), err)
+	saved := s.MediaRepository.savedVariants[0]
+	assert.Equal(s.T(), 200, saved.Width)
+	assert.Equal(s.T(), 400, saved.Height)
+	assert.Equal(s.T(), "image/jpeg", saved.Variants[0].ContentType)
+	assert.Equal(s.T(), 160, saved.Variants[0].Width)
+	assert.Equal(s.T(), 320, saved.Variants[0].Height)
+	assert.NotContains(s.T(), string(s.Storage.objects[saved.Variants[0].StorageKey]), "Exif")
+}
+
+func (s *MediaVariantGeneratorTestSuite) TestGenerateUndecodableImage() {
+	media := s.store("image/png", pngHeader)
+
+	err := s.Generator.Generate(context.Background(), media.ID)
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.MediaRepository.savedVariants)
+}
+
+func (s *MediaVariantGeneratorTestSuite) TestGenerateMissingFile() {
+	media := s.store("image/png", nil)
+	delete(s.Storage.objects, media.StorageKey)
+
+	err := s.Generator.Generate(context.Background(), media.ID)
+
+	assert.Error(s.T(), err)
+}
+
+func TestApplyOrientation(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	marker := color.NRGBA{R: 255, A: 255}
+	img.Set(0, 0, marker)
+
+	// Where the top left pixel ends up, for every orientation.
+	corners := map[int]image.Point{
+		2: {2, 0},
+		3: {2, 1},
+		4: {0, 1},
+		5: {0, 0},
+		6: {1, 0},
+		7: {1, 2},
+		8: {0, 2},
+	}
+	for orientation, corner := range corners {
+		oriented := applyOrientation(img, orientation)
+		assert.Equal(t, marker, color.NRGBAModel.Convert(oriented.At(corner.X, corner.Y)), "orientation %d", orientation)
+		if orientation >= 5 {
+			assert.Equal(t, image.Rect(0, 0, 2, 3), oriented.Bounds(), "orientation %d", orientation)
+		}
+	}
+}
+
+func TestMediaVariantGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaVariantGeneratorTestSuite))
+}
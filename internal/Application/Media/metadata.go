@@ -0,0 +1,291 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// orientationTag is the EXIF tag telling viewers how to rotate or flip the
+// stored pixels, see imageOrientation.
+const orientationTag = 0x0112
+
+var (
+	jpegExifHeader = []byte("Exif\x00\x00")
+	pngSignature   = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// stripMetadata removes EXIF, XMP, IPTC and text metadata, which may hold GPS
+// coordinates, camera serials or the author's name, from JPEG, PNG and WebP
+// files. Color profiles are kept. The EXIF orientation is the one field
+// worth keeping, so it is written back on its own when it is not the
+// default. Data the parser does not understand is copied unchanged.
+func stripMetadata(data []byte, contentType string) []byte {
+	switch contentType {
+	case "image/jpeg":
+		return stripJPEGMetadata(data)
+	case "image/png":
+		return stripPNGMetadata(data)
+	case "image/webp":
+		return stripWebPMetadata(data)
+	default:
+		return data
+	}
+}
+
+// imageOrientation returns the EXIF orientation of the image, from 1, the
+// default, to 8.
+func imageOrientation(data []byte, contentType string) int {
+	orientation := 1
+	switch contentType {
+	case "image/jpeg":
+		walkJPEGSegments(data, func(segment []byte) {
+			if segment[1] == 0xe1 && bytes.HasPrefix(segment[4:], jpegExifHeader) {
+				orientation = tiffOrientation(segment[4+len(jpegExifHeader):])
+			}
+		})
+	case "image/png":
+		walkPNGChunks(data, func(chunkType string, payload []byte) {
+			if chunkType == "eXIf" {
+				orientation = tiffOrientation(payload)
+			}
+		})
+	case "image/webp":
+		walkWebPChunks(data, func(fourCC string, payload []byte) {
+			if fourCC == "EXIF" {
+				orientation = tiffOrientation(bytes.TrimPrefix(payload, jpegExifHeader))
+			}
+		})
+	}
+	return orientation
+}
+
+// tiffOrientation reads the orientation from the first IFD of an EXIF TIFF
+// structure.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := range count {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+		if orientation := int(order.Uint16(tiff[entry+8:])); orientation >= 1 && orientation <= 8 {
+			return orientation
+		}
+		break
+	}
+	return 1
+}
+
+// orientationTIFF builds an EXIF TIFF structure holding only the orientation.
+func orientationTIFF(orientation int) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, uint16(orientation))
+	tiff = append(tiff, 0, 0)
+	// No further IFDs.
+	return binary.BigEndian.AppendUint32(tiff, 0)
+}
+
+// walkJPEGSegments calls fn for every marker segment before the image data,
+// marker and length included, and returns the offset the image data starts
+// at, or -1 when the file is not a JPEG or breaks off before it.
+func walkJPEGSegments(data []byte, fn func(segment []byte)) int {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return -1
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xff {
+			return -1
+		}
+		marker := data[pos+1]
+		if marker == 0xff {
+			// Fill byte.
+			pos++
+			continue
+		}
+		if marker == 0xda || marker == 0xd9 {
+			return pos
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return -1
+		}
+		fn(data[pos : pos+2+length])
+		pos += 2 + length
+	}
+	return -1
+}
+
+// stripJPEGMetadata drops APP1 (EXIF and XMP), APP13 (IPTC) and comment
+// segments. JFIF, ICC profiles and the Adobe segment, which tells decoders
+// how to convert colors, are kept.
+func stripJPEGMetadata(data []byte) []byte {
+	orientation := imageOrientation(data, "image/jpeg")
+
+	var segments [][]byte
+	end := walkJPEGSegments(data, func(segment []byte) {
+		if marker := segment[1]; marker != 0xe1 && marker != 0xed && marker != 0xfe {
+			segments = append(segments, segment)
+		}
+	})
+	if end < 0 {
+		return data
+	}
+
+	stripped := make([]byte, 0, len(data))
+	stripped = append(stripped, 0xff, 0xd8)
+	if len(segments) > 0 && segments[0][1] == 0xe0 {
+		// JFIF must stay the first segment.
+		stripped = append(stripped, segments[0]...)
+		segments = segments[1:]
+	}
+	if orientation != 1 {
+		payload := append(append([]byte{}, jpegExifHeader...), orientationTIFF(orientation)...)
+		stripped = append(stripped, 0xff, 0xe1)
+		stripped = binary.BigEndian.AppendUint16(stripped, uint16(len(payload)+2))
+		stripped = append(stripped, payload...)
+	}
+	for _, segment := range segments {
+		stripped = append(stripped, segment...)
+	}
+	return append(stripped, data[end:]...)
+}
+
+// walkPNGChunks calls fn for every chunk and returns the offset of the first
+// byte after the last complete chunk, or -1 when the file is not a PNG.
+func walkPNGChunks(data []byte, fn func(chunkType string, payload []byte)) int {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return -1
+	}
+	pos := len(pngSignature)
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		if length < 0 || length > len(data)-pos-12 {
+			break
+		}
+		fn(string(data[pos+4:pos+8]), data[pos+8:pos+8+length])
+		pos += 12 + length
+	}
+	return pos
+}
+
+// stripPNGMetadata drops the eXIf, text and modification time chunks.
+func stripPNGMetadata(data []byte) []byte {
+	orientation := imageOrientation(data, "image/png")
+
+	stripped := make([]byte, 0, len(data))
+	stripped = append(stripped, pngSignature...)
+	end := walkPNGChunks(data, func(chunkType string, payload []byte) {
+		switch chunkType {
+		case "eXIf", "tEXt", "zTXt", "iTXt", "tIME":
+			return
+		case "IDAT":
+			// eXIf has to come before the image data.
+			if orientation != 1 {
+				stripped = appendPNGChunk(stripped, "eXIf", orientationTIFF(orientation))
+				orientation = 1
+			}
+		}
+		stripped = appendPNGChunk(stripped, chunkType, payload)
+	})
+	if end < 0 {
+		return data
+	}
+	return append(stripped, data[end:]...)
+}
+
+func appendPNGChunk(data []byte, chunkType string, payload []byte) []byte {
+	data = binary.BigEndian.AppendUint32(data, uint32(len(payload)))
+	start := len(data)
+	data = append(data, chunkType...)
+	data = append(data, payload...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(data[start:]))
+}
+
+// walkWebPChunks calls fn for every chunk of the RIFF container and returns
+// the offset of the first byte after the last complete chunk, or -1 when the
+// file is not a WebP.
+func walkWebPChunks(data []byte, fn func(fourCC string, payload []byte)) int {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return -1
+	}
+	pos := 12
+	for pos+8 <= len(data) {
+		length := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		if length < 0 || length > len(data)-pos-8 {
+			break
+		}
+		fn(string(data[pos:pos+4]), data[pos+8:pos+8+length])
+		pos += 8 + length + length&1
+	}
+	return min(pos, len(data))
+}
+
+// stripWebPMetadata drops the EXIF and XMP chunks. Only extended WebP files,
+// those with a VP8X chunk, can carry metadata; its flags are updated to match.
+func stripWebPMetadata(data []byte) []byte {
+	const (
+		xmpFlag  = 0x04
+		exifFlag = 0x08
+	)
+	orientation := imageOrientation(data, "image/webp")
+
+	stripped := make([]byte, 0, len(data))
+	stripped = append(stripped, data[:min(12, len(data))]...)
+	vp8x := -1
+	end := walkWebPChunks(data, func(fourCC string, payload []byte) {
+		switch fourCC {
+		case "EXIF", "XMP ":
+			return
+		case "VP8X":
+			vp8x = len(stripped)
+		}
+		stripped = appendWebPChunk(stripped, fourCC, payload)
+	})
+	if end < 0 || vp8x < 0 || len(stripped) < vp8x+9 {
+		return data
+	}
+
+	flags := stripped[vp8x+8] &^ (xmpFlag | exifFlag)
+	if orientation != 1 {
+		flags |= exifFlag
+		stripped = appendWebPChunk(stripped, "EXIF", orientationTIFF(orientation))
+	}
+	stripped[vp8x+8] = flags
+	binary.LittleEndian.PutUint32(stripped[4:], uint32(len(stripped)-8))
+	return append(stripped, data[end:]...)
+}
+
+func appendWebPChunk(data []byte, fourCC string, payload []byte) []byte {
+	data = append(data, fourCC...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(payload)))
+	data = append(data, payload...)
+	if len(payload)%2 == 1 {
+		data = append(data, 0)
+	}
+	return data
+}
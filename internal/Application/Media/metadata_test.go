@@ -0,0 +1,162 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	webp "main/internal/Infrastructure/Webp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	xwebp "golang.org/x/image/webp"
+)
+
+// exifTIFF builds a little endian EXIF TIFF structure with the camera make
+// and the orientation.
+func exifTIFF(orientation int) []byte {
+	tiff := []byte("II\x2a\x00\x08\x00\x00\x00")
+	tiff = binary.LittleEndian.AppendUint16(tiff, 2)
+	// Make, ASCII, stored after the IFD at offset 38.
+	tiff = binary.LittleEndian.AppendUint16(tiff, 0x010f)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 2)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 6)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 38)
+	tiff = binary.LittleEndian.AppendUint16(tiff, orientationTag)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 3)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 1)
+	tiff = binary.LittleEndian.AppendUint32(tiff, uint32(orientation))
+	tiff = binary.LittleEndian.AppendUint32(tiff, 0)
+	return append(tiff, "Canon\x00"...)
+}
+
+func testImage(width int, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.NRGBA{R: uint8(x * 255 / width), G: uint8(y * 255 / height), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// jpegWithExif encodes img and inserts an EXIF, an XMP and a comment segment
+// after the JFIF segment.
+func jpegWithExif(img image.Image, orientation int) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		panic(err)
+	}
+	data := buf.Bytes()
+
+	var metadata []byte
+	for _, segment := range []struct {
+		marker  byte
+		payload []byte
+	}{
+		{0xe1, append(append([]byte{}, jpegExifHeader...), exifTIFF(orientation)...)},
+		{0xe1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta>Jane Doe</x:xmpmeta>")},
+		{0xe2, []byte("ICC_PROFILE\x00profile")},
+		{0xfe, []byte("Jane's camera")},
+	} {
+		metadata = append(metadata, 0xff, segment.marker)
+		metadata = binary.BigEndian.AppendUint16(metadata, uint16(len(segment.payload)+2))
+		metadata = append(metadata, segment.payload...)
+	}
+
+	// The encoder writes no JFIF segment, so the metadata goes right after
+	// the SOI marker.
+	return append(append([]byte{0xff, 0xd8}, metadata...), data[2:]...)
+}
+
+type MetadataTestSuite struct {
+	suite.Suite
+}
+
+func (s *MetadataTestSuite) TestStripJPEGMetadata() {
+	data := jpegWithExif(testImage(8, 4), 6)
+	assert.Equal(s.T(), 6, imageOrientation(data, "image/jpeg"))
+
+	stripped := stripMetadata(data, "image/jpeg")
+
+	assert.NotContains(s.T(), string(stripped), "Canon")
+	assert.NotContains(s.T(), string(stripped), "Jane")
+	assert.Contains(s.T(), string(stripped), "ICC_PROFILE")
+	assert.Equal(s.T(), 6, imageOrientation(stripped, "image/jpeg"))
+	img, err := jpeg.Decode(bytes.NewReader(stripped))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), image.Rect(0, 0, 8, 4), img.Bounds())
+}
+
+func (s *MetadataTestSuite) TestStripJPEGMetadataDefaultOrientation() {
+	stripped := stripMetadata(jpegWithExif(testImage(8, 4), 1), "image/jpeg")
+
+	assert.NotContains(s.T(), string(stripped), "Exif")
+	assert.Equal(s.T(), 1, imageOrientation(stripped, "image/jpeg"))
+}
+
+func (s *MetadataTestSuite) TestStripPNGMetadata() {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(8, 4)); err != nil {
+		panic(err)
+	}
+	encoded := buf.Bytes()
+	// IHDR is 25 bytes long, metadata chunks follow it.
+	ihdrEnd := len(pngSignature) + 25
+	data := append([]byte{}, encoded[:ihdrEnd]...)
+	data = appendPNGChunk(data, "eXIf", exifTIFF(3))
+	data = appendPNGChunk(data, "tEXt", []byte("Author\x00Jane Doe"))
+	data = append(data, encoded[ihdrEnd:]...)
+	assert.Equal(s.T(), 3, imageOrientation(data, "image/png"))
+
+	stripped := stripMetadata(data, "image/png")
+
+	assert.NotContains(s.T(), string(stripped), "Canon")
+	assert.NotContains(s.T(), string(stripped), "Jane")
+	assert.Equal(s.T(), 3, imageOrientation(stripped, "image/png"))
+	img, err := png.Decode(bytes.NewReader(stripped))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), image.Rect(0, 0, 8, 4), img.Bounds())
+}
+
+func (s *MetadataTestSuite) TestStripWebPMetadata() {
+	var buf bytes.Buffer
+	if err := webp.Encode(&buf, testImage(8, 4)); err != nil {
+		panic(err)
+	}
+	encoded := buf.Bytes()
+	vp8x := []byte{0x08 | 0x04, 0, 0, 0}
+	vp8x = append(vp8x, 7, 0, 0, 3, 0, 0)
+	data := []byte("RIFF\x00\x00\x00\x00WEBP")
+	data = appendWebPChunk(data, "VP8X", vp8x)
+	data = append(data, encoded[12:]...)
+	data = appendWebPChunk(data, "EXIF", exifTIFF(8))
+	data = appendWebPChunk(data, "XMP ", []byte("<x:xmpmeta>Jane Doe</x:xmpmeta>"))
+	binary.LittleEndian.PutUint32(data[4:], uint32(len(data)-8))
+	assert.Equal(s.T(), 8, imageOrientation(data, "image/webp"))
+
+	stripped := stripMetadata(data, "image/webp")
+
+	assert.NotContains(s.T(), string(stripped), "Canon")
+	assert.NotContains(s.T(), string(stripped), "Jane")
+	assert.Equal(s.T(), 8, imageOrientation(stripped, "image/webp"))
+	assert.Equal(s.T(), byte(0x08), stripped[20])
+	assert.Equal(s.T(), uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+	img, err := xwebp.Decode(bytes.NewReader(stripped))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), image.Rect(0, 0, 8, 4), img.Bounds())
+}
+
+func (s *MetadataTestSuite) TestStripMetadataKeepsUnparsableData() {
+	data := []byte("\xff\xd8\xff\xe1\xff\xffExif")
+
+	assert.Equal(s.T(), data, stripMetadata(data, "image/jpeg"))
+	assert.Equal(s.T(), 1, imageOrientation(data, "image/jpeg"))
+}
+
+func TestMetadataTestSuite(t *testing.T) {
+	suite.Run(t, new(MetadataTestSuite))
+}
@@ -0,0 +1,23 @@
+package annotation_query
+
+import (
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+)
+
+func newAnnotationView(annotation entity.Annotation) view.AnnotationView {
+	return view.NewAnnotationView(
+		annotation.ID,
+		annotation.PostId,
+		annotation.AuthorId,
+		annotation.Start,
+		annotation.End,
+		annotation.Quote,
+		annotation.Body,
+		annotation.Detached,
+		annotation.ResolvedAt,
+		annotation.ResolvedBy,
+		annotation.CreatedAt,
+		annotation.UpdatedAt,
+	)
+}
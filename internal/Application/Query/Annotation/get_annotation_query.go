@@ -0,0 +1,11 @@
+package annotation_query
+
+import "github.com/google/uuid"
+
+type GetAnnotationQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetAnnotationQuery(id uuid.UUID) GetAnnotationQuery {
+	return GetAnnotationQuery{Id: id}
+}
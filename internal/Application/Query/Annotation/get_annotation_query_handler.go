@@ -0,0 +1,30 @@
+package annotation_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetAnnotationQueryHandler struct {
+	AnnotationRepository repository.AnnotationRepository
+}
+
+func (h GetAnnotationQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getAnnotationQuery, ok := query.(GetAnnotationQuery)
+	if !ok {
+		return view.AnnotationView{}, nil
+	}
+
+	annotation, err := h.AnnotationRepository.FindByID(ctx, getAnnotationQuery.Id)
+	if err != nil {
+		return view.AnnotationView{}, err
+	}
+
+	return newAnnotationView(annotation), nil
+}
+
+func (h GetAnnotationQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetAnnotationQuery)
+	return ok
+}
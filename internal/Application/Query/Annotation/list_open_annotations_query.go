@@ -0,0 +1,13 @@
+package annotation_query
+
+import "github.com/google/uuid"
+
+// ListOpenAnnotationsQuery lists the unresolved annotations of a post in the
+// order of their passages.
+type ListOpenAnnotationsQuery struct {
+	PostId uuid.UUID `json:"post_id"`
+}
+
+func NewListOpenAnnotationsQuery(postId uuid.UUID) ListOpenAnnotationsQuery {
+	return ListOpenAnnotationsQuery{PostId: postId}
+}
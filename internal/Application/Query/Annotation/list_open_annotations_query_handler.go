@@ -0,0 +1,35 @@
+package annotation_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListOpenAnnotationsQueryHandler struct {
+	AnnotationRepository repository.AnnotationRepository
+}
+
+func (h ListOpenAnnotationsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	listOpenAnnotationsQuery, ok := query.(ListOpenAnnotationsQuery)
+	if !ok {
+		return []view.AnnotationView{}, nil
+	}
+
+	annotations, err := h.AnnotationRepository.FindAllByPostId(ctx, listOpenAnnotationsQuery.PostId, false)
+	if err != nil {
+		return []view.AnnotationView{}, err
+	}
+
+	annotationViews := make([]view.AnnotationView, len(annotations))
+	for i, annotation := range annotations {
+		annotationViews[i] = newAnnotationView(annotation)
+	}
+
+	return annotationViews, nil
+}
+
+func (h ListOpenAnnotationsQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListOpenAnnotationsQuery)
+	return ok
+}
@@ -0,0 +1,98 @@
+package annotation_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockAnnotationRepository struct {
+	findAllByPostIdFunc func(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error)
+}
+
+func (m *mockAnnotationRepository) Save(ctx context.Context, annotation entity.Annotation) error {
+	return nil
+}
+
+func (m *mockAnnotationRepository) Update(ctx context.Context, annotation entity.Annotation) error {
+	return nil
+}
+
+func (m *mockAnnotationRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Annotation, error) {
+	return entity.Annotation{}, nil
+}
+
+func (m *mockAnnotationRepository) FindAllByPostId(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+	if m.findAllByPostIdFunc != nil {
+		return m.findAllByPostIdFunc(ctx, postId, includeResolved)
+	}
+	return nil, errors.New("not implemented")
+}
+
+type ListOpenAnnotationsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        ListOpenAnnotationsQueryHandler
+	MockRepository *mockAnnotationRepository
+}
+
+func (s *ListOpenAnnotationsQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockAnnotationRepository{}
+	s.Handler = ListOpenAnnotationsQueryHandler{
+		AnnotationRepository: s.MockRepository,
+	}
+}
+
+func (s *ListOpenAnnotationsQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	annotation, err := entity.NewAnnotation(uuid.New(), createdAt, testPostID, testAuthorID, "Hello world", 6, 11, "world", "Too generic")
+	if err != nil {
+		panic(err)
+	}
+	s.MockRepository.findAllByPostIdFunc = func(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+		assert.Equal(s.T(), testPostID, postId)
+		assert.False(s.T(), includeResolved)
+		return []entity.Annotation{annotation}, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewListOpenAnnotationsQuery(testPostID))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []view.AnnotationView{
+		view.NewAnnotationView(annotation.ID, testPostID, testAuthorID, 6, 11, "world", "Too generic", false, nil, nil, createdAt, createdAt),
+	}, result)
+}
+
+func (s *ListOpenAnnotationsQueryHandlerTestSuite) TestHandleRepositoryError() {
+	s.MockRepository.findAllByPostIdFunc = func(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+		return nil, errors.New("database error")
+	}
+
+	_, err := s.Handler.Handle(context.Background(), NewListOpenAnnotationsQuery(uuid.New()))
+
+	assert.EqualError(s.T(), err, "database error")
+}
+
+func (s *ListOpenAnnotationsQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []view.AnnotationView{}, result)
+}
+
+func (s *ListOpenAnnotationsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewListOpenAnnotationsQuery(uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewGetAnnotationQuery(uuid.Nil)))
+}
+
+func TestListOpenAnnotationsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListOpenAnnotationsQueryHandlerTestSuite))
+}
@@ -0,0 +1,34 @@
+package category_query
+
+import (
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+// newCategoryTree nests the categories below the one with the given id, or
+// below the root when it is nil. The order of siblings is kept.
+func newCategoryTree(categories []entity.Category, parentId *uuid.UUID) []view.CategoryView {
+	children := make(map[uuid.UUID][]entity.Category, len(categories))
+	var roots []entity.Category
+	for _, category := range categories {
+		switch {
+		case category.ParentId == nil && parentId == nil,
+			category.ParentId != nil && parentId != nil && *category.ParentId == *parentId:
+			roots = append(roots, category)
+		case category.ParentId != nil:
+			children[*category.ParentId] = append(children[*category.ParentId], category)
+		}
+	}
+
+	var build func(categories []entity.Category) []view.CategoryView
+	build = func(categories []entity.Category) []view.CategoryView {
+		views := make([]view.CategoryView, len(categories))
+		for i, category := range categories {
+			views[i] = view.NewCategoryView(category.ID, category.ParentId, category.Name, category.Slug, build(children[category.ID]))
+		}
+		return views
+	}
+	return build(roots)
+}
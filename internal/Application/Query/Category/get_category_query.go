@@ -0,0 +1,11 @@
+package category_query
+
+import "github.com/google/uuid"
+
+type GetCategoryQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetCategoryQuery(id uuid.UUID) GetCategoryQuery {
+	return GetCategoryQuery{Id: id}
+}
@@ -0,0 +1,51 @@
+package category_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"strings"
+)
+
+// GetCategoryQueryHandler returns the category with its whole subtree.
+type GetCategoryQueryHandler struct {
+	CategoryRepository repository.CategoryRepository
+}
+
+func (h GetCategoryQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getCategoryQuery, ok := query.(GetCategoryQuery)
+	if !ok {
+		return view.CategoryView{}, nil
+	}
+
+	category, err := h.CategoryRepository.FindByID(ctx, getCategoryQuery.Id)
+	if err != nil {
+		return view.CategoryView{}, err
+	}
+
+	categories, err := h.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return view.CategoryView{}, err
+	}
+
+	descendants := make([]entity.Category, 0, len(categories))
+	for _, other := range categories {
+		if other.ID != category.ID && strings.HasPrefix(other.Path, category.Path) {
+			descendants = append(descendants, other)
+		}
+	}
+
+	return view.NewCategoryView(
+		category.ID,
+		category.ParentId,
+		category.Name,
+		category.Slug,
+		newCategoryTree(descendants, &category.ID),
+	), nil
+}
+
+func (h GetCategoryQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetCategoryQuery)
+	return ok
+}
@@ -0,0 +1,60 @@
+package category_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCategoryQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        GetCategoryQueryHandler
+	MockRepository *mockCategoryRepository
+}
+
+func (s *GetCategoryQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockCategoryRepository{}
+	s.Handler = GetCategoryQueryHandler{
+		CategoryRepository: s.MockRepository,
+	}
+}
+
+func (s *GetCategoryQueryHandlerTestSuite) TestHandle() {
+	programming, golang, concurrency, design := categoryTree()
+	s.MockRepository.categories = []entity.Category{concurrency, design, golang, programming}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetCategoryQuery(golang.ID))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.NewCategoryView(golang.ID, &programming.ID, "Go", "go", []view.CategoryView{
+		view.NewCategoryView(concurrency.ID, &golang.ID, "Concurrency", "concurrency", []view.CategoryView{}),
+	}), result)
+}
+
+func (s *GetCategoryQueryHandlerTestSuite) TestHandleNotFound() {
+	result, err := s.Handler.Handle(context.Background(), NewGetCategoryQuery(uuid.New()))
+
+	assert.EqualError(s.T(), err, "record not found")
+	assert.Equal(s.T(), view.CategoryView{}, result)
+}
+
+func (s *GetCategoryQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.CategoryView{}, result)
+}
+
+func (s *GetCategoryQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetCategoryQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestGetCategoryQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetCategoryQueryHandlerTestSuite))
+}
@@ -0,0 +1,7 @@
+package category_query
+
+type ListCategoriesQuery struct{}
+
+func NewListCategoriesQuery() ListCategoriesQuery {
+	return ListCategoriesQuery{}
+}
@@ -0,0 +1,31 @@
+package category_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+// ListCategoriesQueryHandler returns the root categories with their
+// subcategories nested below them, siblings ordered by name.
+type ListCategoriesQueryHandler struct {
+	CategoryRepository repository.CategoryRepository
+}
+
+func (h ListCategoriesQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	if _, ok := query.(ListCategoriesQuery); !ok {
+		return []view.CategoryView{}, nil
+	}
+
+	categories, err := h.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return []view.CategoryView{}, err
+	}
+
+	return newCategoryTree(categories, nil), nil
+}
+
+func (h ListCategoriesQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListCategoriesQuery)
+	return ok
+}
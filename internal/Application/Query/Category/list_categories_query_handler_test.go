@@ -0,0 +1,114 @@
+package category_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCategoryRepository struct {
+	categories []entity.Category
+	err        error
+}
+
+func (m *mockCategoryRepository) Save(ctx context.Context, category entity.Category) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) Update(ctx context.Context, category entity.Category) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error) {
+	for _, category := range m.categories {
+		if category.ID == id {
+			return category, nil
+		}
+	}
+	return entity.Category{}, errors.New("record not found")
+}
+
+func (m *mockCategoryRepository) FindAll(ctx context.Context) ([]entity.Category, error) {
+	return append([]entity.Category{}, m.categories...), m.err
+}
+
+func (m *mockCategoryRepository) FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error) {
+	return nil, errors.New("not implemented")
+}
+
+// categoryTree builds programming > go > concurrency and design, in name
+// order as the repository returns them.
+func categoryTree() (programming entity.Category, golang entity.Category, concurrency entity.Category, design entity.Category) {
+	now := time.Now()
+	programming = entity.NewCategory(uuid.New(), now, nil, "Programming", "programming")
+	golang = entity.NewCategory(uuid.New(), now, &programming, "Go", "go")
+	concurrency = entity.NewCategory(uuid.New(), now, &golang, "Concurrency", "concurrency")
+	design = entity.NewCategory(uuid.New(), now, nil, "Design", "design")
+	return
+}
+
+type ListCategoriesQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        ListCategoriesQueryHandler
+	MockRepository *mockCategoryRepository
+}
+
+func (s *ListCategoriesQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockCategoryRepository{}
+	s.Handler = ListCategoriesQueryHandler{
+		CategoryRepository: s.MockRepository,
+	}
+}
+
+func (s *ListCategoriesQueryHandlerTestSuite) TestHandle() {
+	programming, golang, concurrency, design := categoryTree()
+	s.MockRepository.categories = []entity.Category{concurrency, design, golang, programming}
+
+	result, err := s.Handler.Handle(context.Background(), NewListCategoriesQuery())
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []view.CategoryView{
+		view.NewCategoryView(design.ID, nil, "Design", "design", []view.CategoryView{}),
+		view.NewCategoryView(programming.ID, nil, "Programming", "programming", []view.CategoryView{
+			view.NewCategoryView(golang.ID, &programming.ID, "Go", "go", []view.CategoryView{
+				view.NewCategoryView(concurrency.ID, &golang.ID, "Concurrency", "concurrency", []view.CategoryView{}),
+			}),
+		}),
+	}, result)
+}
+
+func (s *ListCategoriesQueryHandlerTestSuite) TestHandleError() {
+	s.MockRepository.err = errors.New("database error")
+
+	result, err := s.Handler.Handle(context.Background(), NewListCategoriesQuery())
+
+	assert.EqualError(s.T(), err, "database error")
+	assert.Equal(s.T(), []view.CategoryView{}, result)
+}
+
+func (s *ListCategoriesQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []view.CategoryView{}, result)
+}
+
+func (s *ListCategoriesQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(ListCategoriesQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestListCategoriesQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCategoriesQueryHandlerTestSuite))
+}
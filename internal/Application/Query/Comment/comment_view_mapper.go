@@ -0,0 +1,53 @@
+package comment_query
+
+import (
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+func newCommentView(comment entity.Comment, replies []view.CommentView) view.CommentView {
+	return view.NewCommentView(
+		comment.ID,
+		comment.PostId,
+		comment.AuthorId,
+		comment.ParentId,
+		comment.Content,
+		string(comment.Status),
+		comment.CreatedAt,
+		comment.UpdatedAt,
+		replies,
+	)
+}
+
+// newCommentThreads nests a flat list of comments under their parents. The
+// order of the input is kept among siblings. Comments whose parent is not in
+// the list are treated as top-level.
+func newCommentThreads(comments []entity.Comment) []view.CommentView {
+	present := make(map[uuid.UUID]bool, len(comments))
+	for _, comment := range comments {
+		present[comment.ID] = true
+	}
+
+	children := make(map[uuid.UUID][]entity.Comment)
+	roots := make([]entity.Comment, 0)
+	for _, comment := range comments {
+		if comment.ParentId == nil || !present[*comment.ParentId] {
+			roots = append(roots, comment)
+			continue
+		}
+		children[*comment.ParentId] = append(children[*comment.ParentId], comment)
+	}
+
+	var build func(comments []entity.Comment) []view.CommentView
+	build = func(comments []entity.Comment) []view.CommentView {
+		views := make([]view.CommentView, len(comments))
+		for i, comment := range comments {
+			views[i] = newCommentView(comment, build(children[comment.ID]))
+		}
+		return views
+	}
+
+	return build(roots)
+}
@@ -0,0 +1,11 @@
+package comment_query
+
+import "github.com/google/uuid"
+
+type GetCommentQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetCommentQuery(id uuid.UUID) GetCommentQuery {
+	return GetCommentQuery{Id: id}
+}
@@ -0,0 +1,30 @@
+package comment_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetCommentQueryHandler struct {
+	CommentRepository repository.CommentRepository
+}
+
+func (h GetCommentQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getCommentQuery, ok := query.(GetCommentQuery)
+	if !ok {
+		return view.CommentView{}, nil
+	}
+
+	comment, err := h.CommentRepository.FindByID(ctx, getCommentQuery.Id)
+	if err != nil {
+		return view.CommentView{}, err
+	}
+
+	return newCommentView(comment, []view.CommentView{}), nil
+}
+
+func (h GetCommentQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetCommentQuery)
+	return ok
+}
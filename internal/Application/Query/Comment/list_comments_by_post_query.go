@@ -0,0 +1,22 @@
+package comment_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+type ListCommentsByPostQuery struct {
+	PaginationFilters query.PaginationFilters
+	PostId            uuid.UUID
+}
+
+func NewListCommentsByPostQuery(postId uuid.UUID, page int, pageSize int) ListCommentsByPostQuery {
+	return ListCommentsByPostQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		PostId: postId,
+	}
+}
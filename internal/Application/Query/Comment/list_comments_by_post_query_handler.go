@@ -0,0 +1,35 @@
+package comment_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListCommentsByPostQueryHandler struct {
+	CommentRepository repository.CommentRepository
+}
+
+func (h ListCommentsByPostQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	listCommentsQuery, ok := query.(ListCommentsByPostQuery)
+	if !ok {
+		return []view.CommentView{}, nil
+	}
+
+	paginatedResult, err := h.CommentRepository.FindThreadsByPostId(
+		ctx,
+		listCommentsQuery.PostId,
+		listCommentsQuery.PaginationFilters.Page,
+		listCommentsQuery.PaginationFilters.PageSize,
+	)
+	if err != nil {
+		return []view.CommentView{}, err
+	}
+
+	return view.NewPaginatedView(newCommentThreads(paginatedResult.Items), paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h ListCommentsByPostQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListCommentsByPostQuery)
+	return ok
+}
@@ -0,0 +1,121 @@
+package comment_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockCommentRepository struct {
+	findThreadsByPostIdFunc func(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error)
+}
+
+func (m *mockCommentRepository) Save(ctx context.Context, comment entity.Comment) error {
+	return nil
+}
+
+func (m *mockCommentRepository) Update(ctx context.Context, comment entity.Comment) error {
+	return nil
+}
+
+func (m *mockCommentRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+	return entity.Comment{}, nil
+}
+
+func (m *mockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCommentRepository) FindThreadsByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	if m.findThreadsByPostIdFunc != nil {
+		return m.findThreadsByPostIdFunc(ctx, postId, page, pageSize)
+	}
+	return repository.PaginatedResult[entity.Comment]{}, errors.New("not implemented")
+}
+
+func (m *mockCommentRepository) FindAllPendingByPostAuthorId(ctx context.Context, authorId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	return repository.PaginatedResult[entity.Comment]{}, nil
+}
+
+type ListCommentsByPostQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        ListCommentsByPostQueryHandler
+	MockRepository *mockCommentRepository
+}
+
+func (s *ListCommentsByPostQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockCommentRepository{}
+	s.Handler = ListCommentsByPostQueryHandler{
+		CommentRepository: s.MockRepository,
+	}
+}
+
+func (s *ListCommentsByPostQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	firstID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	secondID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174002")
+	replyID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174003")
+	nestedReplyID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174004")
+	now := time.Now()
+
+	s.Run("BuildsThreads", func() {
+		s.MockRepository.findThreadsByPostIdFunc = func(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+			assert.Equal(s.T(), testPostID, postId)
+			assert.Equal(s.T(), 2, page)
+			assert.Equal(s.T(), 5, pageSize)
+			return repository.PaginatedResult[entity.Comment]{
+				Items: []entity.Comment{
+					{ID: firstID, PostId: testPostID, CreatedAt: now},
+					{ID: secondID, PostId: testPostID, CreatedAt: now.Add(time.Minute)},
+					{ID: replyID, PostId: testPostID, ParentId: &firstID, CreatedAt: now.Add(2 * time.Minute)},
+					{ID: nestedReplyID, PostId: testPostID, ParentId: &replyID, CreatedAt: now.Add(3 * time.Minute)},
+				},
+				Total:    7,
+				Page:     2,
+				PageSize: 5,
+			}, nil
+		}
+
+		result, err := s.Handler.Handle(context.Background(), NewListCommentsByPostQuery(testPostID, 2, 5))
+
+		assert.NoError(s.T(), err)
+		paginatedView, ok := result.(view.PaginatedView[view.CommentView])
+		assert.True(s.T(), ok)
+		assert.Equal(s.T(), int64(7), paginatedView.Total)
+		assert.Len(s.T(), paginatedView.Items, 2)
+		assert.Equal(s.T(), firstID, paginatedView.Items[0].Id)
+		assert.Equal(s.T(), secondID, paginatedView.Items[1].Id)
+		assert.Empty(s.T(), paginatedView.Items[1].Replies)
+		assert.Len(s.T(), paginatedView.Items[0].Replies, 1)
+		assert.Equal(s.T(), replyID, paginatedView.Items[0].Replies[0].Id)
+		assert.Len(s.T(), paginatedView.Items[0].Replies[0].Replies, 1)
+		assert.Equal(s.T(), nestedReplyID, paginatedView.Items[0].Replies[0].Replies[0].Id)
+	})
+
+	s.Run("RepositoryError", func() {
+		s.MockRepository.findThreadsByPostIdFunc = func(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+			return repository.PaginatedResult[entity.Comment]{}, errors.New("database error")
+		}
+
+		_, err := s.Handler.Handle(context.Background(), NewListCommentsByPostQuery(testPostID, 1, 10))
+
+		assert.Error(s.T(), err)
+	})
+}
+
+func (s *ListCommentsByPostQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewListCommentsByPostQuery(uuid.Nil, 1, 10)))
+	assert.False(s.T(), s.Handler.Supports(NewGetCommentQuery(uuid.Nil)))
+}
+
+func TestListCommentsByPostQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCommentsByPostQueryHandlerTestSuite))
+}
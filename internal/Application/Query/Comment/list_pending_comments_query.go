@@ -0,0 +1,22 @@
+package comment_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+type ListPendingCommentsQuery struct {
+	PaginationFilters query.PaginationFilters
+	PostAuthorId      uuid.UUID
+}
+
+func NewListPendingCommentsQuery(postAuthorId uuid.UUID, page int, pageSize int) ListPendingCommentsQuery {
+	return ListPendingCommentsQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		PostAuthorId: postAuthorId,
+	}
+}
@@ -0,0 +1,40 @@
+package comment_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListPendingCommentsQueryHandler struct {
+	CommentRepository repository.CommentRepository
+}
+
+func (h ListPendingCommentsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	listPendingQuery, ok := query.(ListPendingCommentsQuery)
+	if !ok {
+		return []view.CommentView{}, nil
+	}
+
+	paginatedResult, err := h.CommentRepository.FindAllPendingByPostAuthorId(
+		ctx,
+		listPendingQuery.PostAuthorId,
+		listPendingQuery.PaginationFilters.Page,
+		listPendingQuery.PaginationFilters.PageSize,
+	)
+	if err != nil {
+		return []view.CommentView{}, err
+	}
+
+	commentViews := make([]view.CommentView, len(paginatedResult.Items))
+	for i, comment := range paginatedResult.Items {
+		commentViews[i] = newCommentView(comment, []view.CommentView{})
+	}
+
+	return view.NewPaginatedView(commentViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h ListPendingCommentsQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListPendingCommentsQuery)
+	return ok
+}
@@ -0,0 +1,11 @@
+package media_query
+
+import "github.com/google/uuid"
+
+type GetMediaQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetMediaQuery(id uuid.UUID) GetMediaQuery {
+	return GetMediaQuery{Id: id}
+}
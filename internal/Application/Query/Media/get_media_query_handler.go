@@ -0,0 +1,57 @@
+package media_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+	"sort"
+)
+
+type GetMediaQueryHandler struct {
+	MediaRepository repository.MediaRepository
+	Storage         repository.Storage
+}
+
+func (h GetMediaQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getMediaQuery, ok := query.(GetMediaQuery)
+	if !ok {
+		return view.MediaView{}, nil
+	}
+
+	media, err := h.MediaRepository.FindByID(ctx, getMediaQuery.Id)
+	if err != nil {
+		return view.MediaView{}, err
+	}
+
+	// Smallest first, the order srcset candidates are usually written in.
+	sort.SliceStable(media.Variants, func(i, j int) bool { return media.Variants[i].Width < media.Variants[j].Width })
+	variantViews := make([]view.MediaVariantView, len(media.Variants))
+	for i, variant := range media.Variants {
+		variantViews[i] = view.NewMediaVariantView(
+			variant.Name,
+			h.Storage.URL(variant.StorageKey),
+			variant.ContentType,
+			variant.Width,
+			variant.Height,
+			variant.Size,
+		)
+	}
+
+	return view.NewMediaView(
+		media.ID,
+		media.OwnerId,
+		h.Storage.URL(media.StorageKey),
+		media.FileName,
+		media.ContentType,
+		media.Size,
+		media.Width,
+		media.Height,
+		variantViews,
+		media.CreatedAt,
+	), nil
+}
+
+func (h GetMediaQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetMediaQuery)
+	return ok
+}
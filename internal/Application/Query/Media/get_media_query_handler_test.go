@@ -0,0 +1,155 @@
+package media_query
+
+import (
+	"context"
+	"errors"
+	"io"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockMediaRepository struct {
+	media map[uuid.UUID]entity.Media
+}
+
+func (m *mockMediaRepository) SaveWithinQuota(ctx context.Context, media entity.Media, quota int64) error {
+	return nil
+}
+
+func (m *mockMediaRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Media, error) {
+	media, ok := m.media[id]
+	if !ok {
+		return entity.Media{}, errors.New("record not found")
+	}
+	return media, nil
+}
+
+func (m *mockMediaRepository) UsedBytes(ctx context.Context, ownerId uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockMediaRepository) SaveVariants(ctx context.Context, media entity.Media) error {
+	return nil
+}
+
+type mockStorage struct{}
+
+func (m mockStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	return nil
+}
+
+func (m mockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return nil, nil
+}
+
+func (m mockStorage) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (m mockStorage) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+type GetMediaQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler         GetMediaQueryHandler
+	MediaRepository *mockMediaRepository
+	Media           entity.Media
+}
+
+func (s *GetMediaQueryHandlerTestSuite) SetupTest() {
+	media, err := entity.NewMedia(
+		uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		uuid.MustParse("423e4567-e89b-12d3-a456-426614174000"),
+		"photo.png",
+		"image/png",
+		1024,
+	)
+	if err != nil {
+		panic(err)
+	}
+	s.Media = media
+	s.MediaRepository = &mockMediaRepository{media: map[uuid.UUID]entity.Media{media.ID: media}}
+	s.Handler = GetMediaQueryHandler{
+		MediaRepository: s.MediaRepository,
+		Storage:         mockStorage{},
+	}
+}
+
+func (s *GetMediaQueryHandlerTestSuite) TestHandle() {
+	result, err := s.Handler.Handle(context.Background(), NewGetMediaQuery(s.Media.ID))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.NewMediaView(
+		s.Media.ID,
+		s.Media.OwnerId,
+		"https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000.png",
+		"photo.png",
+		"image/png",
+		1024,
+		0,
+		0,
+		[]view.MediaVariantView{},
+		s.Media.CreatedAt,
+	), result)
+}
+
+func (s *GetMediaQueryHandlerTestSuite) TestHandleWithVariants() {
+	s.Media.Width, s.Media.Height = 1000, 500
+	s.Media.Variants = []entity.MediaVariant{
+		entity.NewMediaVariant(uuid.New(), s.Media, "medium", "image/webp", 768, 384, 300),
+		entity.NewMediaVariant(uuid.New(), s.Media, "thumbnail", "image/png", 320, 160, 200),
+		entity.NewMediaVariant(uuid.New(), s.Media, "thumbnail", "image/webp", 320, 160, 100),
+		entity.NewMediaVariant(uuid.New(), s.Media, "medium", "image/png", 768, 384, 400),
+	}
+	s.MediaRepository.media[s.Media.ID] = s.Media
+
+	result, err := s.Handler.Handle(context.Background(), NewGetMediaQuery(s.Media.ID))
+
+	assert.NoError(s.T(), err)
+	mediaView := result.(view.MediaView)
+	assert.Equal(s.T(), 1000, mediaView.Width)
+	assert.Equal(s.T(), 500, mediaView.Height)
+	assert.Len(s.T(), mediaView.Variants, 4)
+	assert.Equal(s.T(), view.NewMediaVariantView(
+		"thumbnail",
+		"https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000-thumbnail.png",
+		"image/png",
+		320,
+		160,
+		200,
+	), mediaView.Variants[0])
+	assert.Equal(s.T(), map[string]string{
+		"image/png":  "https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000-thumbnail.png 320w, https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000-medium.png 768w",
+		"image/webp": "https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000-thumbnail.webp 320w, https://cdn.example.com/423e4567-e89b-12d3-a456-426614174000/123e4567-e89b-12d3-a456-426614174000-medium.webp 768w",
+	}, mediaView.Srcset)
+}
+
+func (s *GetMediaQueryHandlerTestSuite) TestHandleNotFound() {
+	_, err := s.Handler.Handle(context.Background(), NewGetMediaQuery(uuid.New()))
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func (s *GetMediaQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.MediaView{}, result)
+}
+
+func (s *GetMediaQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetMediaQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestGetMediaQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetMediaQueryHandlerTestSuite))
+}
@@ -0,0 +1,13 @@
+package post_query
+
+import "github.com/google/uuid"
+
+type DiffPostRevisionsQuery struct {
+	PostId uuid.UUID `json:"post_id"`
+	From   int       `json:"from"`
+	To     int       `json:"to"`
+}
+
+func NewDiffPostRevisionsQuery(postId uuid.UUID, from int, to int) DiffPostRevisionsQuery {
+	return DiffPostRevisionsQuery{PostId: postId, From: from, To: to}
+}
@@ -0,0 +1,56 @@
+package post_query
+
+import (
+	"context"
+	"fmt"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type DiffPostRevisionsQueryHandler struct {
+	PostRevisionRepository repository.PostRevisionRepository
+}
+
+func (h DiffPostRevisionsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	diffPostRevisionsQuery, ok := query.(DiffPostRevisionsQuery)
+	if !ok {
+		return view.PostRevisionDiffView{}, nil
+	}
+
+	from, err := h.PostRevisionRepository.FindByPostIdAndRevision(ctx, diffPostRevisionsQuery.PostId, diffPostRevisionsQuery.From)
+	if err != nil {
+		return view.PostRevisionDiffView{}, err
+	}
+
+	to, err := h.PostRevisionRepository.FindByPostIdAndRevision(ctx, diffPostRevisionsQuery.PostId, diffPostRevisionsQuery.To)
+	if err != nil {
+		return view.PostRevisionDiffView{}, err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(revisionDocument(from)),
+		B:        difflib.SplitLines(revisionDocument(to)),
+		FromFile: fmt.Sprintf("revision %d", from.Revision),
+		ToFile:   fmt.Sprintf("revision %d", to.Revision),
+		Context:  3,
+	})
+	if err != nil {
+		return view.PostRevisionDiffView{}, err
+	}
+
+	return view.NewPostRevisionDiffView(diffPostRevisionsQuery.PostId, from.Revision, to.Revision, diff), nil
+}
+
+func (h DiffPostRevisionsQueryHandler) Supports(query any) bool {
+	_, ok := query.(DiffPostRevisionsQuery)
+	return ok
+}
+
+// revisionDocument renders a revision as the text that is diffed: a small
+// header with slug and title followed by the content.
+func revisionDocument(revision entity.PostRevision) string {
+	return "slug: " + revision.Slug + "\ntitle: " + revision.Title + "\n\n" + revision.Content
+}
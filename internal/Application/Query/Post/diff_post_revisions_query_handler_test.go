@@ -0,0 +1,115 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRevisionRepositoryForDiff struct {
+	revisions map[int]entity.PostRevision
+}
+
+func (m *mockPostRevisionRepositoryForDiff) Save(ctx context.Context, revision entity.PostRevision) (int, error) {
+	return 0, nil
+}
+
+func (m *mockPostRevisionRepositoryForDiff) FindByPostIdAndRevision(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+	postRevision, ok := m.revisions[revision]
+	if !ok {
+		return entity.PostRevision{}, errors.New("revision not found")
+	}
+	return postRevision, nil
+}
+
+func (m *mockPostRevisionRepositoryForDiff) FindAllByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.PostRevision], error) {
+	return repository.PaginatedResult[entity.PostRevision]{}, nil
+}
+
+type DiffPostRevisionsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        DiffPostRevisionsQueryHandler
+	MockRepository *mockPostRevisionRepositoryForDiff
+}
+
+func (s *DiffPostRevisionsQueryHandlerTestSuite) SetupTest() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	s.MockRepository = &mockPostRevisionRepositoryForDiff{
+		revisions: map[int]entity.PostRevision{
+			1: {PostId: testPostID, Revision: 1, Slug: "slug", Title: "Title", Content: "first line\nsecond line"},
+			2: {PostId: testPostID, Revision: 2, Slug: "slug", Title: "New Title", Content: "first line\nchanged line"},
+		},
+	}
+	s.Handler = DiffPostRevisionsQueryHandler{
+		PostRevisionRepository: s.MockRepository,
+	}
+}
+
+func (s *DiffPostRevisionsQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name          string
+		query         any
+		expectedError bool
+		expectedDiff  string
+	}{
+		{
+			name:  "Success",
+			query: NewDiffPostRevisionsQuery(testPostID, 1, 2),
+			expectedDiff: "--- revision 1\n" +
+				"+++ revision 2\n" +
+				"@@ -1,5 +1,5 @@\n" +
+				" slug: slug\n" +
+				"-title: Title\n" +
+				"+title: New Title\n" +
+				" \n" +
+				" first line\n" +
+				"-second line\n" +
+				"+changed line\n",
+		},
+		{
+			name:         "SameRevision",
+			query:        NewDiffPostRevisionsQuery(testPostID, 2, 2),
+			expectedDiff: "",
+		},
+		{
+			name:          "RevisionNotFound",
+			query:         NewDiffPostRevisionsQuery(testPostID, 1, 3),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			diffView, ok := result.(view.PostRevisionDiffView)
+			assert.True(t, ok)
+			assert.Equal(t, testPostID, diffView.PostId)
+			assert.Equal(t, tt.expectedDiff, diffView.Diff)
+		})
+	}
+}
+
+func (s *DiffPostRevisionsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewDiffPostRevisionsQuery(uuid.Nil, 1, 2)))
+	assert.False(s.T(), s.Handler.Supports("not a DiffPostRevisionsQuery"))
+}
+
+func TestDiffPostRevisionsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DiffPostRevisionsQueryHandlerTestSuite))
+}
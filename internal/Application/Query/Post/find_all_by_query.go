@@ -1,19 +1,11 @@
 package post_query
 
-import query "main/internal/Application/Query"
-
 type FindAllByQuery struct {
 	Filters Filters
+	// IncludeContent returns full PostViews instead of PostSummaryViews.
+	IncludeContent bool
 }
 
-func NewFindAllByQuery(page int, pageSize int, slug string, text string, author string) FindAllByQuery {
-	return FindAllByQuery{Filters: Filters{
-		PaginationFilters: query.PaginationFilters{
-			Page:     page,
-			PageSize: pageSize,
-		},
-		Slug:   slug,
-		Text:   text,
-		Author: author,
-	}}
+func NewFindAllByQuery(filters Filters, includeContent bool) FindAllByQuery {
+	return FindAllByQuery{Filters: filters, IncludeContent: includeContent}
 }
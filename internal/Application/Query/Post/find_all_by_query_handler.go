@@ -3,9 +3,8 @@ package post_query
 import (
 	"context"
 	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
 	repository "main/internal/Domain/Repository"
-
-	"github.com/google/uuid"
 )
 
 type FindAllByQueryHandler struct {
@@ -22,24 +21,36 @@ func (h FindAllByQueryHandler) Handle(ctx context.Context, query any) (any, erro
 		ctx,
 		findAllByQuery.Filters.PaginationFilters.Page,
 		findAllByQuery.Filters.PaginationFilters.PageSize,
-		findAllByQuery.Filters.Slug,
-		findAllByQuery.Filters.Text,
-		findAllByQuery.Filters.Author,
+		repository.PostFilters{
+			Slug:           findAllByQuery.Filters.Slug,
+			Text:           findAllByQuery.Filters.Text,
+			Author:         findAllByQuery.Filters.Author,
+			Status:         entity.PostStatus(findAllByQuery.Filters.Status),
+			ViewerId:       findAllByQuery.Filters.ViewerId,
+			TagsAny:        entity.NormalizeTagNames(findAllByQuery.Filters.TagsAny),
+			TagsAll:        entity.NormalizeTagNames(findAllByQuery.Filters.TagsAll),
+			Category:       findAllByQuery.Filters.Category,
+			Sort:           repository.PostSort(findAllByQuery.Filters.Sort),
+			WithoutContent: !findAllByQuery.IncludeContent,
+		},
 	)
 
 	if err != nil {
 		return []view.PostView{}, err
 	}
 
+	if !findAllByQuery.IncludeContent {
+		summaryViews := make([]view.PostSummaryView, len(paginatedResult.Items))
+		for i, post := range paginatedResult.Items {
+			summaryViews[i] = newPostSummaryView(post)
+		}
+
+		return view.NewPaginatedView(summaryViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+	}
+
 	postViews := make([]view.PostView, len(paginatedResult.Items))
 	for i, post := range paginatedResult.Items {
-		postViews[i] = view.NewPostView(
-			uuid.MustParse(post.ID.String()),
-			post.Slug,
-			post.Title,
-			post.Content,
-			post.AuthorId,
-		)
+		postViews[i] = newPostView(post)
 	}
 
 	return view.NewPaginatedView(postViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
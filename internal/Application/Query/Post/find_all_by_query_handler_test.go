@@ -3,6 +3,7 @@ package post_query
 import (
 	"context"
 	"errors"
+	query "main/internal/Application/Query"
 	view "main/internal/Application/View"
 	entity "main/internal/Domain/Entity"
 	repository "main/internal/Domain/Repository"
@@ -15,7 +16,7 @@ import (
 )
 
 type mockPostRepositoryForFindAll struct {
-	findAllByFunc func(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error)
+	findAllByFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error)
 }
 
 func (m *mockPostRepositoryForFindAll) Save(ctx context.Context, post entity.Post) error {
@@ -30,9 +31,13 @@ func (m *mockPostRepositoryForFindAll) FindByID(ctx context.Context, id uuid.UUI
 	return entity.Post{}, nil
 }
 
-func (m *mockPostRepositoryForFindAll) FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+func (m *mockPostRepositoryForFindAll) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForFindAll) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 	if m.findAllByFunc != nil {
-		return m.findAllByFunc(ctx, page, pageSize, slug, text, author)
+		return m.findAllByFunc(ctx, page, pageSize, filters)
 	}
 	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
 }
@@ -41,6 +46,14 @@ func (m *mockPostRepositoryForFindAll) Delete(ctx context.Context, id uuid.UUID)
 	return nil
 }
 
+func (m *mockPostRepositoryForFindAll) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForFindAll) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
 type FindAllByQueryHandlerTestSuite struct {
 	suite.Suite
 	Handler        FindAllByQueryHandler
@@ -74,7 +87,7 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 	}{
 		{
 			name:  "Success",
-			query: NewFindAllByQuery(1, 10, "", "", ""),
+			query: NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, true),
 			setupMock: func() {
 				testPosts := []entity.Post{
 					{
@@ -96,12 +109,15 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 						AuthorId:  testAuthorID,
 					},
 				}
-				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 					assert.Equal(s.T(), 1, page)
 					assert.Equal(s.T(), 10, pageSize)
-					assert.Equal(s.T(), "", slug)
-					assert.Equal(s.T(), "", text)
-					assert.Equal(s.T(), "", author)
+					assert.Equal(s.T(), "", filters.Slug)
+					assert.Equal(s.T(), "", filters.Text)
+					assert.Equal(s.T(), "", filters.Author)
+					assert.Equal(s.T(), entity.PostStatus(""), filters.Status)
+					assert.Equal(s.T(), uuid.Nil, filters.ViewerId)
+					assert.False(s.T(), filters.WithoutContent)
 					return repository.PaginatedResult[entity.Post]{
 						Items:    testPosts,
 						Total:    2,
@@ -122,8 +138,19 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 			expectedResultType: "PaginatedView",
 		},
 		{
-			name:  "WithFilters",
-			query: NewFindAllByQuery(2, 20, "test-slug", "search text", "author-name"),
+			name: "WithFilters",
+			query: NewFindAllByQuery(Filters{
+				PaginationFilters: query.PaginationFilters{Page: 2, PageSize: 20},
+				Slug:              "test-slug",
+				Text:              "search text",
+				Author:            "author-name",
+				Status:            "published",
+				ViewerId:          testAuthorID,
+				TagsAny:           []string{"Go", "sql"},
+				TagsAll:           []string{"news"},
+				Category:          "go",
+				Sort:              "newest",
+			}, true),
 			setupMock: func() {
 				testPosts := []entity.Post{
 					{
@@ -136,12 +163,18 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 						AuthorId:  testAuthorID,
 					},
 				}
-				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 					assert.Equal(s.T(), 2, page)
 					assert.Equal(s.T(), 20, pageSize)
-					assert.Equal(s.T(), "test-slug", slug)
-					assert.Equal(s.T(), "search text", text)
-					assert.Equal(s.T(), "author-name", author)
+					assert.Equal(s.T(), "test-slug", filters.Slug)
+					assert.Equal(s.T(), "search text", filters.Text)
+					assert.Equal(s.T(), "author-name", filters.Author)
+					assert.Equal(s.T(), entity.PostStatusPublished, filters.Status)
+					assert.Equal(s.T(), testAuthorID, filters.ViewerId)
+					assert.Equal(s.T(), []string{"go", "sql"}, filters.TagsAny)
+					assert.Equal(s.T(), []string{"news"}, filters.TagsAll)
+					assert.Equal(s.T(), "go", filters.Category)
+					assert.Equal(s.T(), repository.PostSortNewest, filters.Sort)
 					return repository.PaginatedResult[entity.Post]{
 						Items:    testPosts,
 						Total:    1,
@@ -160,11 +193,36 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 			},
 			expectedResultType: "PaginatedView",
 		},
+		{
+			name:  "Summaries",
+			query: NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, false),
+			setupMock: func() {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+					assert.True(s.T(), filters.WithoutContent)
+					return repository.PaginatedResult[entity.Post]{
+						Items: []entity.Post{
+							{ID: testPostID1, Slug: "test-slug-1", Title: "Test Title 1", Excerpt: "Test excerpt", WordCount: 450, ReadingTimeMinutes: 3, AuthorId: testAuthorID},
+						},
+						Total:    1,
+						Page:     1,
+						PageSize: 10,
+					}, nil
+				}
+			},
+			expectedTotal:    1,
+			expectedPage:     1,
+			expectedPageSize: 10,
+			expectedItemsLen: 1,
+			expectedItems: []entity.Post{
+				{ID: testPostID1, Slug: "test-slug-1", Title: "Test Title 1", Excerpt: "Test excerpt", WordCount: 450, ReadingTimeMinutes: 3, AuthorId: testAuthorID},
+			},
+			expectedResultType: "PaginatedSummaryView",
+		},
 		{
 			name:  "EmptyResult",
-			query: NewFindAllByQuery(1, 10, "", "", ""),
+			query: NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, false),
 			setupMock: func() {
-				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 					return repository.PaginatedResult[entity.Post]{
 						Items:    []entity.Post{},
 						Total:    0,
@@ -179,13 +237,13 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 			expectedPageSize:   10,
 			expectedItemsLen:   0,
 			expectedItems:      []entity.Post{},
-			expectedResultType: "PaginatedView",
+			expectedResultType: "PaginatedSummaryView",
 		},
 		{
 			name:  "RepositoryError",
-			query: NewFindAllByQuery(1, 10, "", "", ""),
+			query: NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, false),
 			setupMock: func() {
-				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 					return repository.PaginatedResult[entity.Post]{}, errors.New("database error")
 				}
 			},
@@ -231,6 +289,21 @@ func (s *FindAllByQueryHandlerTestSuite) TestHandle() {
 						assert.Equal(t, expectedPost.AuthorId, paginatedView.Items[i].AuthorId)
 					}
 				}
+			case "PaginatedSummaryView":
+				paginatedView, ok := result.(view.PaginatedView[view.PostSummaryView])
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedTotal, paginatedView.Total)
+				assert.Equal(t, tt.expectedPage, paginatedView.Page)
+				assert.Equal(t, tt.expectedPageSize, paginatedView.PageSize)
+				assert.Len(t, paginatedView.Items, tt.expectedItemsLen)
+				for i, expectedPost := range tt.expectedItems {
+					if i < len(paginatedView.Items) {
+						assert.Equal(t, expectedPost.ID, paginatedView.Items[i].Id)
+						assert.Equal(t, expectedPost.Excerpt, paginatedView.Items[i].Excerpt)
+						assert.Equal(t, expectedPost.WordCount, paginatedView.Items[i].WordCount)
+						assert.Equal(t, expectedPost.ReadingTimeMinutes, paginatedView.Items[i].ReadingTimeMinutes)
+					}
+				}
 			case "Slice":
 				postViews, ok := result.([]view.PostView)
 				assert.True(t, ok)
@@ -248,7 +321,7 @@ func (s *FindAllByQueryHandlerTestSuite) TestSupports() {
 	}{
 		{
 			name:          "ValidQuery",
-			query:         NewFindAllByQuery(1, 10, "", "", ""),
+			query:         NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, false),
 			expectedValue: true,
 		},
 		{
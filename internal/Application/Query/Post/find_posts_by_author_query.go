@@ -0,0 +1,28 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+// FindPostsByAuthorQuery lists the posts of one author, newest first. Posts
+// other than published ones are only returned when ViewerId is the author.
+type FindPostsByAuthorQuery struct {
+	PaginationFilters query.PaginationFilters
+	AuthorId          uuid.UUID
+	Status            string
+	ViewerId          uuid.UUID
+}
+
+func NewFindPostsByAuthorQuery(page int, pageSize int, authorId uuid.UUID, status string, viewerId uuid.UUID) FindPostsByAuthorQuery {
+	return FindPostsByAuthorQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		AuthorId: authorId,
+		Status:   status,
+		ViewerId: viewerId,
+	}
+}
@@ -0,0 +1,47 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+)
+
+type FindPostsByAuthorQueryHandler struct {
+	PostRepository repository.PostRepository
+}
+
+func (h FindPostsByAuthorQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	findPostsByAuthorQuery, ok := query.(FindPostsByAuthorQuery)
+	if !ok {
+		return []view.PostView{}, nil
+	}
+
+	paginatedResult, err := h.PostRepository.FindAllBy(
+		ctx,
+		findPostsByAuthorQuery.PaginationFilters.Page,
+		findPostsByAuthorQuery.PaginationFilters.PageSize,
+		repository.PostFilters{
+			AuthorId: findPostsByAuthorQuery.AuthorId,
+			Status:   entity.PostStatus(findPostsByAuthorQuery.Status),
+			ViewerId: findPostsByAuthorQuery.ViewerId,
+			Sort:     repository.PostSortNewest,
+		},
+	)
+
+	if err != nil {
+		return []view.PostView{}, err
+	}
+
+	postViews := make([]view.PostView, len(paginatedResult.Items))
+	for i, post := range paginatedResult.Items {
+		postViews[i] = newPostView(post)
+	}
+
+	return view.NewPaginatedView(postViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h FindPostsByAuthorQueryHandler) Supports(query any) bool {
+	_, ok := query.(FindPostsByAuthorQuery)
+	return ok
+}
@@ -0,0 +1,140 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForAuthor struct {
+	findAllByFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error)
+}
+
+func (m *mockPostRepositoryForAuthor) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForAuthor) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForAuthor) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForAuthor) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForAuthor) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	if m.findAllByFunc != nil {
+		return m.findAllByFunc(ctx, page, pageSize, filters)
+	}
+	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForAuthor) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForAuthor) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForAuthor) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type FindPostsByAuthorQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        FindPostsByAuthorQueryHandler
+	MockRepository *mockPostRepositoryForAuthor
+}
+
+func (s *FindPostsByAuthorQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryForAuthor{}
+	s.Handler = FindPostsByAuthorQueryHandler{
+		PostRepository: s.MockRepository,
+	}
+}
+
+func (s *FindPostsByAuthorQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name           string
+		query          any
+		expectedStatus entity.PostStatus
+		expectedViewer uuid.UUID
+		findErr        error
+		expectedError  bool
+	}{
+		{
+			name:           "PublicAuthorPage",
+			query:          NewFindPostsByAuthorQuery(1, 10, testAuthorID, "", uuid.Nil),
+			expectedViewer: uuid.Nil,
+		},
+		{
+			name:           "OwnDrafts",
+			query:          NewFindPostsByAuthorQuery(1, 10, testAuthorID, "draft", testAuthorID),
+			expectedStatus: entity.PostStatusDraft,
+			expectedViewer: testAuthorID,
+		},
+		{
+			name:          "RepositoryError",
+			query:         NewFindPostsByAuthorQuery(1, 10, testAuthorID, "", uuid.Nil),
+			findErr:       errors.New("database error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+				assert.Equal(t, 1, page)
+				assert.Equal(t, 10, pageSize)
+				assert.Equal(t, testAuthorID, filters.AuthorId)
+				assert.Equal(t, tt.expectedStatus, filters.Status)
+				assert.Equal(t, tt.expectedViewer, filters.ViewerId)
+				assert.Equal(t, repository.PostSortNewest, filters.Sort)
+				return repository.PaginatedResult[entity.Post]{
+					Items:    []entity.Post{{ID: testPostID, AuthorId: testAuthorID, Status: entity.PostStatusPublished}},
+					Total:    1,
+					Page:     1,
+					PageSize: 10,
+				}, tt.findErr
+			}
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			paginatedView, ok := result.(view.PaginatedView[view.PostView])
+			assert.True(t, ok)
+			assert.Len(t, paginatedView.Items, 1)
+			assert.Equal(t, testPostID, paginatedView.Items[0].Id)
+		})
+	}
+}
+
+func (s *FindPostsByAuthorQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewFindPostsByAuthorQuery(1, 10, uuid.Nil, "", uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewFindScheduledPostsQuery(1, 10, uuid.Nil)))
+}
+
+func TestFindPostsByAuthorQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FindPostsByAuthorQueryHandlerTestSuite))
+}
@@ -0,0 +1,24 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+// FindReviewQueueQuery lists the posts in review that ReviewerId may review,
+// oldest submission first. uuid.Nil lists every post in review.
+type FindReviewQueueQuery struct {
+	PaginationFilters query.PaginationFilters
+	ReviewerId        uuid.UUID
+}
+
+func NewFindReviewQueueQuery(page int, pageSize int, reviewerId uuid.UUID) FindReviewQueueQuery {
+	return FindReviewQueueQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		ReviewerId: reviewerId,
+	}
+}
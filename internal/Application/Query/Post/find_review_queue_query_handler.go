@@ -0,0 +1,45 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type FindReviewQueueQueryHandler struct {
+	PostRepository repository.PostRepository
+}
+
+func (h FindReviewQueueQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	findReviewQueueQuery, ok := query.(FindReviewQueueQuery)
+	if !ok {
+		return []view.PostView{}, nil
+	}
+
+	paginatedResult, err := h.PostRepository.FindAllBy(
+		ctx,
+		findReviewQueueQuery.PaginationFilters.Page,
+		findReviewQueueQuery.PaginationFilters.PageSize,
+		repository.PostFilters{
+			ReviewQueue:        true,
+			ReviewerId:         findReviewQueueQuery.ReviewerId,
+			IncludeUnpublished: true,
+		},
+	)
+
+	if err != nil {
+		return []view.PostView{}, err
+	}
+
+	postViews := make([]view.PostView, len(paginatedResult.Items))
+	for i, post := range paginatedResult.Items {
+		postViews[i] = newPostView(post)
+	}
+
+	return view.NewPaginatedView(postViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h FindReviewQueueQueryHandler) Supports(query any) bool {
+	_, ok := query.(FindReviewQueueQuery)
+	return ok
+}
@@ -0,0 +1,119 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForReviewQueue struct {
+	findAllByFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error)
+}
+
+func (m *mockPostRepositoryForReviewQueue) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	if m.findAllByFunc != nil {
+		return m.findAllByFunc(ctx, page, pageSize, filters)
+	}
+	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForReviewQueue) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForReviewQueue) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type FindReviewQueueQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        FindReviewQueueQueryHandler
+	MockRepository *mockPostRepositoryForReviewQueue
+}
+
+func (s *FindReviewQueueQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryForReviewQueue{}
+	s.Handler = FindReviewQueueQueryHandler{
+		PostRepository: s.MockRepository,
+	}
+}
+
+func (s *FindReviewQueueQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testReviewerID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	submittedAt := time.Now().Add(-time.Hour)
+	s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+		assert.Equal(s.T(), 2, page)
+		assert.Equal(s.T(), 10, pageSize)
+		assert.Equal(s.T(), repository.PostFilters{ReviewQueue: true, ReviewerId: testReviewerID, IncludeUnpublished: true}, filters)
+		return repository.PaginatedResult[entity.Post]{
+			Items: []entity.Post{
+				{
+					ID:          testPostID,
+					Slug:        "in-review",
+					Status:      entity.PostStatusInReview,
+					SubmittedAt: &submittedAt,
+				},
+			},
+			Total:    11,
+			Page:     2,
+			PageSize: 10,
+		}, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewFindReviewQueueQuery(2, 10, testReviewerID))
+
+	assert.NoError(s.T(), err)
+	paginatedView, ok := result.(view.PaginatedView[view.PostView])
+	assert.True(s.T(), ok)
+	assert.Len(s.T(), paginatedView.Items, 1)
+	assert.Equal(s.T(), testPostID, paginatedView.Items[0].Id)
+	assert.Equal(s.T(), view.NewPostReviewView(&submittedAt, nil, nil, ""), paginatedView.Items[0].Review)
+}
+
+func (s *FindReviewQueueQueryHandlerTestSuite) TestHandleRepositoryError() {
+	s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+		return repository.PaginatedResult[entity.Post]{}, errors.New("database error")
+	}
+
+	_, err := s.Handler.Handle(context.Background(), NewFindReviewQueueQuery(1, 10, uuid.Nil))
+
+	assert.EqualError(s.T(), err, "database error")
+}
+
+func (s *FindReviewQueueQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewFindReviewQueueQuery(1, 10, uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewFindScheduledPostsQuery(1, 10, uuid.Nil)))
+}
+
+func TestFindReviewQueueQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FindReviewQueueQueryHandlerTestSuite))
+}
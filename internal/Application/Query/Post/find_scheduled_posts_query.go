@@ -0,0 +1,22 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+type FindScheduledPostsQuery struct {
+	PaginationFilters query.PaginationFilters
+	AuthorId          uuid.UUID
+}
+
+func NewFindScheduledPostsQuery(page int, pageSize int, authorId uuid.UUID) FindScheduledPostsQuery {
+	return FindScheduledPostsQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		AuthorId: authorId,
+	}
+}
@@ -0,0 +1,45 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type FindScheduledPostsQueryHandler struct {
+	PostRepository repository.PostRepository
+}
+
+func (h FindScheduledPostsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	findScheduledPostsQuery, ok := query.(FindScheduledPostsQuery)
+	if !ok {
+		return []view.PostView{}, nil
+	}
+
+	paginatedResult, err := h.PostRepository.FindAllBy(
+		ctx,
+		findScheduledPostsQuery.PaginationFilters.Page,
+		findScheduledPostsQuery.PaginationFilters.PageSize,
+		repository.PostFilters{
+			AuthorId:  findScheduledPostsQuery.AuthorId,
+			Scheduled: true,
+			ViewerId:  findScheduledPostsQuery.AuthorId,
+		},
+	)
+
+	if err != nil {
+		return []view.PostView{}, err
+	}
+
+	postViews := make([]view.PostView, len(paginatedResult.Items))
+	for i, post := range paginatedResult.Items {
+		postViews[i] = newPostView(post)
+	}
+
+	return view.NewPaginatedView(postViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h FindScheduledPostsQueryHandler) Supports(query any) bool {
+	_, ok := query.(FindScheduledPostsQuery)
+	return ok
+}
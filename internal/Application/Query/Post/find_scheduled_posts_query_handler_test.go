@@ -0,0 +1,150 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	query "main/internal/Application/Query"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForScheduled struct {
+	findAllByFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error)
+}
+
+func (m *mockPostRepositoryForScheduled) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForScheduled) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForScheduled) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForScheduled) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForScheduled) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	if m.findAllByFunc != nil {
+		return m.findAllByFunc(ctx, page, pageSize, filters)
+	}
+	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForScheduled) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForScheduled) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForScheduled) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type FindScheduledPostsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        FindScheduledPostsQueryHandler
+	MockRepository *mockPostRepositoryForScheduled
+}
+
+func (s *FindScheduledPostsQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryForScheduled{}
+	s.Handler = FindScheduledPostsQueryHandler{
+		PostRepository: s.MockRepository,
+	}
+}
+
+func (s *FindScheduledPostsQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	publishAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		query         any
+		setupMock     func()
+		expectedError bool
+		expectedItems int
+	}{
+		{
+			name:  "Success",
+			query: NewFindScheduledPostsQuery(1, 10, testAuthorID),
+			setupMock: func() {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+					assert.Equal(s.T(), 1, page)
+					assert.Equal(s.T(), 10, pageSize)
+					assert.Equal(s.T(), testAuthorID, filters.AuthorId)
+					assert.Equal(s.T(), testAuthorID, filters.ViewerId)
+					assert.True(s.T(), filters.Scheduled)
+					return repository.PaginatedResult[entity.Post]{
+						Items: []entity.Post{
+							{
+								ID:        testPostID,
+								Slug:      "scheduled-slug",
+								AuthorId:  testAuthorID,
+								Status:    entity.PostStatusDraft,
+								PublishAt: &publishAt,
+							},
+						},
+						Total:    1,
+						Page:     1,
+						PageSize: 10,
+					}, nil
+				}
+			},
+			expectedItems: 1,
+		},
+		{
+			name:  "RepositoryError",
+			query: NewFindScheduledPostsQuery(1, 10, testAuthorID),
+			setupMock: func() {
+				s.MockRepository.findAllByFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+					return repository.PaginatedResult[entity.Post]{}, errors.New("database error")
+				}
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			paginatedView, ok := result.(view.PaginatedView[view.PostView])
+			assert.True(t, ok)
+			assert.Len(t, paginatedView.Items, tt.expectedItems)
+			assert.Equal(t, testPostID, paginatedView.Items[0].Id)
+			assert.Equal(t, &publishAt, paginatedView.Items[0].PublishAt)
+		})
+	}
+}
+
+func (s *FindScheduledPostsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewFindScheduledPostsQuery(1, 10, uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewFindAllByQuery(Filters{PaginationFilters: query.PaginationFilters{Page: 1, PageSize: 10}}, false)))
+}
+
+func TestFindScheduledPostsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FindScheduledPostsQueryHandlerTestSuite))
+}
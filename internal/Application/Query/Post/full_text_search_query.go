@@ -0,0 +1,26 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+type FullTextSearchQuery struct {
+	PaginationFilters query.PaginationFilters
+	Text              string
+	Sort              string
+	ViewerId          uuid.UUID
+}
+
+func NewFullTextSearchQuery(page int, pageSize int, text string, sort string, viewerId uuid.UUID) FullTextSearchQuery {
+	return FullTextSearchQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Text:     text,
+		Sort:     sort,
+		ViewerId: viewerId,
+	}
+}
@@ -0,0 +1,54 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type FullTextSearchQueryHandler struct {
+	PostSearchRepository repository.PostSearchRepository
+}
+
+func (h FullTextSearchQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	searchQuery, ok := query.(FullTextSearchQuery)
+	if !ok {
+		return []view.PostSearchResultView{}, nil
+	}
+
+	sort := repository.PostSort(searchQuery.Sort)
+	if sort == "" {
+		sort = repository.PostSortRelevance
+	}
+
+	paginatedResult, err := h.PostSearchRepository.Search(
+		ctx,
+		searchQuery.PaginationFilters.Page,
+		searchQuery.PaginationFilters.PageSize,
+		repository.PostFilters{
+			Text:     searchQuery.Text,
+			ViewerId: searchQuery.ViewerId,
+			Sort:     sort,
+		},
+	)
+	if err != nil {
+		return []view.PostSearchResultView{}, err
+	}
+
+	resultViews := make([]view.PostSearchResultView, len(paginatedResult.Items))
+	for i, result := range paginatedResult.Items {
+		resultViews[i] = view.NewPostSearchResultView(
+			newPostView(result.Post),
+			result.Rank,
+			result.TitleHighlight,
+			result.ContentHighlight,
+		)
+	}
+
+	return view.NewPaginatedView(resultViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h FullTextSearchQueryHandler) Supports(query any) bool {
+	_, ok := query.(FullTextSearchQuery)
+	return ok
+}
@@ -0,0 +1,112 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostSearchRepository struct {
+	searchFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[repository.PostSearchResult], error)
+}
+
+func (m *mockPostSearchRepository) Search(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[repository.PostSearchResult], error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, page, pageSize, filters)
+	}
+	return repository.PaginatedResult[repository.PostSearchResult]{}, errors.New("not implemented")
+}
+
+type FullTextSearchQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        FullTextSearchQueryHandler
+	MockRepository *mockPostSearchRepository
+}
+
+func (s *FullTextSearchQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostSearchRepository{}
+	s.Handler = FullTextSearchQueryHandler{
+		PostSearchRepository: s.MockRepository,
+	}
+}
+
+func (s *FullTextSearchQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testViewerID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := []struct {
+		name          string
+		query         any
+		expectedSort  repository.PostSort
+		searchErr     error
+		expectedError bool
+	}{
+		{
+			name:         "DefaultsToRelevance",
+			query:        NewFullTextSearchQuery(1, 10, `"go generics" -java`, "", testViewerID),
+			expectedSort: repository.PostSortRelevance,
+		},
+		{
+			name:         "ExplicitSort",
+			query:        NewFullTextSearchQuery(1, 10, `"go generics" -java`, "newest", testViewerID),
+			expectedSort: repository.PostSortNewest,
+		},
+		{
+			name:          "RepositoryError",
+			query:         NewFullTextSearchQuery(1, 10, `"go generics" -java`, "", testViewerID),
+			expectedSort:  repository.PostSortRelevance,
+			searchErr:     errors.New("database error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.MockRepository.searchFunc = func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[repository.PostSearchResult], error) {
+				assert.Equal(t, `"go generics" -java`, filters.Text)
+				assert.Equal(t, testViewerID, filters.ViewerId)
+				assert.Equal(t, tt.expectedSort, filters.Sort)
+				return repository.PaginatedResult[repository.PostSearchResult]{
+					Items: []repository.PostSearchResult{
+						{
+							Post:             entity.Post{ID: testPostID, Title: "Go generics", Status: entity.PostStatusPublished},
+							Rank:             0.5,
+							TitleHighlight:   "<mark>Go</mark> <mark>generics</mark>",
+							ContentHighlight: "using <mark>generics</mark>",
+						},
+					},
+					Total:    1,
+					Page:     page,
+					PageSize: pageSize,
+				}, tt.searchErr
+			}
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			paginatedView, ok := result.(view.PaginatedView[view.PostSearchResultView])
+			assert.True(t, ok)
+			assert.Len(t, paginatedView.Items, 1)
+			assert.Equal(t, testPostID, paginatedView.Items[0].Id)
+			assert.Equal(t, 0.5, paginatedView.Items[0].Rank)
+			assert.Equal(t, "<mark>Go</mark> <mark>generics</mark>", paginatedView.Items[0].Highlights.Title)
+			assert.Equal(t, "using <mark>generics</mark>", paginatedView.Items[0].Highlights.Content)
+		})
+	}
+}
+
+func TestFullTextSearchQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FullTextSearchQueryHandlerTestSuite))
+}
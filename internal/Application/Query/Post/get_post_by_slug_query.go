@@ -0,0 +1,9 @@
+package post_query
+
+type GetPostBySlugQuery struct {
+	Slug string `json:"slug"`
+}
+
+func NewGetPostBySlugQuery(slug string) GetPostBySlugQuery {
+	return GetPostBySlugQuery{Slug: slug}
+}
@@ -0,0 +1,49 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetPostBySlugQueryHandler struct {
+	PostRepository        repository.PostRepository
+	SlugHistoryRepository repository.SlugHistoryRepository
+	CategoryRepository    repository.CategoryRepository
+}
+
+// Handle looks the slug up among current slugs first and falls back to the
+// slug history. The returned view always carries the post's current slug, so
+// callers can tell an old slug by comparing it with the one they asked for.
+func (h GetPostBySlugQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getPostBySlugQuery, ok := query.(GetPostBySlugQuery)
+	if !ok {
+		return view.PostView{}, nil
+	}
+
+	post, err := h.PostRepository.FindBySlug(ctx, getPostBySlugQuery.Slug)
+	if err != nil {
+		postId, historyErr := h.SlugHistoryRepository.FindPostIdBySlug(ctx, getPostBySlugQuery.Slug)
+		if historyErr != nil {
+			return view.PostView{}, err
+		}
+
+		post, err = h.PostRepository.FindByID(ctx, postId)
+		if err != nil {
+			return view.PostView{}, err
+		}
+	}
+
+	postView := newPostView(post)
+	postView.Breadcrumbs, err = findBreadcrumbs(ctx, h.CategoryRepository, post)
+	if err != nil {
+		return view.PostView{}, err
+	}
+
+	return postView, nil
+}
+
+func (h GetPostBySlugQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetPostBySlugQuery)
+	return ok
+}
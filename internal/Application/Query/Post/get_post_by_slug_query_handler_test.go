@@ -0,0 +1,190 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForSlug struct {
+	findByIDFunc   func(ctx context.Context, id uuid.UUID) (entity.Post, error)
+	findBySlugFunc func(ctx context.Context, slug string) (entity.Post, error)
+}
+
+func (m *mockPostRepositoryForSlug) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSlug) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSlug) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForSlug) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	if m.findBySlugFunc != nil {
+		return m.findBySlugFunc(ctx, slug)
+	}
+	return entity.Post{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForSlug) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	return repository.PaginatedResult[entity.Post]{}, nil
+}
+
+func (m *mockPostRepositoryForSlug) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSlug) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSlug) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockSlugHistoryRepository struct {
+	findPostIdBySlugFunc func(ctx context.Context, slug string) (uuid.UUID, error)
+}
+
+func (m *mockSlugHistoryRepository) Save(ctx context.Context, slugHistory entity.SlugHistory) error {
+	return nil
+}
+
+func (m *mockSlugHistoryRepository) FindPostIdBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	if m.findPostIdBySlugFunc != nil {
+		return m.findPostIdBySlugFunc(ctx, slug)
+	}
+	return uuid.Nil, errors.New("not implemented")
+}
+
+type GetPostBySlugQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler           GetPostBySlugQueryHandler
+	MockRepository    *mockPostRepositoryForSlug
+	MockSlugHistories *mockSlugHistoryRepository
+}
+
+func (s *GetPostBySlugQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostRepositoryForSlug{}
+	s.MockSlugHistories = &mockSlugHistoryRepository{}
+	s.Handler = GetPostBySlugQueryHandler{
+		PostRepository:        s.MockRepository,
+		SlugHistoryRepository: s.MockSlugHistories,
+	}
+}
+
+func (s *GetPostBySlugQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testPost := entity.Post{
+		ID:       testPostID,
+		Slug:     "currentslug",
+		Title:    "Test Title",
+		Content:  "Test Content",
+		AuthorId: uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Status:   entity.PostStatusPublished,
+	}
+
+	tests := []struct {
+		name          string
+		query         any
+		setupMock     func()
+		expectedError bool
+		expectedView  view.PostView
+	}{
+		{
+			name:  "CurrentSlug",
+			query: NewGetPostBySlugQuery("currentslug"),
+			setupMock: func() {
+				s.MockRepository.findBySlugFunc = func(ctx context.Context, slug string) (entity.Post, error) {
+					assert.Equal(s.T(), "currentslug", slug)
+					return testPost, nil
+				}
+				s.MockSlugHistories.findPostIdBySlugFunc = func(ctx context.Context, slug string) (uuid.UUID, error) {
+					s.T().Error("slug history should not be consulted for a current slug")
+					return uuid.Nil, nil
+				}
+			},
+			expectedView: newPostView(testPost),
+		},
+		{
+			name:  "OldSlug",
+			query: NewGetPostBySlugQuery("oldslug"),
+			setupMock: func() {
+				s.MockRepository.findBySlugFunc = func(ctx context.Context, slug string) (entity.Post, error) {
+					return entity.Post{}, errors.New("record not found")
+				}
+				s.MockSlugHistories.findPostIdBySlugFunc = func(ctx context.Context, slug string) (uuid.UUID, error) {
+					assert.Equal(s.T(), "oldslug", slug)
+					return testPostID, nil
+				}
+				s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+					assert.Equal(s.T(), testPostID, id)
+					return testPost, nil
+				}
+			},
+			expectedView: newPostView(testPost),
+		},
+		{
+			name:  "UnknownSlug",
+			query: NewGetPostBySlugQuery("unknownslug"),
+			setupMock: func() {
+				s.MockRepository.findBySlugFunc = func(ctx context.Context, slug string) (entity.Post, error) {
+					return entity.Post{}, errors.New("record not found")
+				}
+				s.MockSlugHistories.findPostIdBySlugFunc = func(ctx context.Context, slug string) (uuid.UUID, error) {
+					return uuid.Nil, errors.New("record not found")
+				}
+			},
+			expectedError: true,
+		},
+		{
+			name:          "InvalidQueryType",
+			query:         "invalid query",
+			setupMock:     func() {},
+			expectedView:  view.PostView{},
+			expectedError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+			tt.setupMock()
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, view.PostView{}, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedView, result)
+		})
+	}
+}
+
+func (s *GetPostBySlugQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewGetPostBySlugQuery("slug")))
+	assert.False(s.T(), s.Handler.Supports(NewGetPostQuery(uuid.Nil)))
+}
+
+func TestGetPostBySlugQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetPostBySlugQueryHandlerTestSuite))
+}
@@ -0,0 +1,35 @@
+package post_query
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type FeedFormat string
+
+const (
+	FeedFormatRss  FeedFormat = "rss"
+	FeedFormatAtom FeedFormat = "atom"
+	FeedFormatJSON FeedFormat = "json"
+)
+
+func (f FeedFormat) IsValid() bool {
+	switch f {
+	case FeedFormatRss, FeedFormatAtom, FeedFormatJSON:
+		return true
+	}
+	return false
+}
+
+// GetPostFeedQuery builds a feed of the latest published posts. AuthorId and
+// Tag narrow it down to the posts of one author or one tag.
+type GetPostFeedQuery struct {
+	Format   FeedFormat
+	AuthorId uuid.UUID
+	Tag      string
+}
+
+func NewGetPostFeedQuery(format string, authorId uuid.UUID, tag string) GetPostFeedQuery {
+	return GetPostFeedQuery{Format: FeedFormat(format), AuthorId: authorId, Tag: strings.ToLower(strings.TrimSpace(tag))}
+}
@@ -0,0 +1,177 @@
+package post_query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	rendering "main/internal/Application/Rendering"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/feeds"
+)
+
+var ErrUnsupportedFeedFormat = errors.New("unsupported feed format")
+
+var feedContentTypes = map[FeedFormat]string{
+	FeedFormatRss:  "application/rss+xml; charset=utf-8",
+	FeedFormatAtom: "application/atom+xml; charset=utf-8",
+	FeedFormatJSON: "application/feed+json; charset=utf-8",
+}
+
+// GetPostFeedQueryHandler serves feeds from FeedCache and only builds them
+// from the posts table on a miss. Links point at SiteURL, where the client
+// serves posts under /posts/:slug. Items are rendered from the content
+// rather than taken from the cached HTML, which the consumer may not have
+// refreshed yet when the feeds are invalidated.
+type GetPostFeedQueryHandler struct {
+	PostRepository  repository.PostRepository
+	UserRepository  repository.UserRepository
+	FeedCache       repository.FeedCache
+	ContentRenderer rendering.ContentRenderer
+	Title           string
+	Description     string
+	SiteURL         string
+	ItemLimit       int
+}
+
+func (h GetPostFeedQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getPostFeedQuery, ok := query.(GetPostFeedQuery)
+	if !ok {
+		return view.FeedView{}, nil
+	}
+
+	contentType, ok := feedContentTypes[getPostFeedQuery.Format]
+	if !ok {
+		return view.FeedView{}, ErrUnsupportedFeedFormat
+	}
+
+	cached, err := h.FeedCache.Fetch(ctx, feedCacheKey(getPostFeedQuery), func() (repository.CachedFeed, error) {
+		return h.buildFeed(ctx, getPostFeedQuery)
+	})
+	if err != nil {
+		return view.FeedView{}, err
+	}
+
+	return view.NewFeedView(cached.Body, contentType, cached.ETag, cached.LastModified), nil
+}
+
+func (h GetPostFeedQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetPostFeedQuery)
+	return ok
+}
+
+func (h GetPostFeedQueryHandler) buildFeed(ctx context.Context, q GetPostFeedQuery) (repository.CachedFeed, error) {
+	feed := &feeds.Feed{
+		Title:       h.Title,
+		Description: h.Description,
+		Link:        &feeds.Link{Href: h.SiteURL},
+		Id:          h.SiteURL,
+	}
+
+	filters := repository.PostFilters{
+		Status: entity.PostStatusPublished,
+		Sort:   repository.PostSortRecentlyPublished,
+	}
+
+	authors := make(map[uuid.UUID]entity.User)
+	if q.AuthorId != uuid.Nil {
+		author, err := h.UserRepository.FindByID(ctx, q.AuthorId)
+		if err != nil {
+			return repository.CachedFeed{}, err
+		}
+		authors[author.ID] = author
+		filters.AuthorId = author.ID
+		feed.Title = h.Title + " - " + author.Name
+		feed.Link.Href = h.SiteURL + "/authors/" + author.ID.String()
+		feed.Id = feed.Link.Href
+	}
+	if q.Tag != "" {
+		filters.TagsAny = []string{q.Tag}
+		feed.Title = feed.Title + " - #" + q.Tag
+		feed.Link.Href = h.SiteURL + "/tags/" + url.PathEscape(q.Tag)
+		feed.Id = feed.Link.Href
+	}
+
+	result, err := h.PostRepository.FindAllBy(ctx, 1, h.ItemLimit, filters)
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+
+	var lastModified time.Time
+	for _, post := range result.Items {
+		author, ok := authors[post.AuthorId]
+		if !ok {
+			author, err = h.UserRepository.FindByID(ctx, post.AuthorId)
+			if err != nil {
+				return repository.CachedFeed{}, err
+			}
+			authors[post.AuthorId] = author
+		}
+
+		contentHTML, _, err := h.ContentRenderer.Render(post.ContentFormat, post.Content)
+		if err != nil {
+			return repository.CachedFeed{}, err
+		}
+
+		feed.Add(newFeedItem(h.SiteURL, post, author, contentHTML))
+		if post.UpdatedAt.After(lastModified) {
+			lastModified = post.UpdatedAt
+		}
+	}
+	feed.Updated = lastModified
+
+	body, err := serializeFeed(feed, q.Format)
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+
+	return repository.CachedFeed{Body: body, ETag: feedETag(body), LastModified: lastModified}, nil
+}
+
+func newFeedItem(siteURL string, post entity.Post, author entity.User, contentHTML string) *feeds.Item {
+	created := post.CreatedAt
+	if post.PublishedAt != nil {
+		created = *post.PublishedAt
+	}
+
+	return &feeds.Item{
+		Id:          "urn:uuid:" + post.ID.String(),
+		Title:       post.Title,
+		Link:        &feeds.Link{Href: siteURL + "/posts/" + url.PathEscape(post.Slug)},
+		Author:      &feeds.Author{Name: author.Name},
+		Description: post.Excerpt,
+		Content:     contentHTML,
+		Created:     created,
+		Updated:     post.UpdatedAt,
+	}
+}
+
+func serializeFeed(feed *feeds.Feed, format FeedFormat) (string, error) {
+	switch format {
+	case FeedFormatAtom:
+		return feed.ToAtom()
+	case FeedFormatJSON:
+		return feed.ToJSON()
+	}
+	return feed.ToRss()
+}
+
+func feedCacheKey(q GetPostFeedQuery) string {
+	author := ""
+	if q.AuthorId != uuid.Nil {
+		author = q.AuthorId.String()
+	}
+	return strings.Join([]string{string(q.Format), author, q.Tag}, ":")
+}
+
+func feedETag(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
@@ -0,0 +1,273 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	rendering "main/internal/Application/Rendering"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForFeed struct {
+	findAllByFunc func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error)
+	findAllCalls  int
+}
+
+func (m *mockPostRepositoryForFeed) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForFeed) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForFeed) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForFeed) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForFeed) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	m.findAllCalls++
+	if m.findAllByFunc != nil {
+		return m.findAllByFunc(ctx, page, pageSize, filters)
+	}
+	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
+}
+
+func (m *mockPostRepositoryForFeed) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForFeed) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForFeed) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockUserRepositoryForFeed struct {
+	users map[uuid.UUID]entity.User
+}
+
+func (m *mockUserRepositoryForFeed) Save(ctx context.Context, user entity.User) error {
+	return nil
+}
+
+func (m *mockUserRepositoryForFeed) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return entity.User{}, errors.New("record not found")
+	}
+	return user, nil
+}
+
+func (m *mockUserRepositoryForFeed) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
+	return entity.User{}, nil
+}
+
+type mockFeedCache struct {
+	feeds map[string]repository.CachedFeed
+}
+
+func (m *mockFeedCache) Fetch(ctx context.Context, key string, build func() (repository.CachedFeed, error)) (repository.CachedFeed, error) {
+	if feed, ok := m.feeds[key]; ok {
+		return feed, nil
+	}
+	feed, err := build()
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+	m.feeds[key] = feed
+	return feed, nil
+}
+
+func (m *mockFeedCache) Invalidate(ctx context.Context) error {
+	m.feeds = map[string]repository.CachedFeed{}
+	return nil
+}
+
+type GetPostFeedQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler            GetPostFeedQueryHandler
+	MockPostRepository *mockPostRepositoryForFeed
+	MockFeedCache      *mockFeedCache
+	AuthorID           uuid.UUID
+	Posts              []entity.Post
+	LastFilters        repository.PostFilters
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) SetupTest() {
+	s.AuthorID = uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	publishedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	s.Posts = []entity.Post{
+		{
+			ID:            uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			CreatedAt:     time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt:     time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC),
+			PublishedAt:   &publishedAt,
+			Slug:          "second-post",
+			Title:         "Second post",
+			Excerpt:       "Second excerpt.",
+			Content:       "Second **content**",
+			ContentFormat: entity.ContentFormatMarkdown,
+			// The consumer has not rendered the latest update yet.
+			ContentHTML: "<p>Stale content</p>",
+			AuthorId:    s.AuthorID,
+			Status:      entity.PostStatusPublished,
+		},
+		{
+			ID:            uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+			CreatedAt:     time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+			UpdatedAt:     time.Date(2025, 1, 5, 10, 0, 0, 0, time.UTC),
+			PublishedAt:   &publishedAt,
+			Slug:          "first-post",
+			Title:         "First post",
+			Excerpt:       "First excerpt.",
+			Content:       "First content",
+			ContentFormat: entity.ContentFormatMarkdown,
+			ContentHTML:   "<p>First content</p>",
+			AuthorId:      s.AuthorID,
+			Status:        entity.PostStatusPublished,
+		},
+	}
+
+	s.MockPostRepository = &mockPostRepositoryForFeed{
+		findAllByFunc: func(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+			s.LastFilters = filters
+			return repository.PaginatedResult[entity.Post]{Items: s.Posts, Total: int64(len(s.Posts)), Page: page, PageSize: pageSize}, nil
+		},
+	}
+	s.MockFeedCache = &mockFeedCache{feeds: map[string]repository.CachedFeed{}}
+	s.Handler = GetPostFeedQueryHandler{
+		PostRepository: s.MockPostRepository,
+		UserRepository: &mockUserRepositoryForFeed{users: map[uuid.UUID]entity.User{
+			s.AuthorID: {ID: s.AuthorID, Name: "Jane Author"},
+		}},
+		FeedCache:       s.MockFeedCache,
+		ContentRenderer: rendering.NewContentRenderer(),
+		Title:           "Test Blog",
+		Description:     "Test description",
+		SiteURL:         "https://blog.example.com",
+		ItemLimit:       20,
+	}
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleRss() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, ""))
+
+	assert.NoError(s.T(), err)
+	feedView, ok := result.(view.FeedView)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "application/rss+xml; charset=utf-8", feedView.ContentType)
+	assert.Contains(s.T(), feedView.Body, "<title>Test Blog</title>")
+	assert.Contains(s.T(), feedView.Body, "<link>https://blog.example.com/posts/second-post</link>")
+	assert.Contains(s.T(), feedView.Body, "<title>First post</title>")
+	assert.Contains(s.T(), feedView.Body, "Jane Author")
+	assert.NotEmpty(s.T(), feedView.ETag)
+	assert.Equal(s.T(), s.Posts[0].UpdatedAt, feedView.LastModified)
+
+	assert.Equal(s.T(), entity.PostStatusPublished, s.LastFilters.Status)
+	assert.Equal(s.T(), repository.PostSortRecentlyPublished, s.LastFilters.Sort)
+	assert.Equal(s.T(), uuid.Nil, s.LastFilters.AuthorId)
+	assert.Empty(s.T(), s.LastFilters.TagsAny)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleRendersCurrentContent() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("atom", uuid.Nil, ""))
+
+	assert.NoError(s.T(), err)
+	body := result.(view.FeedView).Body
+	assert.Contains(s.T(), body, "Second &lt;strong&gt;content&lt;/strong&gt;")
+	assert.NotContains(s.T(), body, "Stale content")
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleAtomAndJSON() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("atom", uuid.Nil, ""))
+	assert.NoError(s.T(), err)
+	atomView := result.(view.FeedView)
+	assert.Equal(s.T(), "application/atom+xml; charset=utf-8", atomView.ContentType)
+	assert.Contains(s.T(), atomView.Body, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	assert.Contains(s.T(), atomView.Body, "<updated>2025-03-02T10:00:00Z</updated>")
+
+	result, err = s.Handler.Handle(context.Background(), NewGetPostFeedQuery("json", uuid.Nil, ""))
+	assert.NoError(s.T(), err)
+	jsonView := result.(view.FeedView)
+	assert.Equal(s.T(), "application/feed+json; charset=utf-8", jsonView.ContentType)
+	assert.Contains(s.T(), jsonView.Body, `"url": "https://blog.example.com/posts/first-post"`)
+	assert.NotEqual(s.T(), atomView.ETag, jsonView.ETag)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleAuthorFeed() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", s.AuthorID, ""))
+
+	assert.NoError(s.T(), err)
+	assert.Contains(s.T(), result.(view.FeedView).Body, "<title>Test Blog - Jane Author</title>")
+	assert.Equal(s.T(), s.AuthorID, s.LastFilters.AuthorId)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleUnknownAuthor() {
+	_, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.New(), ""))
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), 0, s.MockPostRepository.findAllCalls)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleTagFeed() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, " Golang "))
+
+	assert.NoError(s.T(), err)
+	assert.Contains(s.T(), result.(view.FeedView).Body, "<title>Test Blog - #golang</title>")
+	assert.Equal(s.T(), []string{"golang"}, s.LastFilters.TagsAny)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleServesCachedFeed() {
+	first, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, ""))
+	assert.NoError(s.T(), err)
+	second, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, ""))
+	assert.NoError(s.T(), err)
+
+	assert.Equal(s.T(), first, second)
+	assert.Equal(s.T(), 1, s.MockPostRepository.findAllCalls)
+
+	_, err = s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, "golang"))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, s.MockPostRepository.findAllCalls)
+
+	assert.NoError(s.T(), s.MockFeedCache.Invalidate(context.Background()))
+	_, err = s.Handler.Handle(context.Background(), NewGetPostFeedQuery("rss", uuid.Nil, ""))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, s.MockPostRepository.findAllCalls)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleUnsupportedFormat() {
+	_, err := s.Handler.Handle(context.Background(), NewGetPostFeedQuery("yaml", uuid.Nil, ""))
+
+	assert.ErrorIs(s.T(), err, ErrUnsupportedFeedFormat)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.FeedView{}, result)
+}
+
+func (s *GetPostFeedQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetPostFeedQuery{}))
+	assert.False(s.T(), s.Handler.Supports(GetPostQuery{}))
+}
+
+func TestGetPostFeedQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetPostFeedQueryHandlerTestSuite))
+}
@@ -0,0 +1,11 @@
+package post_query
+
+import "github.com/google/uuid"
+
+type GetPostMetaQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetPostMetaQuery(id uuid.UUID) GetPostMetaQuery {
+	return GetPostMetaQuery{Id: id}
+}
@@ -0,0 +1,121 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GetPostMetaQueryHandler builds the page metadata of a post. The SEO fields
+// of the post win over the title, excerpt and SiteURL/posts/:slug it falls
+// back to. A cover whose media is gone is left out rather than failing.
+type GetPostMetaQueryHandler struct {
+	PostRepository  repository.PostRepository
+	MediaRepository repository.MediaRepository
+	Storage         repository.Storage
+	SiteURL         string
+	SiteName        string
+	// TwitterSite is the @handle of the site, left out when empty.
+	TwitterSite string
+}
+
+func (h GetPostMetaQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getPostMetaQuery, ok := query.(GetPostMetaQuery)
+	if !ok {
+		return view.PostMetaView{}, nil
+	}
+
+	post, err := h.PostRepository.FindByID(ctx, getPostMetaQuery.Id)
+	if err != nil {
+		return view.PostMetaView{}, err
+	}
+
+	title := post.SeoTitle
+	if title == "" {
+		title = post.Title
+	}
+	description := post.MetaDescription
+	if description == "" {
+		description = post.Excerpt
+	}
+	canonicalURL := post.CanonicalURL
+	if canonicalURL == "" {
+		canonicalURL = h.SiteURL + "/posts/" + url.PathEscape(post.Slug)
+	}
+
+	var imageURL string
+	var imageWidth, imageHeight int
+	if post.CoverMediaId != nil {
+		if media, err := h.MediaRepository.FindByID(ctx, *post.CoverMediaId); err == nil {
+			imageURL, imageWidth, imageHeight = h.previewImage(media)
+		}
+	}
+
+	meta := []view.MetaTagView{view.NewOpenGraphTagView("og:type", "article")}
+	appendOpenGraph := func(property string, content string) {
+		if content != "" {
+			meta = append(meta, view.NewOpenGraphTagView(property, content))
+		}
+	}
+	appendOpenGraph("og:site_name", h.SiteName)
+	appendOpenGraph("og:title", title)
+	appendOpenGraph("og:description", description)
+	appendOpenGraph("og:url", canonicalURL)
+	appendOpenGraph("og:image", imageURL)
+	if imageURL != "" && imageWidth > 0 {
+		appendOpenGraph("og:image:width", strconv.Itoa(imageWidth))
+		appendOpenGraph("og:image:height", strconv.Itoa(imageHeight))
+	}
+	if post.PublishedAt != nil {
+		appendOpenGraph("article:published_time", post.PublishedAt.UTC().Format(time.RFC3339))
+	}
+	appendOpenGraph("article:modified_time", post.UpdatedAt.UTC().Format(time.RFC3339))
+	appendOpenGraph("article:author", h.SiteURL+"/authors/"+post.AuthorId.String())
+	for _, tag := range post.TagNames() {
+		appendOpenGraph("article:tag", tag)
+	}
+
+	card := "summary"
+	if imageURL != "" {
+		card = "summary_large_image"
+	}
+	meta = append(meta, view.NewTwitterCardTagView("twitter:card", card))
+	appendTwitterCard := func(name string, content string) {
+		if content != "" {
+			meta = append(meta, view.NewTwitterCardTagView(name, content))
+		}
+	}
+	appendTwitterCard("twitter:site", h.TwitterSite)
+	appendTwitterCard("twitter:title", title)
+	appendTwitterCard("twitter:description", description)
+	appendTwitterCard("twitter:image", imageURL)
+
+	return view.NewPostMetaView(title, description, canonicalURL, imageURL, imageWidth, imageHeight, meta), nil
+}
+
+// previewImage picks the largest JPEG or PNG variant of the cover, as not
+// every link preview crawler reads WebP, and falls back to the original.
+func (h GetPostMetaQueryHandler) previewImage(media entity.Media) (string, int, int) {
+	var best *entity.MediaVariant
+	for i, variant := range media.Variants {
+		if variant.ContentType == "image/webp" {
+			continue
+		}
+		if best == nil || variant.Width > best.Width {
+			best = &media.Variants[i]
+		}
+	}
+	if best == nil {
+		return h.Storage.URL(media.StorageKey), media.Width, media.Height
+	}
+	return h.Storage.URL(best.StorageKey), best.Width, best.Height
+}
+
+func (h GetPostMetaQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetPostMetaQuery)
+	return ok
+}
@@ -0,0 +1,204 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	"io"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockMediaRepositoryForMeta struct {
+	media map[uuid.UUID]entity.Media
+}
+
+func (m *mockMediaRepositoryForMeta) SaveWithinQuota(ctx context.Context, media entity.Media, quota int64) error {
+	return nil
+}
+
+func (m *mockMediaRepositoryForMeta) FindByID(ctx context.Context, id uuid.UUID) (entity.Media, error) {
+	media, ok := m.media[id]
+	if !ok {
+		return entity.Media{}, errors.New("record not found")
+	}
+	return media, nil
+}
+
+func (m *mockMediaRepositoryForMeta) UsedBytes(ctx context.Context, ownerId uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockMediaRepositoryForMeta) SaveVariants(ctx context.Context, media entity.Media) error {
+	return nil
+}
+
+type mockStorageForMeta struct{}
+
+func (m mockStorageForMeta) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	return nil
+}
+
+func (m mockStorageForMeta) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return nil, nil
+}
+
+func (m mockStorageForMeta) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (m mockStorageForMeta) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+type GetPostMetaQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler         GetPostMetaQueryHandler
+	PostRepository  *mockPostRepository
+	MediaRepository *mockMediaRepositoryForMeta
+	Post            entity.Post
+	Media           entity.Media
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) SetupTest() {
+	publishedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	s.Post = entity.Post{
+		ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		UpdatedAt:   time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC),
+		Slug:        "test-slug",
+		Title:       "Test Title",
+		Excerpt:     "Test excerpt",
+		AuthorId:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Status:      entity.PostStatusPublished,
+		PublishedAt: &publishedAt,
+		Tags:        []entity.Tag{{Name: "go"}},
+	}
+
+	media, err := entity.NewMedia(uuid.MustParse("323e4567-e89b-12d3-a456-426614174002"), time.Now(), s.Post.AuthorId, "cover.jpg", "image/jpeg", 1024)
+	if err != nil {
+		panic(err)
+	}
+	media.Width, media.Height = 2000, 1000
+	s.Media = media
+
+	s.PostRepository = &mockPostRepository{findByIDFunc: func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+		if id != s.Post.ID {
+			return entity.Post{}, errors.New("record not found")
+		}
+		return s.Post, nil
+	}}
+	s.MediaRepository = &mockMediaRepositoryForMeta{media: map[uuid.UUID]entity.Media{}}
+	s.Handler = GetPostMetaQueryHandler{
+		PostRepository:  s.PostRepository,
+		MediaRepository: s.MediaRepository,
+		Storage:         mockStorageForMeta{},
+		SiteURL:         "https://blog.example.com",
+		SiteName:        "Example Blog",
+		TwitterSite:     "@example",
+	}
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleFallbacks() {
+	result, err := s.Handler.Handle(context.Background(), NewGetPostMetaQuery(s.Post.ID))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.NewPostMetaView(
+		"Test Title",
+		"Test excerpt",
+		"https://blog.example.com/posts/test-slug",
+		"",
+		0,
+		0,
+		[]view.MetaTagView{
+			view.NewOpenGraphTagView("og:type", "article"),
+			view.NewOpenGraphTagView("og:site_name", "Example Blog"),
+			view.NewOpenGraphTagView("og:title", "Test Title"),
+			view.NewOpenGraphTagView("og:description", "Test excerpt"),
+			view.NewOpenGraphTagView("og:url", "https://blog.example.com/posts/test-slug"),
+			view.NewOpenGraphTagView("article:published_time", "2025-01-01T10:00:00Z"),
+			view.NewOpenGraphTagView("article:modified_time", "2025-01-02T10:00:00Z"),
+			view.NewOpenGraphTagView("article:author", "https://blog.example.com/authors/223e4567-e89b-12d3-a456-426614174001"),
+			view.NewOpenGraphTagView("article:tag", "go"),
+			view.NewTwitterCardTagView("twitter:card", "summary"),
+			view.NewTwitterCardTagView("twitter:site", "@example"),
+			view.NewTwitterCardTagView("twitter:title", "Test Title"),
+			view.NewTwitterCardTagView("twitter:description", "Test excerpt"),
+		},
+	), result)
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleSeoFieldsAndCover() {
+	s.Media.Variants = []entity.MediaVariant{
+		entity.NewMediaVariant(uuid.New(), s.Media, "large", "image/webp", 1536, 768, 100),
+		entity.NewMediaVariant(uuid.New(), s.Media, "medium", "image/jpeg", 768, 384, 100),
+		entity.NewMediaVariant(uuid.New(), s.Media, "large", "image/jpeg", 1536, 768, 100),
+	}
+	s.MediaRepository.media[s.Media.ID] = s.Media
+	s.Post.CoverMediaId = &s.Media.ID
+	s.Post.SeoTitle = "SEO Title"
+	s.Post.MetaDescription = "Meta description"
+	s.Post.CanonicalURL = "https://example.com/original"
+
+	result, err := s.Handler.Handle(context.Background(), NewGetPostMetaQuery(s.Post.ID))
+
+	assert.NoError(s.T(), err)
+	metaView := result.(view.PostMetaView)
+	assert.Equal(s.T(), "SEO Title", metaView.Title)
+	assert.Equal(s.T(), "Meta description", metaView.Description)
+	assert.Equal(s.T(), "https://example.com/original", metaView.CanonicalURL)
+	assert.Equal(s.T(), "https://cdn.example.com/223e4567-e89b-12d3-a456-426614174001/323e4567-e89b-12d3-a456-426614174002-large.jpg", metaView.ImageURL)
+	assert.Equal(s.T(), 1536, metaView.ImageWidth)
+	assert.Equal(s.T(), 768, metaView.ImageHeight)
+	assert.Contains(s.T(), metaView.Meta, view.NewOpenGraphTagView("og:image", metaView.ImageURL))
+	assert.Contains(s.T(), metaView.Meta, view.NewOpenGraphTagView("og:image:width", "1536"))
+	assert.Contains(s.T(), metaView.Meta, view.NewTwitterCardTagView("twitter:card", "summary_large_image"))
+	assert.Contains(s.T(), metaView.Meta, view.NewTwitterCardTagView("twitter:image", metaView.ImageURL))
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleCoverWithoutVariants() {
+	s.MediaRepository.media[s.Media.ID] = s.Media
+	s.Post.CoverMediaId = &s.Media.ID
+
+	result, err := s.Handler.Handle(context.Background(), NewGetPostMetaQuery(s.Post.ID))
+
+	assert.NoError(s.T(), err)
+	metaView := result.(view.PostMetaView)
+	assert.Equal(s.T(), "https://cdn.example.com/"+s.Media.StorageKey, metaView.ImageURL)
+	assert.Equal(s.T(), 2000, metaView.ImageWidth)
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleMissingCover() {
+	s.Post.CoverMediaId = &s.Media.ID
+
+	result, err := s.Handler.Handle(context.Background(), NewGetPostMetaQuery(s.Post.ID))
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), result.(view.PostMetaView).ImageURL)
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleNotFound() {
+	_, err := s.Handler.Handle(context.Background(), NewGetPostMetaQuery(uuid.New()))
+
+	assert.EqualError(s.T(), err, "record not found")
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.PostMetaView{}, result)
+}
+
+func (s *GetPostMetaQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetPostMetaQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestGetPostMetaQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetPostMetaQueryHandlerTestSuite))
+}
@@ -2,12 +2,16 @@ package post_query
 
 import (
 	"context"
+	"errors"
 	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
 	repository "main/internal/Domain/Repository"
 )
 
 type GetPostQueryHandler struct {
-	PostRepository repository.PostRepository
+	PostRepository     repository.PostRepository
+	SeriesRepository   repository.SeriesRepository
+	CategoryRepository repository.CategoryRepository
 }
 
 func (h GetPostQueryHandler) Handle(ctx context.Context, query any) (any, error) {
@@ -22,13 +26,83 @@ func (h GetPostQueryHandler) Handle(ctx context.Context, query any) (any, error)
 		return view.PostView{}, err
 	}
 
-	return view.NewPostView(
-		post.ID,
-		post.Slug,
-		post.Title,
-		post.Content,
-		post.AuthorId,
-	), nil
+	postView := newPostView(post)
+	postView.Breadcrumbs, err = findBreadcrumbs(ctx, h.CategoryRepository, post)
+	if err != nil {
+		return view.PostView{}, err
+	}
+
+	series, err := h.SeriesRepository.FindByPostId(ctx, post.ID)
+	if err != nil && !errors.Is(err, repository.ErrSeriesNotFound) {
+		return view.PostView{}, err
+	}
+	if err == nil {
+		postView.Series = newPostSeriesView(series, post)
+	}
+
+	return postView, nil
+}
+
+// findBreadcrumbs returns the path from the root category down to the
+// category of the post, or nil for posts without a category.
+func findBreadcrumbs(ctx context.Context, categoryRepository repository.CategoryRepository, post entity.Post) ([]view.BreadcrumbView, error) {
+	if post.CategoryId == nil {
+		return nil, nil
+	}
+
+	categories, err := categoryRepository.FindWithAncestors(ctx, *post.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	breadcrumbs := make([]view.BreadcrumbView, len(categories))
+	for i, category := range categories {
+		breadcrumbs[i] = view.NewBreadcrumbView(category.ID, category.Name, category.Slug)
+	}
+	return breadcrumbs, nil
+}
+
+// newPostSeriesView links a published post to the published parts around it
+// only, so readers are not sent to drafts. Unpublished posts are seen by
+// their author alone, who gets the whole series except for trashed parts.
+func newPostSeriesView(series entity.Series, post entity.Post) *view.PostSeriesView {
+	parts := make([]entity.SeriesPost, 0, len(series.Posts))
+	for _, seriesPost := range series.Posts {
+		if seriesPost.Post.IsTrashed() {
+			continue
+		}
+		if seriesPost.PostId == post.ID || !post.IsPublished() || seriesPost.Post.IsPublished() {
+			parts = append(parts, seriesPost)
+		}
+	}
+
+	var previous, next *view.SeriesPostView
+	index := 0
+	for i, part := range parts {
+		if part.PostId == post.ID {
+			index = i
+		}
+	}
+	if index > 0 {
+		previous = newSeriesPostView(parts[index-1])
+	}
+	if index < len(parts)-1 {
+		next = newSeriesPostView(parts[index+1])
+	}
+
+	seriesView := view.NewPostSeriesView(series.ID, series.Title, index+1, len(parts), previous, next)
+	return &seriesView
+}
+
+func newSeriesPostView(seriesPost entity.SeriesPost) *view.SeriesPostView {
+	seriesPostView := view.NewSeriesPostView(
+		seriesPost.PostId,
+		seriesPost.Post.Slug,
+		seriesPost.Post.Title,
+		string(seriesPost.Post.Status),
+		seriesPost.Position,
+	)
+	return &seriesPostView
 }
 
 func (h GetPostQueryHandler) Supports(query any) bool {
@@ -3,6 +3,7 @@ package post_query
 import (
 	"context"
 	"errors"
+	"fmt"
 	view "main/internal/Application/View"
 	entity "main/internal/Domain/Entity"
 	repository "main/internal/Domain/Repository"
@@ -33,7 +34,11 @@ func (m *mockPostRepository) FindByID(ctx context.Context, id uuid.UUID) (entity
 	return entity.Post{}, errors.New("not implemented")
 }
 
-func (m *mockPostRepository) FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+func (m *mockPostRepository) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepository) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 	return repository.PaginatedResult[entity.Post]{}, nil
 }
 
@@ -41,16 +46,93 @@ func (m *mockPostRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (m *mockPostRepository) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepository) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockSeriesRepository struct {
+	series []entity.Series
+}
+
+func (m *mockSeriesRepository) Save(ctx context.Context, series entity.Series) error {
+	return nil
+}
+
+func (m *mockSeriesRepository) Update(ctx context.Context, series entity.Series) error {
+	return nil
+}
+
+func (m *mockSeriesRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	for _, series := range m.series {
+		if series.ID == id {
+			return series, nil
+		}
+	}
+	return entity.Series{}, errors.New("record not found")
+}
+
+func (m *mockSeriesRepository) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	for _, series := range m.series {
+		if series.IndexOf(postId) >= 0 {
+			return series, nil
+		}
+	}
+	return entity.Series{}, repository.ErrSeriesNotFound
+}
+
+type mockCategoryRepository struct {
+	categories []entity.Category
+}
+
+func (m *mockCategoryRepository) Save(ctx context.Context, category entity.Category) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) Update(ctx context.Context, category entity.Category) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockCategoryRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error) {
+	return entity.Category{}, errors.New("not implemented")
+}
+
+func (m *mockCategoryRepository) FindAll(ctx context.Context) ([]entity.Category, error) {
+	return m.categories, nil
+}
+
+func (m *mockCategoryRepository) FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error) {
+	for i, category := range m.categories {
+		if category.ID == id {
+			return m.categories[:i+1], nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
 type GetPostQueryHandlerTestSuite struct {
 	suite.Suite
-	Handler        GetPostQueryHandler
-	MockRepository *mockPostRepository
+	Handler            GetPostQueryHandler
+	MockRepository     *mockPostRepository
+	SeriesRepository   *mockSeriesRepository
+	CategoryRepository *mockCategoryRepository
 }
 
 func (s *GetPostQueryHandlerTestSuite) SetupTest() {
 	s.MockRepository = &mockPostRepository{}
+	s.SeriesRepository = &mockSeriesRepository{}
+	s.CategoryRepository = &mockCategoryRepository{}
 	s.Handler = GetPostQueryHandler{
-		PostRepository: s.MockRepository,
+		PostRepository:     s.MockRepository,
+		SeriesRepository:   s.SeriesRepository,
+		CategoryRepository: s.CategoryRepository,
 	}
 }
 
@@ -140,6 +222,131 @@ func (s *GetPostQueryHandlerTestSuite) TestHandle() {
 	}
 }
 
+func (s *GetPostQueryHandlerTestSuite) TestHandleSeriesNavigation() {
+	parts := make([]entity.Post, 4)
+	for i := range parts {
+		parts[i] = entity.Post{
+			ID:     uuid.New(),
+			Slug:   fmt.Sprintf("part-%d", i+1),
+			Title:  fmt.Sprintf("Part %d", i+1),
+			Status: entity.PostStatusPublished,
+		}
+	}
+	// The third part is still being written.
+	parts[2].Status = entity.PostStatusDraft
+
+	series := entity.NewSeries(uuid.New(), time.Now(), uuid.New(), "Go tutorial", "")
+	for _, part := range parts {
+		if err := series.AddPost(part.ID, time.Now()); err != nil {
+			panic(err)
+		}
+	}
+	for i := range series.Posts {
+		series.Posts[i].Post = parts[i]
+	}
+	s.SeriesRepository.series = []entity.Series{series}
+
+	tests := []struct {
+		name             string
+		post             entity.Post
+		expectedPosition int
+		expectedTotal    int
+		expectedPrevious string
+		expectedNext     string
+	}{
+		{
+			name:             "First",
+			post:             parts[0],
+			expectedPosition: 1,
+			expectedTotal:    3,
+			expectedNext:     "part-2",
+		},
+		{
+			name:             "SkipsDrafts",
+			post:             parts[1],
+			expectedPosition: 2,
+			expectedTotal:    3,
+			expectedPrevious: "part-1",
+			expectedNext:     "part-4",
+		},
+		{
+			name:             "Last",
+			post:             parts[3],
+			expectedPosition: 3,
+			expectedTotal:    3,
+			expectedPrevious: "part-2",
+		},
+		{
+			name:             "DraftSeesWholeSeries",
+			post:             parts[2],
+			expectedPosition: 3,
+			expectedTotal:    4,
+			expectedPrevious: "part-2",
+			expectedNext:     "part-4",
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+				return tt.post, nil
+			}
+
+			result, err := s.Handler.Handle(context.Background(), NewGetPostQuery(tt.post.ID))
+
+			assert.NoError(t, err)
+			seriesView := result.(view.PostView).Series
+			if !assert.NotNil(t, seriesView) {
+				return
+			}
+			assert.Equal(t, series.ID, seriesView.Id)
+			assert.Equal(t, "Go tutorial", seriesView.Title)
+			assert.Equal(t, tt.expectedPosition, seriesView.Position)
+			assert.Equal(t, tt.expectedTotal, seriesView.Total)
+			if tt.expectedPrevious == "" {
+				assert.Nil(t, seriesView.Previous)
+			} else if assert.NotNil(t, seriesView.Previous) {
+				assert.Equal(t, tt.expectedPrevious, seriesView.Previous.Slug)
+			}
+			if tt.expectedNext == "" {
+				assert.Nil(t, seriesView.Next)
+			} else if assert.NotNil(t, seriesView.Next) {
+				assert.Equal(t, tt.expectedNext, seriesView.Next.Slug)
+			}
+		})
+	}
+}
+
+func (s *GetPostQueryHandlerTestSuite) TestHandleBreadcrumbs() {
+	programming := entity.NewCategory(uuid.New(), time.Now(), nil, "Programming", "programming")
+	golang := entity.NewCategory(uuid.New(), time.Now(), &programming, "Go", "go")
+	s.CategoryRepository.categories = []entity.Category{programming, golang}
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+		return entity.Post{ID: id, CategoryId: &golang.ID}, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetPostQuery(uuid.New()))
+
+	assert.NoError(s.T(), err)
+	postView := result.(view.PostView)
+	assert.Equal(s.T(), &golang.ID, postView.CategoryId)
+	assert.Equal(s.T(), []view.BreadcrumbView{
+		view.NewBreadcrumbView(programming.ID, "Programming", "programming"),
+		view.NewBreadcrumbView(golang.ID, "Go", "go"),
+	}, postView.Breadcrumbs)
+}
+
+func (s *GetPostQueryHandlerTestSuite) TestHandleWithoutSeries() {
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+		return entity.Post{ID: id}, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetPostQuery(uuid.New()))
+
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), result.(view.PostView).Series)
+}
+
 func (s *GetPostQueryHandlerTestSuite) TestSupports() {
 	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
 
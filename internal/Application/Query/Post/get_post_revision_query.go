@@ -0,0 +1,12 @@
+package post_query
+
+import "github.com/google/uuid"
+
+type GetPostRevisionQuery struct {
+	PostId   uuid.UUID `json:"post_id"`
+	Revision int       `json:"revision"`
+}
+
+func NewGetPostRevisionQuery(postId uuid.UUID, revision int) GetPostRevisionQuery {
+	return GetPostRevisionQuery{PostId: postId, Revision: revision}
+}
@@ -0,0 +1,30 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetPostRevisionQueryHandler struct {
+	PostRevisionRepository repository.PostRevisionRepository
+}
+
+func (h GetPostRevisionQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getPostRevisionQuery, ok := query.(GetPostRevisionQuery)
+	if !ok {
+		return view.PostRevisionView{}, nil
+	}
+
+	revision, err := h.PostRevisionRepository.FindByPostIdAndRevision(ctx, getPostRevisionQuery.PostId, getPostRevisionQuery.Revision)
+	if err != nil {
+		return view.PostRevisionView{}, err
+	}
+
+	return newPostRevisionView(revision), nil
+}
+
+func (h GetPostRevisionQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetPostRevisionQuery)
+	return ok
+}
@@ -0,0 +1,13 @@
+package post_query
+
+import "github.com/google/uuid"
+
+// GetTrashedPostQuery loads a post from the trash, where GetPostQuery does
+// not look.
+type GetTrashedPostQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetTrashedPostQuery(id uuid.UUID) GetTrashedPostQuery {
+	return GetTrashedPostQuery{Id: id}
+}
@@ -0,0 +1,30 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetTrashedPostQueryHandler struct {
+	PostTrashRepository repository.PostTrashRepository
+}
+
+func (h GetTrashedPostQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getTrashedPostQuery, ok := query.(GetTrashedPostQuery)
+	if !ok {
+		return view.PostView{}, nil
+	}
+
+	post, err := h.PostTrashRepository.FindByID(ctx, getTrashedPostQuery.Id)
+	if err != nil {
+		return view.PostView{}, err
+	}
+
+	return newPostView(post), nil
+}
+
+func (h GetTrashedPostQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetTrashedPostQuery)
+	return ok
+}
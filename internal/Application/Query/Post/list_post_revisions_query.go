@@ -0,0 +1,22 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+type ListPostRevisionsQuery struct {
+	PaginationFilters query.PaginationFilters
+	PostId            uuid.UUID
+}
+
+func NewListPostRevisionsQuery(postId uuid.UUID, page int, pageSize int) ListPostRevisionsQuery {
+	return ListPostRevisionsQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		PostId: postId,
+	}
+}
@@ -0,0 +1,40 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListPostRevisionsQueryHandler struct {
+	PostRevisionRepository repository.PostRevisionRepository
+}
+
+func (h ListPostRevisionsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	listPostRevisionsQuery, ok := query.(ListPostRevisionsQuery)
+	if !ok {
+		return []view.PostRevisionView{}, nil
+	}
+
+	paginatedResult, err := h.PostRevisionRepository.FindAllByPostId(
+		ctx,
+		listPostRevisionsQuery.PostId,
+		listPostRevisionsQuery.PaginationFilters.Page,
+		listPostRevisionsQuery.PaginationFilters.PageSize,
+	)
+	if err != nil {
+		return []view.PostRevisionView{}, err
+	}
+
+	revisionViews := make([]view.PostRevisionView, len(paginatedResult.Items))
+	for i, revision := range paginatedResult.Items {
+		revisionViews[i] = newPostRevisionView(revision)
+	}
+
+	return view.NewPaginatedView(revisionViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h ListPostRevisionsQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListPostRevisionsQuery)
+	return ok
+}
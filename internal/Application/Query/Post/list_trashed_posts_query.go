@@ -0,0 +1,24 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+// ListTrashedPostsQuery lists the trashed posts written or co-authored by
+// OwnerId, most recently trashed first.
+type ListTrashedPostsQuery struct {
+	PaginationFilters query.PaginationFilters
+	OwnerId           uuid.UUID
+}
+
+func NewListTrashedPostsQuery(page int, pageSize int, ownerId uuid.UUID) ListTrashedPostsQuery {
+	return ListTrashedPostsQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		OwnerId: ownerId,
+	}
+}
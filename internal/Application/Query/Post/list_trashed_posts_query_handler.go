@@ -0,0 +1,41 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListTrashedPostsQueryHandler struct {
+	PostTrashRepository repository.PostTrashRepository
+}
+
+func (h ListTrashedPostsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	listTrashedPostsQuery, ok := query.(ListTrashedPostsQuery)
+	if !ok {
+		return []view.PostView{}, nil
+	}
+
+	paginatedResult, err := h.PostTrashRepository.FindAllByOwnerId(
+		ctx,
+		listTrashedPostsQuery.OwnerId,
+		listTrashedPostsQuery.PaginationFilters.Page,
+		listTrashedPostsQuery.PaginationFilters.PageSize,
+	)
+
+	if err != nil {
+		return []view.PostView{}, err
+	}
+
+	postViews := make([]view.PostView, len(paginatedResult.Items))
+	for i, post := range paginatedResult.Items {
+		postViews[i] = newPostView(post)
+	}
+
+	return view.NewPaginatedView(postViews, paginatedResult.Total, paginatedResult.Page, paginatedResult.PageSize), nil
+}
+
+func (h ListTrashedPostsQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListTrashedPostsQuery)
+	return ok
+}
@@ -0,0 +1,110 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type mockPostTrashRepository struct {
+	findAllByOwnerIdFunc func(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error)
+}
+
+func (m *mockPostTrashRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, repository.ErrPostNotInTrash
+}
+
+func (m *mockPostTrashRepository) FindAllByOwnerId(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error) {
+	if m.findAllByOwnerIdFunc != nil {
+		return m.findAllByOwnerIdFunc(ctx, ownerId, page, pageSize)
+	}
+	return repository.PaginatedResult[entity.Post]{}, errors.New("not implemented")
+}
+
+func (m *mockPostTrashRepository) FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]entity.Post, error) {
+	return nil, nil
+}
+
+func (m *mockPostTrashRepository) Restore(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return nil
+}
+
+func (m *mockPostTrashRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+type ListTrashedPostsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        ListTrashedPostsQueryHandler
+	MockRepository *mockPostTrashRepository
+}
+
+func (s *ListTrashedPostsQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostTrashRepository{}
+	s.Handler = ListTrashedPostsQueryHandler{
+		PostTrashRepository: s.MockRepository,
+	}
+}
+
+func (s *ListTrashedPostsQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testOwnerID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	deletedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	s.MockRepository.findAllByOwnerIdFunc = func(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error) {
+		assert.Equal(s.T(), testOwnerID, ownerId)
+		assert.Equal(s.T(), 2, page)
+		assert.Equal(s.T(), 10, pageSize)
+		return repository.PaginatedResult[entity.Post]{
+			Items: []entity.Post{
+				{
+					ID:        testPostID,
+					Slug:      "trashed",
+					AuthorId:  testOwnerID,
+					Status:    entity.PostStatusDraft,
+					DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+				},
+			},
+			Total:    11,
+			Page:     2,
+			PageSize: 10,
+		}, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewListTrashedPostsQuery(2, 10, testOwnerID))
+
+	assert.NoError(s.T(), err)
+	paginatedView, ok := result.(view.PaginatedView[view.PostView])
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), int64(11), paginatedView.Total)
+	assert.Len(s.T(), paginatedView.Items, 1)
+	assert.Equal(s.T(), testPostID, paginatedView.Items[0].Id)
+	assert.Equal(s.T(), &deletedAt, paginatedView.Items[0].DeletedAt)
+}
+
+func (s *ListTrashedPostsQueryHandlerTestSuite) TestHandleRepositoryError() {
+	s.MockRepository.findAllByOwnerIdFunc = func(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error) {
+		return repository.PaginatedResult[entity.Post]{}, errors.New("database error")
+	}
+
+	_, err := s.Handler.Handle(context.Background(), NewListTrashedPostsQuery(1, 10, uuid.New()))
+
+	assert.EqualError(s.T(), err, "database error")
+}
+
+func (s *ListTrashedPostsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewListTrashedPostsQuery(1, 10, uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewFindScheduledPostsQuery(1, 10, uuid.Nil)))
+}
+
+func TestListTrashedPostsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTrashedPostsQueryHandlerTestSuite))
+}
@@ -0,0 +1,94 @@
+package post_query
+
+import (
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"time"
+)
+
+func newPostView(post entity.Post) view.PostView {
+	return view.NewPostView(
+		post.ID,
+		post.Slug,
+		post.Title,
+		post.Content,
+		string(post.ContentFormat),
+		post.ContentHTML,
+		newTocEntryViews(post.Toc),
+		post.Excerpt,
+		post.WordCount,
+		post.ReadingTimeMinutes,
+		post.AuthorId,
+		string(post.Status),
+		post.PublishedAt,
+		post.PublishAt,
+		post.TagNames(),
+		post.CoverMediaId,
+		post.SeoTitle,
+		post.MetaDescription,
+		post.CanonicalURL,
+		post.CategoryId,
+		post.AuthorIds(),
+		newPostCollaboratorViews(post.Collaborators),
+		newPostReviewView(post),
+		trashedAt(post),
+	)
+}
+
+func trashedAt(post entity.Post) *time.Time {
+	if !post.IsTrashed() {
+		return nil
+	}
+	return &post.DeletedAt.Time
+}
+
+func newPostReviewView(post entity.Post) *view.PostReviewView {
+	if post.SubmittedAt == nil {
+		return nil
+	}
+	return view.NewPostReviewView(post.SubmittedAt, post.ReviewerId, post.ReviewedAt, post.ReviewNotes)
+}
+
+func newPostCollaboratorViews(collaborators []entity.PostCollaborator) []view.PostCollaboratorView {
+	views := make([]view.PostCollaboratorView, len(collaborators))
+	for i, collaborator := range collaborators {
+		views[i] = view.NewPostCollaboratorView(collaborator.UserId, string(collaborator.Role))
+	}
+	return views
+}
+
+func newPostSummaryView(post entity.Post) view.PostSummaryView {
+	return view.NewPostSummaryView(
+		post.ID,
+		post.Slug,
+		post.Title,
+		post.Excerpt,
+		post.WordCount,
+		post.ReadingTimeMinutes,
+		post.AuthorId,
+		string(post.Status),
+		post.PublishedAt,
+		post.PublishAt,
+		post.TagNames(),
+	)
+}
+
+func newTocEntryViews(toc []entity.TocEntry) []view.TocEntryView {
+	views := make([]view.TocEntryView, len(toc))
+	for i, entry := range toc {
+		views[i] = view.NewTocEntryView(entry.Level, entry.Anchor, entry.Title)
+	}
+	return views
+}
+
+func newPostRevisionView(revision entity.PostRevision) view.PostRevisionView {
+	return view.NewPostRevisionView(
+		revision.ID,
+		revision.PostId,
+		revision.Revision,
+		revision.CreatedAt,
+		revision.Slug,
+		revision.Title,
+		revision.Content,
+	)
+}
@@ -1,10 +1,22 @@
 package post_query
 
-import query "main/internal/Application/Query"
+import (
+	query "main/internal/Application/Query"
 
+	"github.com/google/uuid"
+)
+
+// Filters narrows down the posts listed by FindAllByQuery. Zero values leave
+// a filter out.
 type Filters struct {
 	PaginationFilters query.PaginationFilters
 	Slug              string
 	Text              string
 	Author            string
+	Status            string
+	TagsAny           []string
+	TagsAll           []string
+	Category          string
+	ViewerId          uuid.UUID
+	Sort              string
 }
@@ -0,0 +1,33 @@
+package post_query
+
+import (
+	query "main/internal/Application/Query"
+
+	"github.com/google/uuid"
+)
+
+// SearchPostsQuery searches the embedded search index rather than the
+// database. A negative Fuzziness lets the index pick the edit distance from
+// the length of each term.
+type SearchPostsQuery struct {
+	PaginationFilters query.PaginationFilters
+	Text              string
+	Fuzziness         int
+	Author            string
+	Tags              []string
+	ViewerId          uuid.UUID
+}
+
+func NewSearchPostsQuery(page int, pageSize int, text string, fuzziness int, author string, tags []string, viewerId uuid.UUID) SearchPostsQuery {
+	return SearchPostsQuery{
+		PaginationFilters: query.PaginationFilters{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Text:      text,
+		Fuzziness: fuzziness,
+		Author:    author,
+		Tags:      tags,
+		ViewerId:  viewerId,
+	}
+}
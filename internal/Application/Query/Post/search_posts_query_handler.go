@@ -0,0 +1,66 @@
+package post_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type SearchPostsQueryHandler struct {
+	PostIndexRepository repository.PostIndexRepository
+}
+
+func (h SearchPostsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	searchQuery, ok := query.(SearchPostsQuery)
+	if !ok {
+		return view.PostSearchView{}, nil
+	}
+
+	result, err := h.PostIndexRepository.Search(ctx, repository.PostIndexQuery{
+		Text:      searchQuery.Text,
+		Fuzziness: searchQuery.Fuzziness,
+		Author:    searchQuery.Author,
+		Tags:      searchQuery.Tags,
+		ViewerId:  searchQuery.ViewerId,
+		Page:      searchQuery.PaginationFilters.Page,
+		PageSize:  searchQuery.PaginationFilters.PageSize,
+	})
+	if err != nil {
+		return view.PostSearchView{}, err
+	}
+
+	hitViews := make([]view.PostSearchHitView, len(result.Hits))
+	for i, hit := range result.Hits {
+		hitViews[i] = view.NewPostSearchHitView(
+			hit.Post.ID,
+			hit.Post.CreatedAt,
+			hit.Post.Slug,
+			hit.Post.Title,
+			hit.Post.AuthorId,
+			hit.Post.AuthorName,
+			hit.Post.Status,
+			hit.Post.Tags,
+			hit.Score,
+			hit.Highlights,
+		)
+	}
+
+	facetViews := make(map[string][]view.FacetTermView, len(result.Facets))
+	for name, terms := range result.Facets {
+		termViews := make([]view.FacetTermView, len(terms))
+		for i, term := range terms {
+			termViews[i] = view.FacetTermView{Term: term.Term, Count: term.Count}
+		}
+		facetViews[name] = termViews
+	}
+
+	return view.NewPostSearchView(
+		view.NewPaginatedView(hitViews, result.Total, searchQuery.PaginationFilters.Page, searchQuery.PaginationFilters.PageSize),
+		facetViews,
+	), nil
+}
+
+func (h SearchPostsQueryHandler) Supports(query any) bool {
+	_, ok := query.(SearchPostsQuery)
+	return ok
+}
@@ -0,0 +1,139 @@
+package post_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostIndexRepositorySearch struct {
+	searchFunc func(ctx context.Context, query repository.PostIndexQuery) (repository.PostIndexResult, error)
+}
+
+func (m *mockPostIndexRepositorySearch) Index(ctx context.Context, post repository.IndexedPost) error {
+	return nil
+}
+
+func (m *mockPostIndexRepositorySearch) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostIndexRepositorySearch) Search(ctx context.Context, query repository.PostIndexQuery) (repository.PostIndexResult, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, query)
+	}
+	return repository.PostIndexResult{}, errors.New("not implemented")
+}
+
+func (m *mockPostIndexRepositorySearch) Rebuild(ctx context.Context, fill func(add func(post repository.IndexedPost) error) error) error {
+	return nil
+}
+
+type SearchPostsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        SearchPostsQueryHandler
+	MockRepository *mockPostIndexRepositorySearch
+}
+
+func (s *SearchPostsQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockPostIndexRepositorySearch{}
+	s.Handler = SearchPostsQueryHandler{
+		PostIndexRepository: s.MockRepository,
+	}
+}
+
+func (s *SearchPostsQueryHandlerTestSuite) TestHandle() {
+	testPostID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	testAuthorID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	testViewerID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := []struct {
+		name          string
+		query         any
+		searchErr     error
+		expectedError bool
+	}{
+		{
+			name:  "Success",
+			query: NewSearchPostsQuery(2, 5, "generics", 1, "author1", []string{"go"}, testViewerID),
+		},
+		{
+			name:          "RepositoryError",
+			query:         NewSearchPostsQuery(2, 5, "generics", 1, "author1", []string{"go"}, testViewerID),
+			searchErr:     errors.New("index locked"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.MockRepository.searchFunc = func(ctx context.Context, query repository.PostIndexQuery) (repository.PostIndexResult, error) {
+				assert.Equal(t, repository.PostIndexQuery{
+					Text:      "generics",
+					Fuzziness: 1,
+					Author:    "author1",
+					Tags:      []string{"go"},
+					ViewerId:  testViewerID,
+					Page:      2,
+					PageSize:  5,
+				}, query)
+				return repository.PostIndexResult{
+					Hits: []repository.PostIndexHit{
+						{
+							Post: repository.IndexedPost{
+								ID:         testPostID,
+								Slug:       "generics",
+								Title:      "Generics in Go",
+								AuthorId:   testAuthorID,
+								AuthorName: "author1",
+								Tags:       []string{"go"},
+								Status:     "published",
+							},
+							Score:      1.5,
+							Highlights: map[string][]string{"title": {"<mark>Generics</mark> in Go"}},
+						},
+					},
+					Total: 6,
+					Facets: map[string][]repository.PostIndexFacet{
+						"tags": {{Term: "go", Count: 6}},
+					},
+				}, tt.searchErr
+			}
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			searchView, ok := result.(view.PostSearchView)
+			assert.True(t, ok)
+			assert.Equal(t, int64(6), searchView.Total)
+			assert.Equal(t, 2, searchView.Page)
+			assert.Equal(t, 5, searchView.PageSize)
+			assert.Len(t, searchView.Items, 1)
+			assert.Equal(t, testPostID, searchView.Items[0].Id)
+			assert.Equal(t, "author1", searchView.Items[0].Author)
+			assert.Equal(t, 1.5, searchView.Items[0].Score)
+			assert.Equal(t, []string{"<mark>Generics</mark> in Go"}, searchView.Items[0].Highlights["title"])
+			assert.Equal(t, []view.FacetTermView{{Term: "go", Count: 6}}, searchView.Facets["tags"])
+		})
+	}
+}
+
+func (s *SearchPostsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewSearchPostsQuery(1, 10, "go", 0, "", nil, uuid.Nil)))
+	assert.False(s.T(), s.Handler.Supports(NewFullTextSearchQuery(1, 10, "go", "", uuid.Nil)))
+}
+
+func TestSearchPostsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchPostsQueryHandlerTestSuite))
+}
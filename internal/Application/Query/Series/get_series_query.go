@@ -0,0 +1,11 @@
+package series_query
+
+import "github.com/google/uuid"
+
+type GetSeriesQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetSeriesQuery(id uuid.UUID) GetSeriesQuery {
+	return GetSeriesQuery{Id: id}
+}
@@ -0,0 +1,55 @@
+package series_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+// GetSeriesQueryHandler returns the series with all of its posts in reading
+// order, drafts included and trashed posts left out. Hiding the posts a
+// reader may not see is up to the caller.
+type GetSeriesQueryHandler struct {
+	SeriesRepository repository.SeriesRepository
+}
+
+func (h GetSeriesQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getSeriesQuery, ok := query.(GetSeriesQuery)
+	if !ok {
+		return view.SeriesView{}, nil
+	}
+
+	series, err := h.SeriesRepository.FindByID(ctx, getSeriesQuery.Id)
+	if err != nil {
+		return view.SeriesView{}, err
+	}
+
+	posts := make([]view.SeriesPostView, 0, len(series.Posts))
+	for _, seriesPost := range series.Posts {
+		if seriesPost.Post.IsTrashed() {
+			continue
+		}
+		posts = append(posts, view.NewSeriesPostView(
+			seriesPost.PostId,
+			seriesPost.Post.Slug,
+			seriesPost.Post.Title,
+			string(seriesPost.Post.Status),
+			seriesPost.Position,
+		))
+	}
+
+	return view.NewSeriesView(
+		series.ID,
+		series.AuthorId,
+		series.Title,
+		series.Description,
+		series.CreatedAt,
+		series.UpdatedAt,
+		posts,
+	), nil
+}
+
+func (h GetSeriesQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetSeriesQuery)
+	return ok
+}
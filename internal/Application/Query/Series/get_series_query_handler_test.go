@@ -0,0 +1,108 @@
+package series_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockSeriesRepository struct {
+	findByIDFunc func(ctx context.Context, id uuid.UUID) (entity.Series, error)
+}
+
+func (m *mockSeriesRepository) Save(ctx context.Context, series entity.Series) error {
+	return nil
+}
+
+func (m *mockSeriesRepository) Update(ctx context.Context, series entity.Series) error {
+	return nil
+}
+
+func (m *mockSeriesRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return entity.Series{}, errors.New("not implemented")
+}
+
+func (m *mockSeriesRepository) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	return entity.Series{}, errors.New("not implemented")
+}
+
+type GetSeriesQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        GetSeriesQueryHandler
+	MockRepository *mockSeriesRepository
+}
+
+func (s *GetSeriesQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockSeriesRepository{}
+	s.Handler = GetSeriesQueryHandler{
+		SeriesRepository: s.MockRepository,
+	}
+}
+
+func (s *GetSeriesQueryHandlerTestSuite) TestHandle() {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	first := entity.Post{ID: uuid.New(), Slug: "part-1", Title: "Part 1", Status: entity.PostStatusPublished}
+	second := entity.Post{ID: uuid.New(), Slug: "part-2", Title: "Part 2", Status: entity.PostStatusDraft}
+	series := entity.NewSeries(uuid.New(), createdAt, uuid.New(), "Go tutorial", "Learn Go step by step")
+	series.Posts = []entity.SeriesPost{
+		{SeriesId: series.ID, PostId: first.ID, Position: 1, Post: first},
+		{SeriesId: series.ID, PostId: second.ID, Position: 2, Post: second},
+	}
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+		assert.Equal(s.T(), series.ID, id)
+		return series, nil
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetSeriesQuery(series.ID))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.NewSeriesView(
+		series.ID,
+		series.AuthorId,
+		"Go tutorial",
+		"Learn Go step by step",
+		createdAt,
+		createdAt,
+		[]view.SeriesPostView{
+			view.NewSeriesPostView(first.ID, "part-1", "Part 1", "published", 1),
+			view.NewSeriesPostView(second.ID, "part-2", "Part 2", "draft", 2),
+		},
+	), result)
+}
+
+func (s *GetSeriesQueryHandlerTestSuite) TestHandleNotFound() {
+	s.MockRepository.findByIDFunc = func(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+		return entity.Series{}, errors.New("record not found")
+	}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetSeriesQuery(uuid.New()))
+
+	assert.EqualError(s.T(), err, "record not found")
+	assert.Equal(s.T(), view.SeriesView{}, result)
+}
+
+func (s *GetSeriesQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.SeriesView{}, result)
+}
+
+func (s *GetSeriesQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetSeriesQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestGetSeriesQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSeriesQueryHandlerTestSuite))
+}
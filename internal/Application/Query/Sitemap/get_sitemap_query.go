@@ -0,0 +1,11 @@
+package sitemap_query
+
+// GetSitemapQuery returns a stored sitemap file, e.g. sitemap.xml or
+// sitemaps/2.xml.
+type GetSitemapQuery struct {
+	Name string `json:"name"`
+}
+
+func NewGetSitemapQuery(name string) GetSitemapQuery {
+	return GetSitemapQuery{Name: name}
+}
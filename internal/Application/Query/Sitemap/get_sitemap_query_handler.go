@@ -0,0 +1,41 @@
+package sitemap_query
+
+import (
+	"context"
+	"errors"
+	sitemap "main/internal/Application/Sitemap"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+// GetSitemapQueryHandler serves the sitemap kept up to date by the consumer.
+// Before the consumer has generated one, the index is generated on the spot.
+type GetSitemapQueryHandler struct {
+	SitemapRepository repository.SitemapRepository
+	SitemapGenerator  sitemap.SitemapGenerator
+}
+
+func (h GetSitemapQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getSitemapQuery, ok := query.(GetSitemapQuery)
+	if !ok {
+		return view.SitemapView{}, nil
+	}
+
+	content, err := h.SitemapRepository.Find(ctx, getSitemapQuery.Name)
+	if errors.Is(err, repository.ErrSitemapFileNotFound) && getSitemapQuery.Name == sitemap.IndexFile {
+		if err := h.SitemapGenerator.Generate(ctx); err != nil {
+			return view.SitemapView{}, err
+		}
+		content, err = h.SitemapRepository.Find(ctx, getSitemapQuery.Name)
+	}
+	if err != nil {
+		return view.SitemapView{}, err
+	}
+
+	return view.NewSitemapView(content), nil
+}
+
+func (h GetSitemapQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetSitemapQuery)
+	return ok
+}
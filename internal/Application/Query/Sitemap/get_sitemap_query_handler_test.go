@@ -0,0 +1,144 @@
+package sitemap_query
+
+import (
+	"context"
+	"errors"
+	sitemap "main/internal/Application/Sitemap"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepositoryForSitemap struct {
+	findAllCalls int
+}
+
+func (m *mockPostRepositoryForSitemap) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSitemap) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSitemap) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForSitemap) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepositoryForSitemap) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	m.findAllCalls++
+	return repository.PaginatedResult[entity.Post]{Items: []entity.Post{}, Page: page, PageSize: pageSize}, nil
+}
+
+func (m *mockPostRepositoryForSitemap) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSitemap) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepositoryForSitemap) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockSitemapRepository struct {
+	files   map[string]string
+	findErr error
+}
+
+func (m *mockSitemapRepository) Save(ctx context.Context, files map[string]string) error {
+	m.files = files
+	return nil
+}
+
+func (m *mockSitemapRepository) Find(ctx context.Context, name string) (string, error) {
+	if m.findErr != nil {
+		return "", m.findErr
+	}
+	content, ok := m.files[name]
+	if !ok {
+		return "", repository.ErrSitemapFileNotFound
+	}
+	return content, nil
+}
+
+type GetSitemapQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler           GetSitemapQueryHandler
+	PostRepository    *mockPostRepositoryForSitemap
+	SitemapRepository *mockSitemapRepository
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) SetupTest() {
+	s.PostRepository = &mockPostRepositoryForSitemap{}
+	s.SitemapRepository = &mockSitemapRepository{files: map[string]string{}}
+	s.Handler = GetSitemapQueryHandler{
+		SitemapRepository: s.SitemapRepository,
+		SitemapGenerator: sitemap.SitemapGenerator{
+			PostRepository:    s.PostRepository,
+			SitemapRepository: s.SitemapRepository,
+			SiteURL:           "https://blog.example.com",
+			SitemapURL:        "https://api.example.com",
+		},
+	}
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestHandleStoredFile() {
+	s.SitemapRepository.files = map[string]string{"sitemaps/2.xml": "<urlset/>"}
+
+	result, err := s.Handler.Handle(context.Background(), NewGetSitemapQuery("sitemaps/2.xml"))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.NewSitemapView("<urlset/>"), result)
+	assert.Equal(s.T(), 0, s.PostRepository.findAllCalls)
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestHandleGeneratesMissingIndex() {
+	result, err := s.Handler.Handle(context.Background(), NewGetSitemapQuery(sitemap.IndexFile))
+
+	assert.NoError(s.T(), err)
+	assert.Contains(s.T(), result.(view.SitemapView).Content, "<loc>https://blog.example.com/</loc>")
+	assert.Equal(s.T(), 1, s.PostRepository.findAllCalls)
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestHandleMissingPart() {
+	_, err := s.Handler.Handle(context.Background(), NewGetSitemapQuery("sitemaps/9.xml"))
+
+	assert.ErrorIs(s.T(), err, repository.ErrSitemapFileNotFound)
+	assert.Equal(s.T(), 0, s.PostRepository.findAllCalls)
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestHandleRepositoryError() {
+	s.SitemapRepository.findErr = errors.New("redis error")
+
+	_, err := s.Handler.Handle(context.Background(), NewGetSitemapQuery(sitemap.IndexFile))
+
+	assert.EqualError(s.T(), err, "redis error")
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestHandleInvalidQueryType() {
+	result, err := s.Handler.Handle(context.Background(), "invalid query")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), view.SitemapView{}, result)
+}
+
+func (s *GetSitemapQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(GetSitemapQuery{}))
+	assert.False(s.T(), s.Handler.Supports("invalid query"))
+}
+
+func TestGetSitemapQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSitemapQueryHandlerTestSuite))
+}
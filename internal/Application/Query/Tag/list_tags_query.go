@@ -0,0 +1,7 @@
+package tag_query
+
+type ListTagsQuery struct{}
+
+func NewListTagsQuery() ListTagsQuery {
+	return ListTagsQuery{}
+}
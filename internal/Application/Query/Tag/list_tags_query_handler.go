@@ -0,0 +1,34 @@
+package tag_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type ListTagsQueryHandler struct {
+	TagRepository repository.TagRepository
+}
+
+func (h ListTagsQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	if _, ok := query.(ListTagsQuery); !ok {
+		return []view.TagView{}, nil
+	}
+
+	tags, err := h.TagRepository.FindAllWithPostCount(ctx)
+	if err != nil {
+		return []view.TagView{}, err
+	}
+
+	tagViews := make([]view.TagView, len(tags))
+	for i, tag := range tags {
+		tagViews[i] = view.NewTagView(tag.Tag.ID, tag.Tag.Name, tag.PostCount)
+	}
+
+	return tagViews, nil
+}
+
+func (h ListTagsQueryHandler) Supports(query any) bool {
+	_, ok := query.(ListTagsQuery)
+	return ok
+}
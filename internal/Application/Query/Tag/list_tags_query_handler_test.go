@@ -0,0 +1,113 @@
+package tag_query
+
+import (
+	"context"
+	"errors"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockTagRepository struct {
+	findAllWithPostCountFunc func(ctx context.Context) ([]repository.TagWithPostCount, error)
+}
+
+func (m *mockTagRepository) FindOrCreateByNames(ctx context.Context, names []string) ([]entity.Tag, error) {
+	return []entity.Tag{}, nil
+}
+
+func (m *mockTagRepository) FindAllWithPostCount(ctx context.Context) ([]repository.TagWithPostCount, error) {
+	if m.findAllWithPostCountFunc != nil {
+		return m.findAllWithPostCountFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+type ListTagsQueryHandlerTestSuite struct {
+	suite.Suite
+	Handler        ListTagsQueryHandler
+	MockRepository *mockTagRepository
+}
+
+func (s *ListTagsQueryHandlerTestSuite) SetupTest() {
+	s.MockRepository = &mockTagRepository{}
+	s.Handler = ListTagsQueryHandler{
+		TagRepository: s.MockRepository,
+	}
+}
+
+func (s *ListTagsQueryHandlerTestSuite) TestHandle() {
+	goTagID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	sqlTagID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := []struct {
+		name          string
+		query         any
+		setupMock     func()
+		expectedError bool
+		expectedTags  []view.TagView
+	}{
+		{
+			name:  "Success",
+			query: NewListTagsQuery(),
+			setupMock: func() {
+				s.MockRepository.findAllWithPostCountFunc = func(ctx context.Context) ([]repository.TagWithPostCount, error) {
+					return []repository.TagWithPostCount{
+						{Tag: entity.Tag{ID: goTagID, Name: "go"}, PostCount: 3},
+						{Tag: entity.Tag{ID: sqlTagID, Name: "sql"}, PostCount: 1},
+					}, nil
+				}
+			},
+			expectedTags: []view.TagView{
+				view.NewTagView(goTagID, "go", 3),
+				view.NewTagView(sqlTagID, "sql", 1),
+			},
+		},
+		{
+			name:  "RepositoryError",
+			query: NewListTagsQuery(),
+			setupMock: func() {
+				s.MockRepository.findAllWithPostCountFunc = func(ctx context.Context) ([]repository.TagWithPostCount, error) {
+					return nil, errors.New("database error")
+				}
+			},
+			expectedError: true,
+			expectedTags:  []view.TagView{},
+		},
+		{
+			name:         "InvalidQueryType",
+			query:        "not a ListTagsQuery",
+			setupMock:    func() {},
+			expectedTags: []view.TagView{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := s.Handler.Handle(context.Background(), tt.query)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTags, result)
+		})
+	}
+}
+
+func (s *ListTagsQueryHandlerTestSuite) TestSupports() {
+	assert.True(s.T(), s.Handler.Supports(NewListTagsQuery()))
+	assert.False(s.T(), s.Handler.Supports("not a ListTagsQuery"))
+}
+
+func TestListTagsQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTagsQueryHandlerTestSuite))
+}
@@ -5,8 +5,6 @@ import (
 	view "main/internal/Application/View"
 	repository "main/internal/Domain/Repository"
 	open_telemetry "main/internal/Infrastructure/OpenTelemetry"
-
-	"github.com/google/uuid"
 )
 
 type FindUserByQueryHandler struct {
@@ -27,16 +25,7 @@ func (h FindUserByQueryHandler) Handle(ctx context.Context, query any) (any, err
 
 	_, span := h.Telemetry.TraceStart(ctx, "FindUserByQueryHandler.CreateView")
 	defer span.End()
-	return view.NewUserView(
-		uuid.MustParse(userEntity.ID.String()),
-		userEntity.Email,
-		userEntity.Provider,
-		userEntity.Name,
-		userEntity.FirstName,
-		userEntity.LastName,
-		userEntity.ProviderUserId,
-		userEntity.AvatarURL,
-	), nil
+	return newUserView(userEntity), nil
 }
 
 func (h FindUserByQueryHandler) Supports(query any) bool {
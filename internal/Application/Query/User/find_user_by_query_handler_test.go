@@ -66,6 +66,7 @@ func (s *FindUserByQueryHandlerTestSuite) TestHandle_Success() {
 		LastName:       "User",
 		ProviderUserId: "testprovider123",
 		AvatarURL:      "https://example.com/avatar.jpg",
+		Roles:          []entity.UserRole{{UserId: testUserID, Role: entity.RoleAdmin}},
 	}
 
 	s.MockRepository.findByProviderUserIdAndEmailFunc = func(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
@@ -90,6 +91,8 @@ func (s *FindUserByQueryHandlerTestSuite) TestHandle_Success() {
 	assert.Equal(s.T(), "Test", userView.FirstName)
 	assert.Equal(s.T(), "User", userView.LastName)
 	assert.Equal(s.T(), "https://example.com/avatar.jpg", userView.AvatarURL)
+	assert.Equal(s.T(), []string{"admin"}, userView.Roles)
+	assert.Contains(s.T(), userView.Permissions, "roles:manage")
 }
 
 func (s *FindUserByQueryHandlerTestSuite) TestHandle_ErrorCases() {
@@ -0,0 +1,11 @@
+package user_query
+
+import "github.com/google/uuid"
+
+type GetAuthorQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetAuthorQuery(id uuid.UUID) GetAuthorQuery {
+	return GetAuthorQuery{Id: id}
+}
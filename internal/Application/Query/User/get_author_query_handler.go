@@ -0,0 +1,30 @@
+package user_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetAuthorQueryHandler struct {
+	UserRepository repository.UserRepository
+}
+
+func (h GetAuthorQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getAuthorQuery, ok := query.(GetAuthorQuery)
+	if !ok {
+		return view.AuthorView{}, nil
+	}
+
+	user, err := h.UserRepository.FindByID(ctx, getAuthorQuery.Id)
+	if err != nil {
+		return view.AuthorView{}, err
+	}
+
+	return view.NewAuthorView(user.ID, user.Name, user.AvatarURL), nil
+}
+
+func (h GetAuthorQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetAuthorQuery)
+	return ok
+}
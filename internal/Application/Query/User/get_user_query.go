@@ -0,0 +1,13 @@
+package user_query
+
+import "github.com/google/uuid"
+
+// GetUserQuery loads a user with their roles, e.g. for an admin managing
+// them. Use GetAuthorQuery for the public profile.
+type GetUserQuery struct {
+	Id uuid.UUID `json:"id"`
+}
+
+func NewGetUserQuery(id uuid.UUID) GetUserQuery {
+	return GetUserQuery{Id: id}
+}
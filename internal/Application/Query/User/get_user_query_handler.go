@@ -0,0 +1,30 @@
+package user_query
+
+import (
+	"context"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+)
+
+type GetUserQueryHandler struct {
+	UserRepository repository.UserRepository
+}
+
+func (h GetUserQueryHandler) Handle(ctx context.Context, query any) (any, error) {
+	getUserQuery, ok := query.(GetUserQuery)
+	if !ok {
+		return view.UserView{}, nil
+	}
+
+	user, err := h.UserRepository.FindByID(ctx, getUserQuery.Id)
+	if err != nil {
+		return view.UserView{}, err
+	}
+
+	return newUserView(user), nil
+}
+
+func (h GetUserQueryHandler) Supports(query any) bool {
+	_, ok := query.(GetUserQuery)
+	return ok
+}
@@ -0,0 +1,27 @@
+package user_query
+
+import (
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+)
+
+func newUserView(user entity.User) view.UserView {
+	permissions := user.Permissions()
+	permissionNames := make([]string, len(permissions))
+	for i, permission := range permissions {
+		permissionNames[i] = string(permission)
+	}
+
+	return view.NewUserView(
+		user.ID,
+		user.Email,
+		user.Provider,
+		user.Name,
+		user.FirstName,
+		user.LastName,
+		user.ProviderUserId,
+		user.AvatarURL,
+		user.RoleNames(),
+		permissionNames,
+	)
+}
@@ -0,0 +1,264 @@
+package rendering
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	stdhtml "html"
+	"io"
+	entity "main/internal/Domain/Entity"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	classNamePattern  = regexp.MustCompile(`^[a-zA-Z0-9 _-]+$`)
+	paragraphSplitter = regexp.MustCompile(`\n\s*\n`)
+)
+
+var headingLevels = map[atom.Atom]int{
+	atom.H1: 1,
+	atom.H2: 2,
+	atom.H3: 3,
+	atom.H4: 4,
+	atom.H5: 5,
+	atom.H6: 6,
+}
+
+var inlineElements = map[atom.Atom]bool{
+	atom.A:      true,
+	atom.Abbr:   true,
+	atom.B:      true,
+	atom.Code:   true,
+	atom.Del:    true,
+	atom.Em:     true,
+	atom.I:      true,
+	atom.Ins:    true,
+	atom.Kbd:    true,
+	atom.Mark:   true,
+	atom.S:      true,
+	atom.Small:  true,
+	atom.Span:   true,
+	atom.Strong: true,
+	atom.Sub:    true,
+	atom.Sup:    true,
+	atom.U:      true,
+}
+
+// ContentRenderer turns post content into HTML that is safe to embed in a
+// page. Markdown and HTML input both pass through the same allowlist, so raw
+// HTML inside Markdown is sanitized like any other. Headings get anchors after
+// sanitizing and replace any id the author gave them, so anchors always match
+// the table of contents.
+type ContentRenderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+func NewContentRenderer() ContentRenderer {
+	policy := bluemonday.UGCPolicy()
+	// Syntax highlighting is emitted as CSS classes rather than inline
+	// styles, so the allowlist only has to let class names through.
+	policy.AllowAttrs("class").Matching(classNamePattern).OnElements("pre", "code", "span")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+
+	return ContentRenderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(
+				extension.GFM,
+				highlighting.NewHighlighting(
+					highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+				),
+			),
+			goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+		),
+		policy: policy,
+	}
+}
+
+// Render returns the sanitized HTML of content and its table of contents.
+func (r ContentRenderer) Render(format entity.ContentFormat, content string) (string, []entity.TocEntry, error) {
+	switch format {
+	case entity.ContentFormatMarkdown:
+		var buf bytes.Buffer
+		if err := r.markdown.Convert([]byte(content), &buf); err != nil {
+			return "", nil, err
+		}
+		return addHeadingAnchors(r.policy.Sanitize(buf.String()))
+	case entity.ContentFormatHTML:
+		return addHeadingAnchors(r.policy.Sanitize(content))
+	case entity.ContentFormatPlain:
+		return renderPlainText(content), []entity.TocEntry{}, nil
+	}
+	return "", nil, fmt.Errorf("unknown content format %q", format)
+}
+
+// PlainText returns the text of content without any markup, e.g. for counting
+// words. Code blocks are kept as text.
+func (r ContentRenderer) PlainText(format entity.ContentFormat, content string) (string, error) {
+	switch format {
+	case entity.ContentFormatMarkdown:
+		var buf bytes.Buffer
+		if err := r.markdown.Convert([]byte(content), &buf); err != nil {
+			return "", err
+		}
+		return stripTags(buf.String()), nil
+	case entity.ContentFormatHTML:
+		return stripTags(content), nil
+	case entity.ContentFormatPlain:
+		return content, nil
+	}
+	return "", fmt.Errorf("unknown content format %q", format)
+}
+
+// stripTags keeps the text of fragment. Block elements are separated by a
+// space so that words of adjacent paragraphs do not run together; script and
+// style contents are dropped.
+func stripTags(fragment string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(fragment))
+	var out strings.Builder
+	skip := 0
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return out.String()
+		}
+
+		name, _ := tokenizer.TagName()
+		tag := atom.Lookup(name)
+		switch tokenType {
+		case html.TextToken:
+			if skip == 0 {
+				out.Write(tokenizer.Text())
+			}
+			continue
+		case html.StartTagToken:
+			if tag == atom.Script || tag == atom.Style {
+				skip++
+			}
+		case html.EndTagToken:
+			if (tag == atom.Script || tag == atom.Style) && skip > 0 {
+				skip--
+			}
+		}
+		if !inlineElements[tag] {
+			out.WriteByte(' ')
+		}
+	}
+}
+
+func renderPlainText(content string) string {
+	var out strings.Builder
+	for _, paragraph := range paragraphSplitter.Split(strings.TrimSpace(content), -1) {
+		if paragraph == "" {
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(paragraph), "\n")
+		for i, line := range lines {
+			lines[i] = stdhtml.EscapeString(line)
+		}
+		out.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>\n")
+	}
+	return out.String()
+}
+
+// addHeadingAnchors gives every heading an id derived from its text and
+// collects the headings into a table of contents. Headings are buffered until
+// their end tag because the id is only known once the text has been read.
+func addHeadingAnchors(fragment string) (string, []entity.TocEntry, error) {
+	tokenizer := html.NewTokenizer(strings.NewReader(fragment))
+	toc := make([]entity.TocEntry, 0)
+	anchors := make(map[string]bool)
+
+	var out strings.Builder
+	var heading *html.Token
+	var headingBody, headingText strings.Builder
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+
+		if heading == nil {
+			if _, ok := headingLevels[token.DataAtom]; ok && tokenType == html.StartTagToken {
+				heading = &token
+				headingBody.Reset()
+				headingText.Reset()
+				continue
+			}
+			out.WriteString(token.String())
+			continue
+		}
+
+		if tokenType == html.EndTagToken && token.DataAtom == heading.DataAtom {
+			title := strings.Join(strings.Fields(headingText.String()), " ")
+			anchor := uniqueAnchor(anchors, slugify(title))
+			heading.Attr = slices.DeleteFunc(heading.Attr, func(attr html.Attribute) bool { return attr.Key == "id" })
+			heading.Attr = append(heading.Attr, html.Attribute{Key: "id", Val: anchor})
+			out.WriteString(heading.String() + headingBody.String() + token.String())
+			toc = append(toc, entity.TocEntry{Level: headingLevels[heading.DataAtom], Anchor: anchor, Title: title})
+			heading = nil
+			continue
+		}
+
+		headingBody.WriteString(token.String())
+		if tokenType == html.TextToken {
+			headingText.WriteString(token.Data)
+		}
+	}
+
+	if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+		return "", nil, err
+	}
+	if heading != nil {
+		out.WriteString(heading.String() + headingBody.String())
+	}
+
+	return out.String(), toc, nil
+}
+
+func slugify(title string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			slug.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && slug.Len() > 0 {
+			slug.WriteRune('-')
+			dash = true
+		}
+	}
+
+	anchor := strings.TrimSuffix(slug.String(), "-")
+	if anchor == "" {
+		return "section"
+	}
+	return anchor
+}
+
+func uniqueAnchor(seen map[string]bool, anchor string) string {
+	candidate := anchor
+	for i := 1; seen[candidate]; i++ {
+		candidate = anchor + "-" + strconv.Itoa(i)
+	}
+	seen[candidate] = true
+	return candidate
+}
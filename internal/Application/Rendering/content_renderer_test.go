@@ -0,0 +1,91 @@
+package rendering
+
+import (
+	entity "main/internal/Domain/Entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContentRendererTestSuite struct {
+	suite.Suite
+	Renderer ContentRenderer
+}
+
+func (s *ContentRendererTestSuite) SetupTest() {
+	s.Renderer = NewContentRenderer()
+}
+
+func (s *ContentRendererTestSuite) TestMarkdown() {
+	contentHTML, toc, err := s.Renderer.Render(entity.ContentFormatMarkdown, "# Getting Started\n\nSome **bold** text.\n\n## Install & Run\n\n## Install & Run\n")
+
+	assert.NoError(s.T(), err)
+	assert.Contains(s.T(), contentHTML, `<h1 id="getting-started">Getting Started</h1>`)
+	assert.Contains(s.T(), contentHTML, `<strong>bold</strong>`)
+	assert.Contains(s.T(), contentHTML, `<h2 id="install-run">Install &amp; Run</h2>`)
+	assert.Contains(s.T(), contentHTML, `<h2 id="install-run-1">Install &amp; Run</h2>`)
+	assert.Equal(s.T(), []entity.TocEntry{
+		{Level: 1, Anchor: "getting-started", Title: "Getting Started"},
+		{Level: 2, Anchor: "install-run", Title: "Install & Run"},
+		{Level: 2, Anchor: "install-run-1", Title: "Install & Run"},
+	}, toc)
+}
+
+func (s *ContentRendererTestSuite) TestMarkdownCodeBlockIsHighlighted() {
+	contentHTML, _, err := s.Renderer.Render(entity.ContentFormatMarkdown, "```go\nfunc main() {}\n```\n")
+
+	assert.NoError(s.T(), err)
+	assert.Contains(s.T(), contentHTML, `<pre class="chroma">`)
+	assert.Contains(s.T(), contentHTML, `<span class="kd">func</span>`)
+}
+
+func (s *ContentRendererTestSuite) TestSanitizesUnsafeMarkup() {
+	tests := []struct {
+		name    string
+		format  entity.ContentFormat
+		content string
+	}{
+		{name: "RawHTMLInMarkdown", format: entity.ContentFormatMarkdown, content: "Hello <script>alert(1)</script> <a href=\"javascript:alert(1)\" onclick=\"x()\">link</a>"},
+		{name: "HTML", format: entity.ContentFormatHTML, content: "<p onmouseover=\"alert(1)\">Hello</p><script>alert(1)</script><a href=\"javascript:alert(1)\">link</a>"},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			contentHTML, _, err := s.Renderer.Render(tt.format, tt.content)
+
+			assert.NoError(t, err)
+			assert.Contains(t, contentHTML, "Hello")
+			assert.NotContains(t, contentHTML, "<script")
+			assert.NotContains(t, contentHTML, "javascript:")
+			assert.NotContains(t, contentHTML, "onclick")
+			assert.NotContains(t, contentHTML, "onmouseover")
+		})
+	}
+}
+
+func (s *ContentRendererTestSuite) TestHTMLReplacesAuthorIds() {
+	contentHTML, toc, err := s.Renderer.Render(entity.ContentFormatHTML, `<h2 id="custom">Section <em>One</em></h2><p>Text</p>`)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), `<h2 id="section-one">Section <em>One</em></h2><p>Text</p>`, contentHTML)
+	assert.Equal(s.T(), []entity.TocEntry{{Level: 2, Anchor: "section-one", Title: "Section One"}}, toc)
+}
+
+func (s *ContentRendererTestSuite) TestPlain() {
+	contentHTML, toc, err := s.Renderer.Render(entity.ContentFormatPlain, "# Not a heading\nsecond <line>\n\nNext paragraph")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "<p># Not a heading<br>second &lt;line&gt;</p>\n<p>Next paragraph</p>\n", contentHTML)
+	assert.Empty(s.T(), toc)
+}
+
+func (s *ContentRendererTestSuite) TestUnknownFormat() {
+	_, _, err := s.Renderer.Render(entity.ContentFormat("rtf"), "content")
+
+	assert.Error(s.T(), err)
+}
+
+func TestContentRendererTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentRendererTestSuite))
+}
@@ -0,0 +1,56 @@
+package rendering
+
+import (
+	"context"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+)
+
+const rerenderPageSize = 500
+
+// PostRenderer caches the rendered content of posts in the posts table.
+type PostRenderer struct {
+	PostRepository  repository.PostRepository
+	ContentRenderer ContentRenderer
+}
+
+// RenderPost renders the current content of the post rather than the content
+// carried by the event that triggered it, so a late event cannot overwrite a
+// newer rendering with stale content.
+func (r PostRenderer) RenderPost(ctx context.Context, id uuid.UUID) error {
+	post, err := r.PostRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	post.ContentHTML, post.Toc, err = r.ContentRenderer.Render(post.ContentFormat, post.Content)
+	if err != nil {
+		return err
+	}
+
+	return r.PostRepository.SaveRenderedContent(ctx, post)
+}
+
+// RenderAll renders every post again, e.g. after the allowlist has changed,
+// and returns the number of rendered posts.
+func (r PostRenderer) RenderAll(ctx context.Context) (int, error) {
+	rendered := 0
+	for page := 1; ; page++ {
+		result, err := r.PostRepository.FindAllBy(ctx, page, rerenderPageSize, repository.PostFilters{IncludeUnpublished: true})
+		if err != nil {
+			return rendered, err
+		}
+
+		for _, post := range result.Items {
+			if err := r.RenderPost(ctx, post.ID); err != nil {
+				return rendered, err
+			}
+			rendered++
+		}
+
+		if len(result.Items) < rerenderPageSize {
+			return rendered, nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package search
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+)
+
+const reindexPageSize = 500
+
+// PostIndexer copies posts from the database into the search index. The
+// index holds the author's name, so it is resolved here as well.
+type PostIndexer struct {
+	PostRepository      repository.PostRepository
+	UserRepository      repository.UserRepository
+	PostIndexRepository repository.PostIndexRepository
+}
+
+// IndexPost loads the current state of the post rather than trusting the
+// event that triggered it, since events of different types are not
+// delivered in order.
+func (i PostIndexer) IndexPost(ctx context.Context, id uuid.UUID) error {
+	post, err := i.PostRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	author, err := i.UserRepository.FindByID(ctx, post.AuthorId)
+	if err != nil {
+		return err
+	}
+
+	return i.PostIndexRepository.Index(ctx, newIndexedPost(post, author))
+}
+
+func (i PostIndexer) RemovePost(ctx context.Context, id uuid.UUID) error {
+	return i.PostIndexRepository.Delete(ctx, id)
+}
+
+// Reindex rebuilds the whole index from the posts table and returns the
+// number of indexed posts.
+func (i PostIndexer) Reindex(ctx context.Context) (int, error) {
+	indexed := 0
+	authors := make(map[uuid.UUID]entity.User)
+
+	err := i.PostIndexRepository.Rebuild(ctx, func(add func(post repository.IndexedPost) error) error {
+		for page := 1; ; page++ {
+			result, err := i.PostRepository.FindAllBy(ctx, page, reindexPageSize, repository.PostFilters{IncludeUnpublished: true})
+			if err != nil {
+				return err
+			}
+
+			for _, post := range result.Items {
+				author, ok := authors[post.AuthorId]
+				if !ok {
+					author, err = i.UserRepository.FindByID(ctx, post.AuthorId)
+					if err != nil {
+						return err
+					}
+					authors[post.AuthorId] = author
+				}
+
+				if err := add(newIndexedPost(post, author)); err != nil {
+					return err
+				}
+				indexed++
+			}
+
+			if len(result.Items) < reindexPageSize {
+				return nil
+			}
+		}
+	})
+
+	return indexed, err
+}
+
+func newIndexedPost(post entity.Post, author entity.User) repository.IndexedPost {
+	return repository.IndexedPost{
+		ID:         post.ID,
+		CreatedAt:  post.CreatedAt,
+		Slug:       post.Slug,
+		Title:      post.Title,
+		Content:    post.Content,
+		AuthorId:   post.AuthorId,
+		AuthorName: author.Name,
+		Tags:       post.TagNames(),
+		Status:     string(post.Status),
+	}
+}
@@ -0,0 +1,171 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	sitemapPageSize = 500
+	// MaxURLsPerFile is the limit of the sitemap protocol. Larger sitemaps
+	// are split into files listed by a sitemap index.
+	MaxURLsPerFile = 50000
+	// IndexFile is the name under which the sitemap, or the sitemap index
+	// when it had to be split, is stored.
+	IndexFile = "sitemap.xml"
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapURL `xml:"sitemap"`
+}
+
+// SitemapGenerator lists the home page, every published post and the author
+// and tag pages that have published posts. Pages live on SiteURL, the client,
+// while the sitemap files are served from SitemapURL.
+type SitemapGenerator struct {
+	PostRepository    repository.PostRepository
+	SitemapRepository repository.SitemapRepository
+	SiteURL           string
+	SitemapURL        string
+	// MaxURLsPerFile defaults to the protocol limit when zero.
+	MaxURLsPerFile int
+}
+
+// Generate builds the sitemap from the posts table and replaces the stored
+// one. Split files are named sitemaps/1.xml, sitemaps/2.xml and so on.
+func (g SitemapGenerator) Generate(ctx context.Context) error {
+	urls, err := g.collectURLs(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxURLs := g.MaxURLsPerFile
+	if maxURLs <= 0 {
+		maxURLs = MaxURLsPerFile
+	}
+
+	files := make(map[string]string)
+	if len(urls) <= maxURLs {
+		files[IndexFile], err = marshalSitemap(urlSet{XMLNS: sitemapNS, URLs: urls})
+		if err != nil {
+			return err
+		}
+		return g.SitemapRepository.Save(ctx, files)
+	}
+
+	index := sitemapIndex{XMLNS: sitemapNS}
+	for start := 0; start < len(urls); start += maxURLs {
+		chunk := urls[start:min(start+maxURLs, len(urls))]
+		name := fmt.Sprintf("sitemaps/%d.xml", len(index.Sitemaps)+1)
+
+		files[name], err = marshalSitemap(urlSet{XMLNS: sitemapNS, URLs: chunk})
+		if err != nil {
+			return err
+		}
+		index.Sitemaps = append(index.Sitemaps, sitemapURL{Loc: g.SitemapURL + "/" + name, LastMod: latestLastMod(chunk)})
+	}
+
+	files[IndexFile], err = marshalSitemap(index)
+	if err != nil {
+		return err
+	}
+	return g.SitemapRepository.Save(ctx, files)
+}
+
+func (g SitemapGenerator) collectURLs(ctx context.Context) ([]sitemapURL, error) {
+	urls := []sitemapURL{{Loc: g.SiteURL + "/"}}
+	authors := make(map[uuid.UUID]time.Time)
+	tags := make(map[string]time.Time)
+
+	for page := 1; ; page++ {
+		result, err := g.PostRepository.FindAllBy(ctx, page, sitemapPageSize, repository.PostFilters{
+			Status:         entity.PostStatusPublished,
+			WithoutContent: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, post := range result.Items {
+			urls = append(urls, sitemapURL{Loc: g.SiteURL + "/posts/" + url.PathEscape(post.Slug), LastMod: formatLastMod(post.UpdatedAt)})
+			if post.UpdatedAt.After(authors[post.AuthorId]) {
+				authors[post.AuthorId] = post.UpdatedAt
+			}
+			for _, tag := range post.Tags {
+				if post.UpdatedAt.After(tags[tag.Name]) {
+					tags[tag.Name] = post.UpdatedAt
+				}
+			}
+		}
+
+		if len(result.Items) < sitemapPageSize {
+			break
+		}
+	}
+
+	authorIds := make([]uuid.UUID, 0, len(authors))
+	for id := range authors {
+		authorIds = append(authorIds, id)
+	}
+	sort.Slice(authorIds, func(i, j int) bool { return authorIds[i].String() < authorIds[j].String() })
+	for _, id := range authorIds {
+		urls = append(urls, sitemapURL{Loc: g.SiteURL + "/authors/" + id.String(), LastMod: formatLastMod(authors[id])})
+	}
+
+	tagNames := make([]string, 0, len(tags))
+	for name := range tags {
+		tagNames = append(tagNames, name)
+	}
+	sort.Strings(tagNames)
+	for _, name := range tagNames {
+		urls = append(urls, sitemapURL{Loc: g.SiteURL + "/tags/" + url.PathEscape(name), LastMod: formatLastMod(tags[name])})
+	}
+
+	return urls, nil
+}
+
+func marshalSitemap(v any) (string, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(body), nil
+}
+
+func formatLastMod(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// latestLastMod relies on lastmod values being UTC RFC 3339 strings, which
+// sort chronologically.
+func latestLastMod(urls []sitemapURL) string {
+	latest := ""
+	for _, u := range urls {
+		if u.LastMod > latest {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
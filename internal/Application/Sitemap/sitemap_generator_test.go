@@ -0,0 +1,163 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockPostRepository struct {
+	posts   []entity.Post
+	filters []repository.PostFilters
+}
+
+func (m *mockPostRepository) Save(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepository) Update(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepository) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return entity.Post{}, nil
+}
+
+func (m *mockPostRepository) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
+	m.filters = append(m.filters, filters)
+	start := min((page-1)*pageSize, len(m.posts))
+	end := min(start+pageSize, len(m.posts))
+	return repository.PaginatedResult[entity.Post]{Items: m.posts[start:end], Total: int64(len(m.posts)), Page: page, PageSize: pageSize}, nil
+}
+
+func (m *mockPostRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (m *mockPostRepository) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	return nil
+}
+
+func (m *mockPostRepository) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return nil
+}
+
+type mockSitemapRepository struct {
+	files   map[string]string
+	saveErr error
+}
+
+func (m *mockSitemapRepository) Save(ctx context.Context, files map[string]string) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.files = files
+	return nil
+}
+
+func (m *mockSitemapRepository) Find(ctx context.Context, name string) (string, error) {
+	content, ok := m.files[name]
+	if !ok {
+		return "", repository.ErrSitemapFileNotFound
+	}
+	return content, nil
+}
+
+type SitemapGeneratorTestSuite struct {
+	suite.Suite
+	PostRepository    *mockPostRepository
+	SitemapRepository *mockSitemapRepository
+	Generator         SitemapGenerator
+	AuthorID          uuid.UUID
+}
+
+func (s *SitemapGeneratorTestSuite) SetupTest() {
+	s.AuthorID = uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	s.PostRepository = &mockPostRepository{}
+	s.SitemapRepository = &mockSitemapRepository{}
+	s.Generator = SitemapGenerator{
+		PostRepository:    s.PostRepository,
+		SitemapRepository: s.SitemapRepository,
+		SiteURL:           "https://blog.example.com",
+		SitemapURL:        "https://api.example.com",
+	}
+}
+
+func (s *SitemapGeneratorTestSuite) newPost(slug string, updatedAt time.Time, tags ...string) entity.Post {
+	post := entity.Post{ID: uuid.New(), Slug: slug, UpdatedAt: updatedAt, AuthorId: s.AuthorID, Status: entity.PostStatusPublished}
+	for _, tag := range tags {
+		post.Tags = append(post.Tags, entity.Tag{Name: tag})
+	}
+	return post
+}
+
+func (s *SitemapGeneratorTestSuite) TestGenerate() {
+	s.PostRepository.posts = []entity.Post{
+		s.newPost("first-post", time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), "go"),
+		s.newPost("second post", time.Date(2025, 2, 1, 10, 0, 0, 0, time.FixedZone("CET", 3600)), "go", "web"),
+	}
+
+	err := s.Generator.Generate(context.Background())
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.SitemapRepository.files, 1)
+	content := s.SitemapRepository.files[IndexFile]
+	assert.True(s.T(), strings.HasPrefix(content, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(s.T(), content, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/</loc>")
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/posts/first-post</loc>\n    <lastmod>2025-01-01T10:00:00Z</lastmod>")
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/posts/second%20post</loc>\n    <lastmod>2025-02-01T09:00:00Z</lastmod>")
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/authors/"+s.AuthorID.String()+"</loc>\n    <lastmod>2025-02-01T09:00:00Z</lastmod>")
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/tags/go</loc>\n    <lastmod>2025-02-01T09:00:00Z</lastmod>")
+	assert.Contains(s.T(), content, "<loc>https://blog.example.com/tags/web</loc>")
+
+	assert.Equal(s.T(), entity.PostStatusPublished, s.PostRepository.filters[0].Status)
+	assert.True(s.T(), s.PostRepository.filters[0].WithoutContent)
+}
+
+func (s *SitemapGeneratorTestSuite) TestGenerateSplitsIntoIndex() {
+	for i := range 5 {
+		s.PostRepository.posts = append(s.PostRepository.posts, s.newPost(fmt.Sprintf("post-%d", i), time.Date(2025, 1, i+1, 0, 0, 0, 0, time.UTC)))
+	}
+	s.Generator.MaxURLsPerFile = 3
+
+	err := s.Generator.Generate(context.Background())
+
+	// The home page, five posts and one author make seven URLs.
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), s.SitemapRepository.files, 4)
+	index := s.SitemapRepository.files[IndexFile]
+	assert.Contains(s.T(), index, `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	assert.Contains(s.T(), index, "<loc>https://api.example.com/sitemaps/1.xml</loc>\n    <lastmod>2025-01-02T00:00:00Z</lastmod>")
+	assert.Contains(s.T(), index, "<loc>https://api.example.com/sitemaps/2.xml</loc>")
+	assert.Contains(s.T(), index, "<loc>https://api.example.com/sitemaps/3.xml</loc>")
+	assert.Equal(s.T(), 3, strings.Count(s.SitemapRepository.files["sitemaps/1.xml"], "<url>"))
+	assert.Equal(s.T(), 3, strings.Count(s.SitemapRepository.files["sitemaps/2.xml"], "<url>"))
+	assert.Equal(s.T(), 1, strings.Count(s.SitemapRepository.files["sitemaps/3.xml"], "<url>"))
+	assert.Contains(s.T(), s.SitemapRepository.files["sitemaps/3.xml"], "/authors/"+s.AuthorID.String())
+}
+
+func (s *SitemapGeneratorTestSuite) TestGenerateSaveError() {
+	s.SitemapRepository.saveErr = errors.New("save error")
+
+	err := s.Generator.Generate(context.Background())
+
+	assert.EqualError(s.T(), err, "save error")
+}
+
+func TestSitemapGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(SitemapGeneratorTestSuite))
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationView struct {
+	entityView
+	PostId     uuid.UUID  `json:"post_id"`
+	AuthorId   uuid.UUID  `json:"author_id"`
+	Start      int        `json:"start"`
+	End        int        `json:"end"`
+	Quote      string     `json:"quote"`
+	Body       string     `json:"body"`
+	Detached   bool       `json:"detached"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+	ResolvedBy *uuid.UUID `json:"resolved_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func NewAnnotationView(
+	id uuid.UUID,
+	postId uuid.UUID,
+	authorId uuid.UUID,
+	start int,
+	end int,
+	quote string,
+	body string,
+	detached bool,
+	resolvedAt *time.Time,
+	resolvedBy *uuid.UUID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) AnnotationView {
+	return AnnotationView{
+		entityView: NewEntityView(id),
+		PostId:     postId,
+		AuthorId:   authorId,
+		Start:      start,
+		End:        end,
+		Quote:      quote,
+		Body:       body,
+		Detached:   detached,
+		ResolvedAt: resolvedAt,
+		ResolvedBy: resolvedBy,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
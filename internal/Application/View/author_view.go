@@ -0,0 +1,20 @@
+package view
+
+import (
+	"github.com/google/uuid"
+)
+
+// AuthorView is the public profile of a user, without any account details.
+type AuthorView struct {
+	entityView
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+func NewAuthorView(id uuid.UUID, name string, avatarURL string) AuthorView {
+	return AuthorView{
+		entityView: NewEntityView(id),
+		Name:       name,
+		AvatarURL:  avatarURL,
+	}
+}
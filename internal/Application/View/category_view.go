@@ -0,0 +1,40 @@
+package view
+
+import (
+	"github.com/google/uuid"
+)
+
+// CategoryView is a node of the category tree with its subcategories.
+type CategoryView struct {
+	entityView
+	ParentId *uuid.UUID     `json:"parent_id"`
+	Name     string         `json:"name"`
+	Slug     string         `json:"slug"`
+	Children []CategoryView `json:"children"`
+}
+
+func NewCategoryView(id uuid.UUID, parentId *uuid.UUID, name string, slug string, children []CategoryView) CategoryView {
+	return CategoryView{
+		entityView: NewEntityView(id),
+		ParentId:   parentId,
+		Name:       name,
+		Slug:       slug,
+		Children:   children,
+	}
+}
+
+// BreadcrumbView is one step of the path from the root category down to the
+// category of a post.
+type BreadcrumbView struct {
+	entityView
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func NewBreadcrumbView(id uuid.UUID, name string, slug string) BreadcrumbView {
+	return BreadcrumbView{
+		entityView: NewEntityView(id),
+		Name:       name,
+		Slug:       slug,
+	}
+}
@@ -0,0 +1,43 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentView struct {
+	entityView
+	PostId    uuid.UUID     `json:"post_id"`
+	AuthorId  uuid.UUID     `json:"author_id"`
+	ParentId  *uuid.UUID    `json:"parent_id"`
+	Content   string        `json:"content"`
+	Status    string        `json:"status"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Replies   []CommentView `json:"replies"`
+}
+
+func NewCommentView(
+	id uuid.UUID,
+	postId uuid.UUID,
+	authorId uuid.UUID,
+	parentId *uuid.UUID,
+	content string,
+	status string,
+	createdAt time.Time,
+	updatedAt time.Time,
+	replies []CommentView,
+) CommentView {
+	return CommentView{
+		entityView: NewEntityView(id),
+		PostId:     postId,
+		AuthorId:   authorId,
+		ParentId:   parentId,
+		Content:    content,
+		Status:     status,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+		Replies:    replies,
+	}
+}
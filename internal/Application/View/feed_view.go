@@ -0,0 +1,14 @@
+package view
+
+import "time"
+
+type FeedView struct {
+	Body         string
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+func NewFeedView(body string, contentType string, etag string, lastModified time.Time) FeedView {
+	return FeedView{Body: body, ContentType: contentType, ETag: etag, LastModified: lastModified}
+}
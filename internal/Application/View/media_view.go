@@ -0,0 +1,87 @@
+package view
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MediaView struct {
+	entityView
+	OwnerId     uuid.UUID          `json:"owner_id"`
+	URL         string             `json:"url"`
+	FileName    string             `json:"file_name"`
+	ContentType string             `json:"content_type"`
+	Size        int64              `json:"size"`
+	Width       int                `json:"width"`
+	Height      int                `json:"height"`
+	Variants    []MediaVariantView `json:"variants"`
+	// Srcset holds a srcset attribute value per content type, to be used in
+	// the <source> elements of a <picture>.
+	Srcset    map[string]string `json:"srcset"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type MediaVariantView struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+}
+
+func NewMediaView(
+	id uuid.UUID,
+	ownerId uuid.UUID,
+	url string,
+	fileName string,
+	contentType string,
+	size int64,
+	width int,
+	height int,
+	variants []MediaVariantView,
+	createdAt time.Time,
+) MediaView {
+	srcset := make(map[string]string)
+	for _, variant := range variants {
+		candidate := variant.URL + " " + strconv.Itoa(variant.Width) + "w"
+		if srcset[variant.ContentType] != "" {
+			candidate = srcset[variant.ContentType] + ", " + candidate
+		}
+		srcset[variant.ContentType] = candidate
+	}
+
+	return MediaView{
+		entityView:  NewEntityView(id),
+		OwnerId:     ownerId,
+		URL:         url,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Width:       width,
+		Height:      height,
+		Variants:    variants,
+		Srcset:      srcset,
+		CreatedAt:   createdAt,
+	}
+}
+
+func NewMediaVariantView(
+	name string,
+	url string,
+	contentType string,
+	width int,
+	height int,
+	size int64,
+) MediaVariantView {
+	return MediaVariantView{
+		Name:        name,
+		URL:         url,
+		ContentType: contentType,
+		Width:       width,
+		Height:      height,
+		Size:        size,
+	}
+}
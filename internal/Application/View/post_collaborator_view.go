@@ -0,0 +1,13 @@
+package view
+
+import "github.com/google/uuid"
+
+// PostCollaboratorView is a user invited to work on a post, with their role.
+type PostCollaboratorView struct {
+	UserId uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+func NewPostCollaboratorView(userId uuid.UUID, role string) PostCollaboratorView {
+	return PostCollaboratorView{UserId: userId, Role: role}
+}
@@ -0,0 +1,50 @@
+package view
+
+// PostMetaView holds what a page needs in its <head> for search engines and
+// link previews. Meta lists the Open Graph and Twitter Card tags ready to be
+// rendered as <meta> elements.
+type PostMetaView struct {
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	CanonicalURL string        `json:"canonical_url"`
+	ImageURL     string        `json:"image_url"`
+	ImageWidth   int           `json:"image_width"`
+	ImageHeight  int           `json:"image_height"`
+	Meta         []MetaTagView `json:"meta"`
+}
+
+// MetaTagView is a <meta> element. Open Graph tags are keyed by Property,
+// Twitter Card tags by Name.
+type MetaTagView struct {
+	Property string `json:"property,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Content  string `json:"content"`
+}
+
+func NewPostMetaView(
+	title string,
+	description string,
+	canonicalURL string,
+	imageURL string,
+	imageWidth int,
+	imageHeight int,
+	meta []MetaTagView,
+) PostMetaView {
+	return PostMetaView{
+		Title:        title,
+		Description:  description,
+		CanonicalURL: canonicalURL,
+		ImageURL:     imageURL,
+		ImageWidth:   imageWidth,
+		ImageHeight:  imageHeight,
+		Meta:         meta,
+	}
+}
+
+func NewOpenGraphTagView(property string, content string) MetaTagView {
+	return MetaTagView{Property: property, Content: content}
+}
+
+func NewTwitterCardTagView(name string, content string) MetaTagView {
+	return MetaTagView{Name: name, Content: content}
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostReviewView is the state of the editorial review of a post. Notes are
+// the changes the reviewer asked for, empty once the post is approved.
+type PostReviewView struct {
+	SubmittedAt *time.Time `json:"submitted_at"`
+	ReviewerId  *uuid.UUID `json:"reviewer_id"`
+	ReviewedAt  *time.Time `json:"reviewed_at"`
+	Notes       string     `json:"notes"`
+}
+
+func NewPostReviewView(submittedAt *time.Time, reviewerId *uuid.UUID, reviewedAt *time.Time, notes string) *PostReviewView {
+	return &PostReviewView{
+		SubmittedAt: submittedAt,
+		ReviewerId:  reviewerId,
+		ReviewedAt:  reviewedAt,
+		Notes:       notes,
+	}
+}
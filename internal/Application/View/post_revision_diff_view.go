@@ -0,0 +1,14 @@
+package view
+
+import "github.com/google/uuid"
+
+type PostRevisionDiffView struct {
+	PostId uuid.UUID `json:"post_id"`
+	From   int       `json:"from"`
+	To     int       `json:"to"`
+	Diff   string    `json:"diff"`
+}
+
+func NewPostRevisionDiffView(postId uuid.UUID, from int, to int, diff string) PostRevisionDiffView {
+	return PostRevisionDiffView{PostId: postId, From: from, To: to, Diff: diff}
+}
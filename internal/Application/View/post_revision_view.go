@@ -0,0 +1,37 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostRevisionView struct {
+	entityView
+	PostId    uuid.UUID `json:"post_id"`
+	Revision  int       `json:"revision"`
+	CreatedAt time.Time `json:"created_at"`
+	Slug      string    `json:"slug"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+}
+
+func NewPostRevisionView(
+	id uuid.UUID,
+	postId uuid.UUID,
+	revision int,
+	createdAt time.Time,
+	slug string,
+	title string,
+	content string,
+) PostRevisionView {
+	return PostRevisionView{
+		entityView: NewEntityView(id),
+		PostId:     postId,
+		Revision:   revision,
+		CreatedAt:  createdAt,
+		Slug:       slug,
+		Title:      title,
+		Content:    content,
+	}
+}
@@ -0,0 +1,62 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostSearchHitView struct {
+	entityView
+	CreatedAt  time.Time           `json:"created_at"`
+	Slug       string              `json:"slug"`
+	Title      string              `json:"title"`
+	AuthorId   uuid.UUID           `json:"author_id"`
+	Author     string              `json:"author"`
+	Status     string              `json:"status"`
+	Tags       []string            `json:"tags"`
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights"`
+}
+
+func NewPostSearchHitView(
+	id uuid.UUID,
+	createdAt time.Time,
+	slug string,
+	title string,
+	authorId uuid.UUID,
+	author string,
+	status string,
+	tags []string,
+	score float64,
+	highlights map[string][]string,
+) PostSearchHitView {
+	return PostSearchHitView{
+		entityView: NewEntityView(id),
+		CreatedAt:  createdAt,
+		Slug:       slug,
+		Title:      title,
+		AuthorId:   authorId,
+		Author:     author,
+		Status:     status,
+		Tags:       tags,
+		Score:      score,
+		Highlights: highlights,
+	}
+}
+
+type FacetTermView struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+}
+
+// PostSearchView is a page of search hits together with the number of
+// matching posts per author and per tag.
+type PostSearchView struct {
+	PaginatedView[PostSearchHitView]
+	Facets map[string][]FacetTermView `json:"facets"`
+}
+
+func NewPostSearchView(hits PaginatedView[PostSearchHitView], facets map[string][]FacetTermView) PostSearchView {
+	return PostSearchView{PaginatedView: hits, Facets: facets}
+}
@@ -0,0 +1,23 @@
+package view
+
+type PostHighlightsView struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+type PostSearchResultView struct {
+	PostView
+	Rank       float64            `json:"rank"`
+	Highlights PostHighlightsView `json:"highlights"`
+}
+
+func NewPostSearchResultView(post PostView, rank float64, titleHighlight string, contentHighlight string) PostSearchResultView {
+	return PostSearchResultView{
+		PostView: post,
+		Rank:     rank,
+		Highlights: PostHighlightsView{
+			Title:   titleHighlight,
+			Content: contentHighlight,
+		},
+	}
+}
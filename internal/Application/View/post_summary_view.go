@@ -0,0 +1,51 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostSummaryView is the compact form of a post used by listings. It leaves
+// out the content and its rendering.
+type PostSummaryView struct {
+	entityView
+	Slug               string     `json:"slug"`
+	Title              string     `json:"title"`
+	Excerpt            string     `json:"excerpt"`
+	WordCount          int        `json:"word_count"`
+	ReadingTimeMinutes int        `json:"reading_time_minutes"`
+	AuthorId           uuid.UUID  `json:"author_id"`
+	Status             string     `json:"status"`
+	PublishedAt        *time.Time `json:"published_at"`
+	PublishAt          *time.Time `json:"publish_at"`
+	Tags               []string   `json:"tags"`
+}
+
+func NewPostSummaryView(
+	id uuid.UUID,
+	slug string,
+	title string,
+	excerpt string,
+	wordCount int,
+	readingTimeMinutes int,
+	authorId uuid.UUID,
+	status string,
+	publishedAt *time.Time,
+	publishAt *time.Time,
+	tags []string,
+) PostSummaryView {
+	return PostSummaryView{
+		entityView:         NewEntityView(id),
+		Slug:               slug,
+		Title:              title,
+		Excerpt:            excerpt,
+		WordCount:          wordCount,
+		ReadingTimeMinutes: readingTimeMinutes,
+		AuthorId:           authorId,
+		Status:             status,
+		PublishedAt:        publishedAt,
+		PublishAt:          publishAt,
+		Tags:               tags,
+	}
+}
@@ -1,15 +1,44 @@
 package view
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type PostView struct {
 	entityView
-	Slug     string    `json:"slug"`
-	Title    string    `json:"title"`
-	Content  string    `json:"content"`
-	AuthorId uuid.UUID `json:"author_id"`
+	Slug               string         `json:"slug"`
+	Title              string         `json:"title"`
+	Content            string         `json:"content"`
+	ContentFormat      string         `json:"content_format"`
+	ContentHTML        string         `json:"content_html"`
+	Toc                []TocEntryView `json:"toc"`
+	Excerpt            string         `json:"excerpt"`
+	WordCount          int            `json:"word_count"`
+	ReadingTimeMinutes int            `json:"reading_time_minutes"`
+	AuthorId           uuid.UUID      `json:"author_id"`
+	Status             string         `json:"status"`
+	PublishedAt        *time.Time     `json:"published_at"`
+	PublishAt          *time.Time     `json:"publish_at"`
+	Tags               []string       `json:"tags"`
+	CoverMediaId       *uuid.UUID     `json:"cover_media_id"`
+	SeoTitle           string         `json:"seo_title"`
+	MetaDescription    string         `json:"meta_description"`
+	CanonicalURL       string         `json:"canonical_url"`
+	CategoryId         *uuid.UUID     `json:"category_id"`
+	// AuthorIds credits the author followed by the co-authors.
+	AuthorIds     []uuid.UUID            `json:"author_ids"`
+	Collaborators []PostCollaboratorView `json:"collaborators"`
+	// Review is left out for posts that were never submitted for review.
+	Review *PostReviewView `json:"review,omitempty"`
+	// DeletedAt is only set for posts in the trash.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Breadcrumbs leads from the root category to the category of the post.
+	// It is set by the queries returning a single post.
+	Breadcrumbs []BreadcrumbView `json:"breadcrumbs,omitempty"`
+	// Series is set by GetPostQuery for posts that are part of a series.
+	Series *PostSeriesView `json:"series,omitempty"`
 }
 
 func NewPostView(
@@ -17,7 +46,51 @@ func NewPostView(
 	slug string,
 	title string,
 	content string,
+	contentFormat string,
+	contentHTML string,
+	toc []TocEntryView,
+	excerpt string,
+	wordCount int,
+	readingTimeMinutes int,
 	authorId uuid.UUID,
+	status string,
+	publishedAt *time.Time,
+	publishAt *time.Time,
+	tags []string,
+	coverMediaId *uuid.UUID,
+	seoTitle string,
+	metaDescription string,
+	canonicalURL string,
+	categoryId *uuid.UUID,
+	authorIds []uuid.UUID,
+	collaborators []PostCollaboratorView,
+	review *PostReviewView,
+	deletedAt *time.Time,
 ) PostView {
-	return PostView{entityView: NewEntityView(id), Slug: slug, Title: title, Content: content, AuthorId: authorId}
+	return PostView{
+		entityView:         NewEntityView(id),
+		Slug:               slug,
+		Title:              title,
+		Content:            content,
+		ContentFormat:      contentFormat,
+		ContentHTML:        contentHTML,
+		Toc:                toc,
+		Excerpt:            excerpt,
+		WordCount:          wordCount,
+		ReadingTimeMinutes: readingTimeMinutes,
+		AuthorId:           authorId,
+		Status:             status,
+		PublishedAt:        publishedAt,
+		PublishAt:          publishAt,
+		Tags:               tags,
+		CoverMediaId:       coverMediaId,
+		SeoTitle:           seoTitle,
+		MetaDescription:    metaDescription,
+		CanonicalURL:       canonicalURL,
+		CategoryId:         categoryId,
+		AuthorIds:          authorIds,
+		Collaborators:      collaborators,
+		Review:             review,
+		DeletedAt:          deletedAt,
+	}
 }
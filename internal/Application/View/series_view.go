@@ -0,0 +1,85 @@
+package view
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeriesView struct {
+	entityView
+	AuthorId    uuid.UUID        `json:"author_id"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+	Posts       []SeriesPostView `json:"posts"`
+}
+
+func NewSeriesView(
+	id uuid.UUID,
+	authorId uuid.UUID,
+	title string,
+	description string,
+	createdAt time.Time,
+	updatedAt time.Time,
+	posts []SeriesPostView,
+) SeriesView {
+	return SeriesView{
+		entityView:  NewEntityView(id),
+		AuthorId:    authorId,
+		Title:       title,
+		Description: description,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+		Posts:       posts,
+	}
+}
+
+// SeriesPostView is a post as listed in a series, with its position.
+type SeriesPostView struct {
+	entityView
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	Position int    `json:"position"`
+}
+
+func NewSeriesPostView(id uuid.UUID, slug string, title string, status string, position int) SeriesPostView {
+	return SeriesPostView{
+		entityView: NewEntityView(id),
+		Slug:       slug,
+		Title:      title,
+		Status:     status,
+		Position:   position,
+	}
+}
+
+// PostSeriesView places a post in its series for the navigation between the
+// parts. Previous and Next are nil at either end of the series.
+type PostSeriesView struct {
+	entityView
+	Title    string          `json:"title"`
+	Position int             `json:"position"`
+	Total    int             `json:"total"`
+	Previous *SeriesPostView `json:"previous"`
+	Next     *SeriesPostView `json:"next"`
+}
+
+func NewPostSeriesView(
+	id uuid.UUID,
+	title string,
+	position int,
+	total int,
+	previous *SeriesPostView,
+	next *SeriesPostView,
+) PostSeriesView {
+	return PostSeriesView{
+		entityView: NewEntityView(id),
+		Title:      title,
+		Position:   position,
+		Total:      total,
+		Previous:   previous,
+		Next:       next,
+	}
+}
@@ -0,0 +1,9 @@
+package view
+
+type SitemapView struct {
+	Content string
+}
+
+func NewSitemapView(content string) SitemapView {
+	return SitemapView{Content: content}
+}
@@ -0,0 +1,13 @@
+package view
+
+import "github.com/google/uuid"
+
+type TagView struct {
+	entityView
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
+}
+
+func NewTagView(id uuid.UUID, name string, postCount int64) TagView {
+	return TagView{entityView: NewEntityView(id), Name: name, PostCount: postCount}
+}
@@ -0,0 +1,11 @@
+package view
+
+type TocEntryView struct {
+	Level  int    `json:"level"`
+	Anchor string `json:"anchor"`
+	Title  string `json:"title"`
+}
+
+func NewTocEntryView(level int, anchor string, title string) TocEntryView {
+	return TocEntryView{Level: level, Anchor: anchor, Title: title}
+}
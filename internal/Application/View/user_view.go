@@ -13,6 +13,10 @@ type UserView struct {
 	LastName       string `json:"last_name"`
 	ProviderUserId string `json:"provider_user_id"`
 	AvatarURL      string `json:"avatar_url"`
+	// Roles are the site wide roles of the user and Permissions what they
+	// grant, each once.
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
 }
 
 func NewUserView(
@@ -24,6 +28,8 @@ func NewUserView(
 	lastName string,
 	providerUserId string,
 	avatarURL string,
+	roles []string,
+	permissions []string,
 ) UserView {
 	return UserView{
 		entityView:     NewEntityView(id),
@@ -34,5 +40,7 @@ func NewUserView(
 		LastName:       lastName,
 		ProviderUserId: providerUserId,
 		AvatarURL:      avatarURL,
+		Roles:          roles,
+		Permissions:    permissions,
 	}
 }
@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAnnotationRange  = errors.New("the annotated range must be a non-empty part of the post content")
+	ErrAnnotationQuoteMismatch = errors.New("the quote does not match the annotated range of the post content")
+)
+
+// Annotation is a review note on a passage of a post, kept apart from the
+// reader comments. Start and End are character offsets into the content of
+// the post, End exclusive, and Quote is the passage they covered when the
+// annotation was last anchored. A detached annotation lost its passage in an
+// edit; it keeps its last offsets until the quote shows up again.
+type Annotation struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at"`
+	PostId     uuid.UUID  `gorm:"column:post_id"`
+	AuthorId   uuid.UUID  `gorm:"column:author_id"`
+	Start      int        `gorm:"column:start_offset"`
+	End        int        `gorm:"column:end_offset"`
+	Quote      string     `gorm:"column:quote"`
+	Body       string     `gorm:"column:body"`
+	Detached   bool       `gorm:"column:detached"`
+	ResolvedAt *time.Time `gorm:"column:resolved_at"`
+	ResolvedBy *uuid.UUID `gorm:"column:resolved_by"`
+}
+
+// NewAnnotation anchors an annotation to the range of content. The quote
+// must match the range, which catches offsets computed against an outdated
+// version of the content.
+func NewAnnotation(
+	id uuid.UUID,
+	createdAt time.Time,
+	postId uuid.UUID,
+	authorId uuid.UUID,
+	content string,
+	start int,
+	end int,
+	quote string,
+	body string,
+) (Annotation, error) {
+	runes := []rune(content)
+	if start < 0 || end <= start || end > len(runes) {
+		return Annotation{}, ErrInvalidAnnotationRange
+	}
+	if string(runes[start:end]) != quote {
+		return Annotation{}, ErrAnnotationQuoteMismatch
+	}
+	return Annotation{ID: id, CreatedAt: createdAt, UpdatedAt: createdAt, PostId: postId, AuthorId: authorId, Start: start, End: end, Quote: quote, Body: body}, nil
+}
+
+// Reanchor follows an edit of the post content from oldContent to
+// newContent. A range before or after the edited part keeps its passage and
+// only shifts by the length of the edit. A range the edit touched, or a
+// detached one, moves to the occurrence of its quote closest to where it was,
+// and is detached when there is none. Reports whether the anchor changed.
+func (a *Annotation) Reanchor(oldContent string, newContent string, at time.Time) bool {
+	oldRunes, newRunes := []rune(oldContent), []rune(newContent)
+	prefix := commonPrefixLength(oldRunes, newRunes)
+	suffix := commonSuffixLength(oldRunes[prefix:], newRunes[prefix:])
+
+	start, end, detached := a.Start, a.End, a.Detached
+	switch {
+	case !a.Detached && a.End <= prefix:
+	case !a.Detached && a.Start >= len(oldRunes)-suffix:
+		shift := len(newRunes) - len(oldRunes)
+		start, end = a.Start+shift, a.End+shift
+	default:
+		start, end, detached = a.findQuote(newRunes)
+	}
+
+	if start == a.Start && end == a.End && detached == a.Detached {
+		return false
+	}
+	a.Start, a.End, a.Detached = start, end, detached
+	a.UpdatedAt = at
+	return true
+}
+
+// findQuote returns the range of the occurrence of the quote in content that
+// starts closest to the current start, or the current range, detached.
+func (a *Annotation) findQuote(content []rune) (int, int, bool) {
+	quote := []rune(a.Quote)
+	best := -1
+	for i := 0; i+len(quote) <= len(content); i++ {
+		if !slices.Equal(content[i:i+len(quote)], quote) {
+			continue
+		}
+		if best < 0 || abs(i-a.Start) < abs(best-a.Start) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return a.Start, a.End, true
+	}
+	return best, best + len(quote), false
+}
+
+func (a *Annotation) Resolve(userId uuid.UUID, at time.Time) {
+	a.ResolvedAt = &at
+	a.ResolvedBy = &userId
+	a.UpdatedAt = at
+}
+
+func (a *Annotation) Unresolve(at time.Time) {
+	a.ResolvedAt = nil
+	a.ResolvedBy = nil
+	a.UpdatedAt = at
+}
+
+func (a *Annotation) IsResolved() bool {
+	return a.ResolvedAt != nil
+}
+
+func commonPrefixLength(a []rune, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func commonSuffixLength(a []rune, b []rune) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	return n
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrCategoryCycle = errors.New("a category cannot be moved below itself or one of its descendants")
+
+// Category is a node of the curated category tree. Path is the materialized
+// path of the node, the ids from the root down to the category itself
+// between slashes, e.g. /<root id>/<child id>/.
+type Category struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	UpdatedAt time.Time  `gorm:"column:updated_at"`
+	ParentId  *uuid.UUID `gorm:"type:uuid;column:parent_id"`
+	Name      string     `gorm:"column:name"`
+	Slug      string     `gorm:"column:slug"`
+	Path      string     `gorm:"column:path"`
+}
+
+// NewCategory creates a category below parent, or a root category when
+// parent is nil.
+func NewCategory(id uuid.UUID, createdAt time.Time, parent *Category, name string, slug string) Category {
+	category := Category{ID: id, CreatedAt: createdAt, UpdatedAt: createdAt, Name: name, Slug: slug}
+	category.placeBelow(parent)
+	return category
+}
+
+func (c *Category) Rename(name string, slug string, at time.Time) {
+	c.Name = name
+	c.Slug = slug
+	c.UpdatedAt = at
+}
+
+// MoveTo puts the category below parent, or at the root when parent is nil.
+// The paths of its descendants have to be rewritten with the new prefix.
+func (c *Category) MoveTo(parent *Category, at time.Time) error {
+	if parent != nil && parent.IsDescendantOf(*c) {
+		return ErrCategoryCycle
+	}
+	c.placeBelow(parent)
+	c.UpdatedAt = at
+	return nil
+}
+
+// IsDescendantOf tells whether the category is other or lies below it.
+func (c *Category) IsDescendantOf(other Category) bool {
+	return strings.HasPrefix(c.Path, other.Path)
+}
+
+// AncestorIds returns the ids of the categories above this one, root first.
+func (c *Category) AncestorIds() []uuid.UUID {
+	parts := strings.Split(strings.Trim(c.Path, "/"), "/")
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts[:len(parts)-1] {
+		if id, err := uuid.Parse(part); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (c *Category) placeBelow(parent *Category) {
+	if parent == nil {
+		c.ParentId = nil
+		c.Path = "/" + c.ID.String() + "/"
+		return
+	}
+	c.ParentId = &parent.ID
+	c.Path = parent.Path + c.ID.String() + "/"
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrCommentParentMismatch = errors.New("a reply must belong to the same post as its parent comment")
+
+// Comment is a reader comment on a post. Top-level comments have no parent;
+// replies point at the comment they answer and may be nested arbitrarily deep.
+// New comments are pending until moderation approves or rejects them.
+type Comment struct {
+	ID        uuid.UUID     `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt time.Time     `gorm:"column:created_at"`
+	UpdatedAt time.Time     `gorm:"column:updated_at"`
+	PostId    uuid.UUID     `gorm:"column:post_id"`
+	AuthorId  uuid.UUID     `gorm:"column:author_id"`
+	ParentId  *uuid.UUID    `gorm:"column:parent_id"`
+	Content   string        `gorm:"column:content"`
+	Status    CommentStatus `gorm:"column:status"`
+}
+
+func NewComment(
+	id uuid.UUID,
+	createdAt time.Time,
+	postId uuid.UUID,
+	authorId uuid.UUID,
+	content string,
+) Comment {
+	return Comment{ID: id, CreatedAt: createdAt, UpdatedAt: createdAt, PostId: postId, AuthorId: authorId, Content: content, Status: CommentStatusPending}
+}
+
+// ReplyTo makes the comment a reply to parent. Both comments must be on the same post.
+func (c *Comment) ReplyTo(parent Comment) error {
+	if parent.PostId != c.PostId {
+		return ErrCommentParentMismatch
+	}
+	c.ParentId = &parent.ID
+	return nil
+}
+
+func (c *Comment) Edit(content string, at time.Time) {
+	c.Content = content
+	c.UpdatedAt = at
+}
+
+func (c *Comment) Approve(at time.Time) {
+	c.Status = CommentStatusApproved
+	c.UpdatedAt = at
+}
+
+func (c *Comment) Reject(at time.Time) {
+	c.Status = CommentStatusRejected
+	c.UpdatedAt = at
+}
+
+// Hold puts the comment back into the moderation queue.
+func (c *Comment) Hold(at time.Time) {
+	c.Status = CommentStatusPending
+	c.UpdatedAt = at
+}
+
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentStatusApproved
+}
+
+func (c *Comment) IsRejected() bool {
+	return c.Status == CommentStatusRejected
+}
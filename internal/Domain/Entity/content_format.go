@@ -0,0 +1,17 @@
+package entity
+
+type ContentFormat string
+
+const (
+	ContentFormatMarkdown ContentFormat = "markdown"
+	ContentFormatHTML     ContentFormat = "html"
+	ContentFormatPlain    ContentFormat = "plain"
+)
+
+func (f ContentFormat) IsValid() bool {
+	switch f {
+	case ContentFormatMarkdown, ContentFormatHTML, ContentFormatPlain:
+		return true
+	}
+	return false
+}
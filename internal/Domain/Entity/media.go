@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMediaTooLarge       = errors.New("media file exceeds the size limit")
+	ErrMediaTypeNotAllowed = errors.New("media type is not allowed")
+	ErrMediaQuotaExceeded  = errors.New("media quota exceeded")
+)
+
+// mediaExtensions lists the accepted content types with the extension their
+// files are stored under. SVG is left out on purpose, as it can carry scripts.
+var mediaExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Media is a file uploaded by a user, e.g. an image to embed in a post. The
+// file itself is kept in a Storage under StorageKey. Width, Height and
+// Variants stay empty until the variants have been generated.
+type Media struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt   time.Time      `gorm:"column:created_at"`
+	OwnerId     uuid.UUID      `gorm:"column:owner_id"`
+	StorageKey  string         `gorm:"column:storage_key"`
+	FileName    string         `gorm:"column:file_name"`
+	ContentType string         `gorm:"column:content_type"`
+	Size        int64          `gorm:"column:size"`
+	Width       int            `gorm:"column:width"`
+	Height      int            `gorm:"column:height"`
+	Variants    []MediaVariant `gorm:"foreignKey:MediaId"`
+}
+
+func (Media) TableName() string {
+	return "media"
+}
+
+// MediaExtension returns the extension files of contentType are stored under.
+func MediaExtension(contentType string) string {
+	return mediaExtensions[contentType]
+}
+
+// NewMedia returns ErrMediaTypeNotAllowed unless contentType is one of the
+// accepted image types. contentType is expected to be sniffed from the file
+// rather than taken from the client.
+func NewMedia(
+	id uuid.UUID,
+	createdAt time.Time,
+	ownerId uuid.UUID,
+	fileName string,
+	contentType string,
+	size int64,
+) (Media, error) {
+	extension, ok := mediaExtensions[contentType]
+	if !ok {
+		return Media{}, ErrMediaTypeNotAllowed
+	}
+	return Media{
+		ID:          id,
+		CreatedAt:   createdAt,
+		OwnerId:     ownerId,
+		StorageKey:  ownerId.String() + "/" + id.String() + extension,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MediaVariant is a resized copy of an uploaded image, e.g. its WebP
+// thumbnail. Variants are stored next to the original, under a key derived
+// from its key, name and type.
+type MediaVariant struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	MediaId     uuid.UUID `gorm:"column:media_id"`
+	Name        string    `gorm:"column:name"`
+	StorageKey  string    `gorm:"column:storage_key"`
+	ContentType string    `gorm:"column:content_type"`
+	Width       int       `gorm:"column:width"`
+	Height      int       `gorm:"column:height"`
+	Size        int64     `gorm:"column:size"`
+}
+
+func NewMediaVariant(
+	id uuid.UUID,
+	media Media,
+	name string,
+	contentType string,
+	width int,
+	height int,
+	size int64,
+) MediaVariant {
+	return MediaVariant{
+		ID:          id,
+		MediaId:     media.ID,
+		Name:        name,
+		StorageKey:  strings.TrimSuffix(media.StorageKey, MediaExtension(media.ContentType)) + "-" + name + MediaExtension(contentType),
+		ContentType: contentType,
+		Width:       width,
+		Height:      height,
+		Size:        size,
+	}
+}
@@ -1,19 +1,61 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+var (
+	ErrPostNotSchedulable = errors.New("only posts that are not published can be scheduled for publishing")
+	ErrPostNotApproved    = errors.New("only approved posts can be published")
+)
+
+// Post keeps the content as written by the author. ContentHTML and Toc are
+// rendered from it asynchronously and lag behind Content until the renderer
+// has caught up.
 type Post struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
-	CreatedAt time.Time `gorm:"column:created_at"`
-	UpdatedAt time.Time `gorm:"column:updated_at"`
-	Slug      string    `gorm:"column:slug"`
-	Title     string    `gorm:"column:title"`
-	Content   string    `gorm:"column:content"`
-	AuthorId  uuid.UUID `gorm:"column:author_id"`
+	ID                 uuid.UUID     `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt          time.Time     `gorm:"column:created_at"`
+	UpdatedAt          time.Time     `gorm:"column:updated_at"`
+	Slug               string        `gorm:"column:slug"`
+	Title              string        `gorm:"column:title"`
+	Content            string        `gorm:"column:content"`
+	ContentFormat      ContentFormat `gorm:"column:content_format"`
+	ContentHTML        string        `gorm:"column:content_html;->"`
+	Toc                []TocEntry    `gorm:"column:toc;serializer:json;->"`
+	Excerpt            string        `gorm:"column:excerpt"`
+	WordCount          int           `gorm:"column:word_count"`
+	ReadingTimeMinutes int           `gorm:"column:reading_time_minutes"`
+	AuthorId           uuid.UUID     `gorm:"column:author_id"`
+	Status             PostStatus    `gorm:"column:status"`
+	PublishedAt        *time.Time    `gorm:"column:published_at"`
+	PublishAt          *time.Time    `gorm:"column:publish_at"`
+	Tags               []Tag         `gorm:"many2many:post_tags;"`
+	// CoverMediaId points at the image shown above the post and in link
+	// previews. The SEO fields override the title, excerpt and URL in the
+	// page metadata when set.
+	CoverMediaId    *uuid.UUID `gorm:"type:uuid;column:cover_media_id"`
+	SeoTitle        string     `gorm:"column:seo_title"`
+	MetaDescription string     `gorm:"column:meta_description"`
+	CanonicalURL    string     `gorm:"column:canonical_url"`
+	// CategoryId is the primary category of the post, used for navigation.
+	CategoryId *uuid.UUID `gorm:"type:uuid;column:category_id"`
+	// SubmittedAt is when the post was last submitted for review. ReviewerId,
+	// ReviewedAt and ReviewNotes record the last review; the notes explain
+	// the changes requested and are cleared on approval.
+	SubmittedAt *time.Time `gorm:"column:submitted_at"`
+	ReviewerId  *uuid.UUID `gorm:"type:uuid;column:reviewer_id"`
+	ReviewedAt  *time.Time `gorm:"column:reviewed_at"`
+	ReviewNotes string     `gorm:"column:review_notes"`
+	// Collaborators are the users invited to work on the post besides its
+	// author, stored by the PostCollaboratorRepository.
+	Collaborators []PostCollaborator `gorm:"foreignKey:PostId"`
+	// DeletedAt is set while the post is in the trash. Trashed posts are left
+	// out of every query until they are restored or purged.
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at"`
 }
 
 func NewPost(
@@ -23,7 +65,165 @@ func NewPost(
 	slug string,
 	title string,
 	content string,
+	contentFormat ContentFormat,
 	authorId uuid.UUID,
 ) Post {
-	return Post{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt, Slug: slug, Title: title, Content: content, AuthorId: authorId}
+	if contentFormat == "" {
+		contentFormat = ContentFormatMarkdown
+	}
+	return Post{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt, Slug: slug, Title: title, Content: content, ContentFormat: contentFormat, AuthorId: authorId, Status: PostStatusDraft}
+}
+
+func (p *Post) Publish(at time.Time) error {
+	if p.Status != PostStatusApproved {
+		return ErrPostNotApproved
+	}
+	if err := p.transitionTo(PostStatusPublished, at); err != nil {
+		return err
+	}
+	if p.PublishedAt == nil {
+		p.PublishedAt = &at
+	}
+	p.PublishAt = nil
+	return nil
+}
+
+// SchedulePublish sets the time at which the scheduler publishes the post
+// once it is approved. A nil time clears the schedule. Only pending posts can
+// be scheduled.
+func (p *Post) SchedulePublish(at *time.Time) error {
+	if at != nil && !p.Status.IsPending() {
+		return ErrPostNotSchedulable
+	}
+	p.PublishAt = at
+	return nil
+}
+
+func (p *Post) TagNames() []string {
+	names := make([]string, len(p.Tags))
+	for i, tag := range p.Tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// AuthorIds lists the users credited for the post, the author followed by
+// the co-authors.
+func (p *Post) AuthorIds() []uuid.UUID {
+	ids := []uuid.UUID{p.AuthorId}
+	for _, collaborator := range p.Collaborators {
+		if collaborator.Role == CollaboratorRoleCoAuthor {
+			ids = append(ids, collaborator.UserId)
+		}
+	}
+	return ids
+}
+
+// InviteCollaborator lets the user work on the post in the given role. Inviting
+// a collaborator again changes their role.
+func (p *Post) InviteCollaborator(userId uuid.UUID, role CollaboratorRole, at time.Time) (PostCollaborator, error) {
+	if !role.IsValid() {
+		return PostCollaborator{}, ErrInvalidCollaboratorRole
+	}
+	if userId == p.AuthorId {
+		return PostCollaborator{}, ErrCollaboratorIsAuthor
+	}
+
+	for i, collaborator := range p.Collaborators {
+		if collaborator.UserId == userId {
+			p.Collaborators[i].Role = role
+			return p.Collaborators[i], nil
+		}
+	}
+
+	collaborator := PostCollaborator{PostId: p.ID, UserId: userId, Role: role, CreatedAt: at}
+	p.Collaborators = append(p.Collaborators, collaborator)
+	return collaborator, nil
+}
+
+func (p *Post) RemoveCollaborator(userId uuid.UUID) error {
+	for i, collaborator := range p.Collaborators {
+		if collaborator.UserId == userId {
+			p.Collaborators = append(p.Collaborators[:i], p.Collaborators[i+1:]...)
+			return nil
+		}
+	}
+	return ErrCollaboratorNotFound
+}
+
+func (p *Post) IsScheduled() bool {
+	return p.Status.IsPending() && p.PublishAt != nil
+}
+
+// SubmitForReview hands a draft, or a post whose changes were requested, to
+// the reviewers.
+func (p *Post) SubmitForReview(at time.Time) error {
+	if err := p.transitionTo(PostStatusInReview, at); err != nil {
+		return err
+	}
+	p.SubmittedAt = &at
+	return nil
+}
+
+// RequestChanges sends a post in review back to its authors with the notes
+// of the reviewer.
+func (p *Post) RequestChanges(reviewerId uuid.UUID, notes string, at time.Time) error {
+	if err := p.transitionTo(PostStatusChangesRequested, at); err != nil {
+		return err
+	}
+	p.recordReview(reviewerId, notes, at)
+	return nil
+}
+
+// Approve clears a post in review for publishing.
+func (p *Post) Approve(reviewerId uuid.UUID, at time.Time) error {
+	if err := p.transitionTo(PostStatusApproved, at); err != nil {
+		return err
+	}
+	p.recordReview(reviewerId, "", at)
+	return nil
+}
+
+// WithdrawApproval puts an approved post back in review, for when its
+// content changed after the approval.
+func (p *Post) WithdrawApproval(at time.Time) {
+	if p.Status == PostStatusApproved {
+		p.Status = PostStatusInReview
+		p.SubmittedAt = &at
+	}
+}
+
+func (p *Post) recordReview(reviewerId uuid.UUID, notes string, at time.Time) {
+	p.ReviewerId = &reviewerId
+	p.ReviewedAt = &at
+	p.ReviewNotes = notes
+}
+
+func (p *Post) Unpublish(at time.Time) error {
+	return p.transitionTo(PostStatusDraft, at)
+}
+
+func (p *Post) Archive(at time.Time) error {
+	if err := p.transitionTo(PostStatusArchived, at); err != nil {
+		return err
+	}
+	p.PublishAt = nil
+	return nil
+}
+
+func (p *Post) IsPublished() bool {
+	return p.Status == PostStatusPublished
+}
+
+func (p *Post) IsTrashed() bool {
+	return p.DeletedAt.Valid
+}
+
+func (p *Post) transitionTo(status PostStatus, at time.Time) error {
+	if !p.Status.CanTransitionTo(status) {
+		return ErrInvalidPostStatusTransition
+	}
+	p.Status = status
+	p.UpdatedAt = at
+	return nil
 }
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCollaboratorRole = errors.New("invalid collaborator role")
+	ErrCollaboratorIsAuthor    = errors.New("the author of a post cannot be invited as collaborator")
+	ErrCollaboratorNotFound    = errors.New("user is not a collaborator of the post")
+)
+
+// CollaboratorRole is what a collaborator may do with a post. Co-authors are
+// credited next to the author and may do everything the author does, editors
+// may change and review the post and reviewers may read and review it.
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleCoAuthor CollaboratorRole = "co-author"
+	CollaboratorRoleEditor   CollaboratorRole = "editor"
+	CollaboratorRoleReviewer CollaboratorRole = "reviewer"
+)
+
+// ReviewerCollaboratorRoles are the roles allowed to review a post.
+var ReviewerCollaboratorRoles = []CollaboratorRole{CollaboratorRoleEditor, CollaboratorRoleReviewer}
+
+func (r CollaboratorRole) IsValid() bool {
+	switch r {
+	case CollaboratorRoleCoAuthor, CollaboratorRoleEditor, CollaboratorRoleReviewer:
+		return true
+	}
+	return false
+}
+
+// CanEdit tells whether the role allows changing the content of the post.
+func (r CollaboratorRole) CanEdit() bool {
+	return r == CollaboratorRoleCoAuthor || r == CollaboratorRoleEditor
+}
+
+// CanReview tells whether the role allows approving the post or requesting
+// changes to it.
+func (r CollaboratorRole) CanReview() bool {
+	for _, role := range ReviewerCollaboratorRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CanManage tells whether the role allows deleting the post and inviting or
+// removing collaborators.
+func (r CollaboratorRole) CanManage() bool {
+	return r == CollaboratorRoleCoAuthor
+}
+
+type PostCollaborator struct {
+	PostId    uuid.UUID        `gorm:"type:uuid;primaryKey;column:post_id"`
+	UserId    uuid.UUID        `gorm:"type:uuid;primaryKey;column:user_id"`
+	Role      CollaboratorRole `gorm:"column:role"`
+	CreatedAt time.Time        `gorm:"column:created_at"`
+}
+
+func (PostCollaborator) TableName() string {
+	return "post_collaborators"
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostRevision is an immutable snapshot of a post's editable fields. Revision
+// numbers start at 1 and are assigned by the repository on save.
+type PostRevision struct {
+	ID            uuid.UUID     `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt     time.Time     `gorm:"column:created_at"`
+	PostId        uuid.UUID     `gorm:"column:post_id"`
+	Revision      int           `gorm:"column:revision"`
+	Slug          string        `gorm:"column:slug"`
+	Title         string        `gorm:"column:title"`
+	Content       string        `gorm:"column:content"`
+	ContentFormat ContentFormat `gorm:"column:content_format"`
+	Excerpt       string        `gorm:"column:excerpt"`
+}
+
+func NewPostRevision(id uuid.UUID, createdAt time.Time, post Post) PostRevision {
+	return PostRevision{
+		ID:            id,
+		CreatedAt:     createdAt,
+		PostId:        post.ID,
+		Slug:          post.Slug,
+		Title:         post.Title,
+		Content:       post.Content,
+		ContentFormat: post.ContentFormat,
+		Excerpt:       post.Excerpt,
+	}
+}
@@ -0,0 +1,57 @@
+package entity
+
+import "errors"
+
+type PostStatus string
+
+const (
+	PostStatusDraft            PostStatus = "draft"
+	PostStatusInReview         PostStatus = "in_review"
+	PostStatusChangesRequested PostStatus = "changes_requested"
+	PostStatusApproved         PostStatus = "approved"
+	PostStatusPublished        PostStatus = "published"
+	PostStatusArchived         PostStatus = "archived"
+)
+
+var ErrInvalidPostStatusTransition = errors.New("invalid post status transition")
+
+// PendingPostStatuses are the statuses of posts on their way to publication,
+// from the draft to the approval.
+var PendingPostStatuses = []PostStatus{PostStatusDraft, PostStatusInReview, PostStatusChangesRequested, PostStatusApproved}
+
+// postStatusTransitions makes every draft go through the review before it
+// is published. Posts that were published before, and later unpublished or
+// archived, go back through the review too: an archived post may have been
+// edited, so it returns to draft before it can be published again.
+var postStatusTransitions = map[PostStatus][]PostStatus{
+	PostStatusDraft:            {PostStatusInReview, PostStatusArchived},
+	PostStatusInReview:         {PostStatusChangesRequested, PostStatusApproved},
+	PostStatusChangesRequested: {PostStatusInReview, PostStatusArchived},
+	PostStatusApproved:         {PostStatusPublished, PostStatusInReview, PostStatusArchived},
+	PostStatusPublished:        {PostStatusDraft, PostStatusArchived},
+	PostStatusArchived:         {PostStatusDraft},
+}
+
+func (s PostStatus) IsValid() bool {
+	_, ok := postStatusTransitions[s]
+	return ok
+}
+
+// IsPending tells whether a post in this status waits to be published.
+func (s PostStatus) IsPending() bool {
+	for _, pending := range PendingPostStatuses {
+		if s == pending {
+			return true
+		}
+	}
+	return false
+}
+
+func (s PostStatus) CanTransitionTo(target PostStatus) bool {
+	for _, allowed := range postStatusTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	excerptMaxLength = 280
+	wordsPerMinute   = 200
+)
+
+// Summarize derives the excerpt, word count and reading time of the post from
+// text, its content with the markup stripped. A non-empty excerpt is kept as
+// given instead of being derived.
+func (p *Post) Summarize(text string, excerpt string) {
+	words := strings.Fields(text)
+	p.WordCount = len(words)
+	p.ReadingTimeMinutes = (p.WordCount + wordsPerMinute - 1) / wordsPerMinute
+
+	excerpt = strings.Join(strings.Fields(excerpt), " ")
+	if excerpt == "" {
+		excerpt = truncateAtSentence(strings.Join(words, " "), excerptMaxLength)
+	}
+	p.Excerpt = excerpt
+}
+
+// truncateAtSentence shortens text to at most max runes. It cuts after the
+// last complete sentence that fits, or after the last whole word when the
+// first sentence is already too long.
+func truncateAtSentence(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	runes := []rune(text)
+	head := string(runes[:max])
+
+	sentenceEnd := -1
+	for i := 0; i < len(head)-1; i++ {
+		if strings.ContainsRune(".!?", rune(head[i])) && head[i+1] == ' ' {
+			sentenceEnd = i + 1
+		}
+	}
+	if sentenceEnd > 0 {
+		return head[:sentenceEnd]
+	}
+
+	if lastSpace := strings.LastIndex(head, " "); lastSpace > 0 {
+		head = head[:lastSpace]
+	}
+	return strings.TrimRight(head, ",;:") + "…"
+}
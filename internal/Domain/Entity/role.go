@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRole              = errors.New("invalid role")
+	ErrRoleNotGranted           = errors.New("user does not have the role")
+	ErrCannotRevokeOwnAdminRole = errors.New("admins cannot revoke their own admin role")
+)
+
+// Role is a site wide role of a user, granting the permissions listed in
+// rolePermissions. Access to a single post is given by the post itself, to
+// its author and collaborators, on top of that.
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleAuthor Role = "author"
+	RoleReader Role = "reader"
+)
+
+// DefaultRole is granted to every user on sign-up.
+const DefaultRole = RoleAuthor
+
+// Permission is an action a Role allows.
+type Permission string
+
+const (
+	// PermissionCreatePosts allows writing posts and series of one's own and
+	// uploading media for them.
+	PermissionCreatePosts Permission = "posts:create"
+	// PermissionEditAnyPost allows doing everything a co-author does on every
+	// post.
+	PermissionEditAnyPost Permission = "posts:edit_any"
+	// PermissionReviewAnyPost allows reviewing and annotating every post the
+	// user did not write.
+	PermissionReviewAnyPost  Permission = "posts:review_any"
+	PermissionCreateComments Permission = "comments:create"
+	// PermissionModerateComments allows moderating the comments on every post,
+	// not only on one's own.
+	PermissionModerateComments Permission = "comments:moderate"
+	PermissionManageCategories Permission = "categories:manage"
+	PermissionManageRoles      Permission = "roles:manage"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionCreatePosts,
+		PermissionEditAnyPost,
+		PermissionReviewAnyPost,
+		PermissionCreateComments,
+		PermissionModerateComments,
+		PermissionManageCategories,
+		PermissionManageRoles,
+	},
+	RoleEditor: {
+		PermissionCreatePosts,
+		PermissionEditAnyPost,
+		PermissionReviewAnyPost,
+		PermissionCreateComments,
+		PermissionModerateComments,
+	},
+	RoleAuthor: {
+		PermissionCreatePosts,
+		PermissionCreateComments,
+	},
+	RoleReader: {
+		PermissionCreateComments,
+	},
+}
+
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// UserRole grants a role to a user. GrantedBy is nil for roles granted on
+// sign-up or by a migration.
+type UserRole struct {
+	UserId    uuid.UUID  `gorm:"type:uuid;primaryKey;column:user_id"`
+	Role      Role       `gorm:"primaryKey;column:role"`
+	GrantedAt time.Time  `gorm:"column:granted_at"`
+	GrantedBy *uuid.UUID `gorm:"type:uuid;column:granted_by"`
+}
+
+func (UserRole) TableName() string {
+	return "user_roles"
+}
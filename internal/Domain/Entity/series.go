@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPostAlreadyInSeries = errors.New("post is already part of the series")
+	ErrPostNotInSeries     = errors.New("post is not part of the series")
+	ErrSeriesOrderMismatch = errors.New("the new order must list every post of the series exactly once")
+)
+
+// Series groups the posts of a multi-part collection, e.g. a tutorial, in
+// reading order. Posts holds the membership ordered by position.
+type Series struct {
+	ID          uuid.UUID    `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt   time.Time    `gorm:"column:created_at"`
+	UpdatedAt   time.Time    `gorm:"column:updated_at"`
+	AuthorId    uuid.UUID    `gorm:"column:author_id"`
+	Title       string       `gorm:"column:title"`
+	Description string       `gorm:"column:description"`
+	Posts       []SeriesPost `gorm:"foreignKey:SeriesId"`
+}
+
+// SeriesPost places a post in a series. Positions start at 1. Post is only
+// loaded when reading a series, for the titles and slugs of its posts; posts
+// in the trash keep their place but are loaded with DeletedAt set.
+type SeriesPost struct {
+	SeriesId uuid.UUID `gorm:"type:uuid;primaryKey;column:series_id"`
+	PostId   uuid.UUID `gorm:"type:uuid;primaryKey;column:post_id"`
+	Position int       `gorm:"column:position"`
+	Post     Post      `gorm:"foreignKey:PostId"`
+}
+
+func (Series) TableName() string {
+	return "series"
+}
+
+func (SeriesPost) TableName() string {
+	return "series_posts"
+}
+
+func NewSeries(
+	id uuid.UUID,
+	createdAt time.Time,
+	authorId uuid.UUID,
+	title string,
+	description string,
+) Series {
+	return Series{ID: id, CreatedAt: createdAt, UpdatedAt: createdAt, AuthorId: authorId, Title: title, Description: description}
+}
+
+// AddPost appends the post to the end of the series.
+func (s *Series) AddPost(postId uuid.UUID, at time.Time) error {
+	if s.IndexOf(postId) >= 0 {
+		return ErrPostAlreadyInSeries
+	}
+	s.Posts = append(s.Posts, SeriesPost{SeriesId: s.ID, PostId: postId})
+	s.renumber(at)
+	return nil
+}
+
+// RemovePost takes the post out of the series and closes the gap it leaves.
+func (s *Series) RemovePost(postId uuid.UUID, at time.Time) error {
+	index := s.IndexOf(postId)
+	if index < 0 {
+		return ErrPostNotInSeries
+	}
+	s.Posts = append(s.Posts[:index], s.Posts[index+1:]...)
+	s.renumber(at)
+	return nil
+}
+
+// Reorder puts the posts in the given order, which has to list every post of
+// the series that is not in the trash exactly once. Trashed posts move to the
+// end, keeping their relative order.
+func (s *Series) Reorder(postIds []uuid.UUID, at time.Time) error {
+	trashed := make([]SeriesPost, 0)
+	for _, seriesPost := range s.Posts {
+		if seriesPost.Post.IsTrashed() {
+			trashed = append(trashed, seriesPost)
+		}
+	}
+	if len(postIds) != len(s.Posts)-len(trashed) {
+		return ErrSeriesOrderMismatch
+	}
+
+	reordered := make([]SeriesPost, len(postIds), len(s.Posts))
+	seen := make(map[uuid.UUID]bool, len(postIds))
+	for i, postId := range postIds {
+		index := s.IndexOf(postId)
+		if index < 0 || seen[postId] || s.Posts[index].Post.IsTrashed() {
+			return ErrSeriesOrderMismatch
+		}
+		seen[postId] = true
+		reordered[i] = s.Posts[index]
+	}
+
+	s.Posts = append(reordered, trashed...)
+	s.renumber(at)
+	return nil
+}
+
+// IndexOf returns the index of the post in Posts, or -1 when it is not part
+// of the series.
+func (s *Series) IndexOf(postId uuid.UUID) int {
+	for i, seriesPost := range s.Posts {
+		if seriesPost.PostId == postId {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Series) PostIds() []uuid.UUID {
+	postIds := make([]uuid.UUID, len(s.Posts))
+	for i, seriesPost := range s.Posts {
+		postIds[i] = seriesPost.PostId
+	}
+	return postIds
+}
+
+func (s *Series) renumber(at time.Time) {
+	for i := range s.Posts {
+		s.Posts[i].Position = i + 1
+	}
+	s.UpdatedAt = at
+}
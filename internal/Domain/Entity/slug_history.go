@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SlugHistory remembers a slug a post was published under before it was
+// renamed, so links using the old slug can be redirected.
+type SlugHistory struct {
+	Slug      string    `gorm:"primaryKey;column:slug"`
+	PostId    uuid.UUID `gorm:"column:post_id"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (SlugHistory) TableName() string {
+	return "slug_history"
+}
+
+func NewSlugHistory(slug string, postId uuid.UUID, createdAt time.Time) SlugHistory {
+	return SlugHistory{
+		Slug:      slug,
+		PostId:    postId,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;column:id;default:gen_random_uuid()"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	Name      string    `gorm:"column:name"`
+}
+
+func NewTag(id uuid.UUID, createdAt time.Time, name string) Tag {
+	return Tag{ID: id, CreatedAt: createdAt, Name: name}
+}
+
+// NormalizeTagNames lowercases and trims the given names and drops empty and
+// duplicate entries while keeping the original order.
+func NormalizeTagNames(names []string) []string {
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
@@ -0,0 +1,9 @@
+package entity
+
+// TocEntry is one heading of a post's rendered content. Anchor is the id of
+// the heading element in the rendered HTML.
+type TocEntry struct {
+	Level  int    `json:"level"`
+	Anchor string `json:"anchor"`
+	Title  string `json:"title"`
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +19,8 @@ type User struct {
 	LastName       string    `gorm:"column:last_name"`
 	ProviderUserId string    `gorm:"column:provider_user_id"`
 	AvatarURL      string    `gorm:"column:avatar_url"`
+	// Roles are the site wide roles of the user, which grant its permissions.
+	Roles []UserRole `gorm:"foreignKey:UserId"`
 }
 
 func NewUser(
@@ -47,3 +50,73 @@ func NewUser(
 		AvatarURL:      avatarURL,
 	}
 }
+
+func (u *User) HasRole(role Role) bool {
+	for _, userRole := range u.Roles {
+		if userRole.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Can tells whether one of the roles of the user grants the permission.
+func (u *User) Can(permission Permission) bool {
+	return slices.Contains(u.Permissions(), permission)
+}
+
+// Permissions lists the permissions granted by the roles of the user, each
+// once.
+func (u *User) Permissions() []Permission {
+	permissions := make([]Permission, 0)
+	for _, userRole := range u.Roles {
+		for _, permission := range userRole.Role.Permissions() {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+func (u *User) RoleNames() []string {
+	names := make([]string, len(u.Roles))
+	for i, userRole := range u.Roles {
+		names[i] = string(userRole.Role)
+	}
+	return names
+}
+
+// GrantRole gives the user the role. Granting a role the user already has
+// returns the existing grant.
+func (u *User) GrantRole(role Role, grantedBy *uuid.UUID, at time.Time) (UserRole, error) {
+	if !role.IsValid() {
+		return UserRole{}, ErrInvalidRole
+	}
+	for _, userRole := range u.Roles {
+		if userRole.Role == role {
+			return userRole, nil
+		}
+	}
+
+	userRole := UserRole{UserId: u.ID, Role: role, GrantedAt: at, GrantedBy: grantedBy}
+	u.Roles = append(u.Roles, userRole)
+	u.UpdatedAt = at
+	return userRole, nil
+}
+
+// RevokeRole takes the role away from the user. Admins cannot revoke their
+// own admin role, so that they cannot lock themselves out by accident.
+func (u *User) RevokeRole(role Role, revokedBy uuid.UUID, at time.Time) error {
+	if role == RoleAdmin && revokedBy == u.ID {
+		return ErrCannotRevokeOwnAdminRole
+	}
+	for i, userRole := range u.Roles {
+		if userRole.Role == role {
+			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			u.UpdatedAt = at
+			return nil
+		}
+	}
+	return ErrRoleNotGranted
+}
@@ -0,0 +1,40 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationWasCreated struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	PostId    uuid.UUID `json:"post_id"`
+	AuthorId  uuid.UUID `json:"author_id"`
+	Start     int       `json:"start"`
+	End       int       `json:"end"`
+	Quote     string    `json:"quote"`
+	Body      string    `json:"body"`
+}
+
+func NewAnnotationWasCreated(
+	ID uuid.UUID,
+	CreatedAt time.Time,
+	PostId uuid.UUID,
+	AuthorId uuid.UUID,
+	Start int,
+	End int,
+	Quote string,
+	Body string,
+) AnnotationWasCreated {
+	return AnnotationWasCreated{
+		ID:        ID,
+		CreatedAt: CreatedAt,
+		PostId:    PostId,
+		AuthorId:  AuthorId,
+		Start:     Start,
+		End:       End,
+		Quote:     Quote,
+		Body:      Body,
+	}
+}
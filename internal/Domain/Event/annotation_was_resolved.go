@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationWasResolved struct {
+	ID         uuid.UUID `json:"id"`
+	ResolvedAt time.Time `json:"resolved_at"`
+	PostId     uuid.UUID `json:"post_id"`
+	ResolvedBy uuid.UUID `json:"resolved_by"`
+}
+
+func NewAnnotationWasResolved(
+	ID uuid.UUID,
+	ResolvedAt time.Time,
+	PostId uuid.UUID,
+	ResolvedBy uuid.UUID,
+) AnnotationWasResolved {
+	return AnnotationWasResolved{
+		ID:         ID,
+		ResolvedAt: ResolvedAt,
+		PostId:     PostId,
+		ResolvedBy: ResolvedBy,
+	}
+}
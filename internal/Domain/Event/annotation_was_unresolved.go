@@ -0,0 +1,25 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationWasUnresolved struct {
+	ID           uuid.UUID `json:"id"`
+	UnresolvedAt time.Time `json:"unresolved_at"`
+	PostId       uuid.UUID `json:"post_id"`
+}
+
+func NewAnnotationWasUnresolved(
+	ID uuid.UUID,
+	UnresolvedAt time.Time,
+	PostId uuid.UUID,
+) AnnotationWasUnresolved {
+	return AnnotationWasUnresolved{
+		ID:           ID,
+		UnresolvedAt: UnresolvedAt,
+		PostId:       PostId,
+	}
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CategoryWasCreated struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	ParentId  *uuid.UUID `json:"parent_id"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug"`
+	Path      string     `json:"path"`
+}
+
+func NewCategoryWasCreated(
+	ID uuid.UUID,
+	CreatedAt time.Time,
+	ParentId *uuid.UUID,
+	Name string,
+	Slug string,
+	Path string,
+) CategoryWasCreated {
+	return CategoryWasCreated{
+		ID:        ID,
+		CreatedAt: CreatedAt,
+		ParentId:  ParentId,
+		Name:      Name,
+		Slug:      Slug,
+		Path:      Path,
+	}
+}
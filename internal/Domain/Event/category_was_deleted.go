@@ -0,0 +1,25 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CategoryWasDeleted struct {
+	ID        uuid.UUID `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+	Slug      string    `json:"slug"`
+}
+
+func NewCategoryWasDeleted(
+	ID uuid.UUID,
+	DeletedAt time.Time,
+	Slug string,
+) CategoryWasDeleted {
+	return CategoryWasDeleted{
+		ID:        ID,
+		DeletedAt: DeletedAt,
+		Slug:      Slug,
+	}
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CategoryWasUpdated struct {
+	ID        uuid.UUID  `json:"id"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ParentId  *uuid.UUID `json:"parent_id"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug"`
+	Path      string     `json:"path"`
+}
+
+func NewCategoryWasUpdated(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	ParentId *uuid.UUID,
+	Name string,
+	Slug string,
+	Path string,
+) CategoryWasUpdated {
+	return CategoryWasUpdated{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		ParentId:  ParentId,
+		Name:      Name,
+		Slug:      Slug,
+		Path:      Path,
+	}
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentWasDeleted struct {
+	ID        uuid.UUID `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+	PostId    uuid.UUID `json:"post_id"`
+	AuthorId  uuid.UUID `json:"author_id"`
+}
+
+func NewCommentWasDeleted(
+	ID uuid.UUID,
+	DeletedAt time.Time,
+	PostId uuid.UUID,
+	AuthorId uuid.UUID,
+) CommentWasDeleted {
+	return CommentWasDeleted{
+		ID:        ID,
+		DeletedAt: DeletedAt,
+		PostId:    PostId,
+		AuthorId:  AuthorId,
+	}
+}
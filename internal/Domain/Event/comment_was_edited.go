@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentWasEdited struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	PostId    uuid.UUID `json:"post_id"`
+	AuthorId  uuid.UUID `json:"author_id"`
+	Content   string    `json:"content"`
+	Status    string    `json:"status"`
+}
+
+func NewCommentWasEdited(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PostId uuid.UUID,
+	AuthorId uuid.UUID,
+	Content string,
+	Status string,
+) CommentWasEdited {
+	return CommentWasEdited{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		PostId:    PostId,
+		AuthorId:  AuthorId,
+		Content:   Content,
+		Status:    Status,
+	}
+}
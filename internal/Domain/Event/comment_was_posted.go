@@ -0,0 +1,37 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentWasPosted struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	PostId    uuid.UUID  `json:"post_id"`
+	AuthorId  uuid.UUID  `json:"author_id"`
+	ParentId  *uuid.UUID `json:"parent_id"`
+	Content   string     `json:"content"`
+	Status    string     `json:"status"`
+}
+
+func NewCommentWasPosted(
+	ID uuid.UUID,
+	CreatedAt time.Time,
+	PostId uuid.UUID,
+	AuthorId uuid.UUID,
+	ParentId *uuid.UUID,
+	Content string,
+	Status string,
+) CommentWasPosted {
+	return CommentWasPosted{
+		ID:        ID,
+		CreatedAt: CreatedAt,
+		PostId:    PostId,
+		AuthorId:  AuthorId,
+		ParentId:  ParentId,
+		Content:   Content,
+		Status:    Status,
+	}
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentWasRejected struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	PostId    uuid.UUID `json:"post_id"`
+	AuthorId  uuid.UUID `json:"author_id"`
+	Content   string    `json:"content"`
+}
+
+func NewCommentWasRejected(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PostId uuid.UUID,
+	AuthorId uuid.UUID,
+	Content string,
+) CommentWasRejected {
+	return CommentWasRejected{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		PostId:    PostId,
+		AuthorId:  AuthorId,
+		Content:   Content,
+	}
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MediaWasUploaded struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	OwnerId     uuid.UUID `json:"owner_id"`
+	StorageKey  string    `json:"storage_key"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+}
+
+func NewMediaWasUploaded(
+	ID uuid.UUID,
+	CreatedAt time.Time,
+	OwnerId uuid.UUID,
+	StorageKey string,
+	ContentType string,
+	Size int64,
+) MediaWasUploaded {
+	return MediaWasUploaded{
+		ID:          ID,
+		CreatedAt:   CreatedAt,
+		OwnerId:     OwnerId,
+		StorageKey:  StorageKey,
+		ContentType: ContentType,
+		Size:        Size,
+	}
+}
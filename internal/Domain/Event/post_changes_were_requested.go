@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostChangesWereRequested struct {
+	ID          uuid.UUID   `json:"id"`
+	RequestedAt time.Time   `json:"requested_at"`
+	ReviewerId  uuid.UUID   `json:"reviewer_id"`
+	Notes       string      `json:"notes"`
+	AuthorIds   []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostChangesWereRequested(
+	ID uuid.UUID,
+	RequestedAt time.Time,
+	ReviewerId uuid.UUID,
+	Notes string,
+	AuthorIds []uuid.UUID,
+) PostChangesWereRequested {
+	return PostChangesWereRequested{
+		ID:          ID,
+		RequestedAt: RequestedAt,
+		ReviewerId:  ReviewerId,
+		Notes:       Notes,
+		AuthorIds:   AuthorIds,
+	}
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostCollaboratorWasInvited struct {
+	ID        uuid.UUID   `json:"id"`
+	InvitedAt time.Time   `json:"invited_at"`
+	UserId    uuid.UUID   `json:"user_id"`
+	Role      string      `json:"role"`
+	AuthorIds []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostCollaboratorWasInvited(
+	ID uuid.UUID,
+	InvitedAt time.Time,
+	UserId uuid.UUID,
+	Role string,
+	AuthorIds []uuid.UUID,
+) PostCollaboratorWasInvited {
+	return PostCollaboratorWasInvited{
+		ID:        ID,
+		InvitedAt: InvitedAt,
+		UserId:    UserId,
+		Role:      Role,
+		AuthorIds: AuthorIds,
+	}
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostCollaboratorWasRemoved struct {
+	ID        uuid.UUID   `json:"id"`
+	RemovedAt time.Time   `json:"removed_at"`
+	UserId    uuid.UUID   `json:"user_id"`
+	AuthorIds []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostCollaboratorWasRemoved(
+	ID uuid.UUID,
+	RemovedAt time.Time,
+	UserId uuid.UUID,
+	AuthorIds []uuid.UUID,
+) PostCollaboratorWasRemoved {
+	return PostCollaboratorWasRemoved{
+		ID:        ID,
+		RemovedAt: RemovedAt,
+		UserId:    UserId,
+		AuthorIds: AuthorIds,
+	}
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasAddedToSeries struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	PostId    uuid.UUID `json:"post_id"`
+	Position  int       `json:"position"`
+}
+
+func NewPostWasAddedToSeries(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PostId uuid.UUID,
+	Position int,
+) PostWasAddedToSeries {
+	return PostWasAddedToSeries{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		PostId:    PostId,
+		Position:  Position,
+	}
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasApproved struct {
+	ID         uuid.UUID   `json:"id"`
+	ApprovedAt time.Time   `json:"approved_at"`
+	ReviewerId uuid.UUID   `json:"reviewer_id"`
+	AuthorIds  []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostWasApproved(
+	ID uuid.UUID,
+	ApprovedAt time.Time,
+	ReviewerId uuid.UUID,
+	AuthorIds []uuid.UUID,
+) PostWasApproved {
+	return PostWasApproved{
+		ID:         ID,
+		ApprovedAt: ApprovedAt,
+		ReviewerId: ReviewerId,
+		AuthorIds:  AuthorIds,
+	}
+}
@@ -6,13 +6,16 @@ import (
 )
 
 type PostWasCreated struct {
-	ID        uuid.UUID `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Slug      string    `json:"slug"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorId  uuid.UUID `json:"author_id"`
+	ID            uuid.UUID   `json:"id"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	Slug          string      `json:"slug"`
+	Title         string      `json:"title"`
+	Content       string      `json:"content"`
+	ContentFormat string      `json:"content_format"`
+	AuthorId      uuid.UUID   `json:"author_id"`
+	AuthorIds     []uuid.UUID `json:"author_ids"`
+	Tags          []string    `json:"tags"`
 }
 
 func NewPostWasCreated(
@@ -22,15 +25,21 @@ func NewPostWasCreated(
 	Slug string,
 	Title string,
 	Content string,
+	ContentFormat string,
 	AuthorId uuid.UUID,
+	AuthorIds []uuid.UUID,
+	Tags []string,
 ) PostWasCreated {
 	return PostWasCreated{
-		ID:        ID,
-		CreatedAt: CreatedAt,
-		UpdatedAt: UpdatedAt,
-		Slug:      Slug,
-		Title:     Title,
-		Content:   Content,
-		AuthorId:  AuthorId,
+		ID:            ID,
+		CreatedAt:     CreatedAt,
+		UpdatedAt:     UpdatedAt,
+		Slug:          Slug,
+		Title:         Title,
+		Content:       Content,
+		ContentFormat: ContentFormat,
+		AuthorId:      AuthorId,
+		AuthorIds:     AuthorIds,
+		Tags:          Tags,
 	}
 }
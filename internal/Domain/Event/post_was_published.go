@@ -0,0 +1,34 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasPublished struct {
+	ID          uuid.UUID   `json:"id"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	PublishedAt time.Time   `json:"published_at"`
+	Slug        string      `json:"slug"`
+	AuthorId    uuid.UUID   `json:"author_id"`
+	AuthorIds   []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostWasPublished(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PublishedAt time.Time,
+	Slug string,
+	AuthorId uuid.UUID,
+	AuthorIds []uuid.UUID,
+) PostWasPublished {
+	return PostWasPublished{
+		ID:          ID,
+		UpdatedAt:   UpdatedAt,
+		PublishedAt: PublishedAt,
+		Slug:        Slug,
+		AuthorId:    AuthorId,
+		AuthorIds:   AuthorIds,
+	}
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasPurged struct {
+	ID        uuid.UUID   `json:"id"`
+	PurgedAt  time.Time   `json:"purged_at"`
+	Slug      string      `json:"slug"`
+	AuthorIds []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostWasPurged(
+	ID uuid.UUID,
+	PurgedAt time.Time,
+	Slug string,
+	AuthorIds []uuid.UUID,
+) PostWasPurged {
+	return PostWasPurged{
+		ID:        ID,
+		PurgedAt:  PurgedAt,
+		Slug:      Slug,
+		AuthorIds: AuthorIds,
+	}
+}
@@ -0,0 +1,25 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasRemovedFromSeries struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	PostId    uuid.UUID `json:"post_id"`
+}
+
+func NewPostWasRemovedFromSeries(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PostId uuid.UUID,
+) PostWasRemovedFromSeries {
+	return PostWasRemovedFromSeries{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		PostId:    PostId,
+	}
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasRestored struct {
+	ID         uuid.UUID   `json:"id"`
+	RestoredAt time.Time   `json:"restored_at"`
+	Slug       string      `json:"slug"`
+	Title      string      `json:"title"`
+	AuthorIds  []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostWasRestored(
+	ID uuid.UUID,
+	RestoredAt time.Time,
+	Slug string,
+	Title string,
+	AuthorIds []uuid.UUID,
+) PostWasRestored {
+	return PostWasRestored{
+		ID:         ID,
+		RestoredAt: RestoredAt,
+		Slug:       Slug,
+		Title:      Title,
+		AuthorIds:  AuthorIds,
+	}
+}
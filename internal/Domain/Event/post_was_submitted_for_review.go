@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasSubmittedForReview struct {
+	ID          uuid.UUID   `json:"id"`
+	SubmittedAt time.Time   `json:"submitted_at"`
+	Slug        string      `json:"slug"`
+	Title       string      `json:"title"`
+	AuthorIds   []uuid.UUID `json:"author_ids"`
+}
+
+func NewPostWasSubmittedForReview(
+	ID uuid.UUID,
+	SubmittedAt time.Time,
+	Slug string,
+	Title string,
+	AuthorIds []uuid.UUID,
+) PostWasSubmittedForReview {
+	return PostWasSubmittedForReview{
+		ID:          ID,
+		SubmittedAt: SubmittedAt,
+		Slug:        Slug,
+		Title:       Title,
+		AuthorIds:   AuthorIds,
+	}
+}
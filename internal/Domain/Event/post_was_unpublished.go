@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PostWasUnpublished struct {
+	ID        uuid.UUID `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Slug      string    `json:"slug"`
+	AuthorId  uuid.UUID `json:"author_id"`
+}
+
+func NewPostWasUnpublished(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	Slug string,
+	AuthorId uuid.UUID,
+) PostWasUnpublished {
+	return PostWasUnpublished{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		Slug:      Slug,
+		AuthorId:  AuthorId,
+	}
+}
@@ -7,13 +7,16 @@ import (
 )
 
 type PostWasUpdated struct {
-	ID        uuid.UUID `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Slug      string    `json:"slug"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorId  uuid.UUID `json:"author_id"`
+	ID            uuid.UUID   `json:"id"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	Slug          string      `json:"slug"`
+	Title         string      `json:"title"`
+	Content       string      `json:"content"`
+	ContentFormat string      `json:"content_format"`
+	AuthorId      uuid.UUID   `json:"author_id"`
+	AuthorIds     []uuid.UUID `json:"author_ids"`
+	Tags          []string    `json:"tags"`
 }
 
 func NewPostWasUpdated(
@@ -23,15 +26,21 @@ func NewPostWasUpdated(
 	Slug string,
 	Title string,
 	Content string,
+	ContentFormat string,
 	AuthorId uuid.UUID,
+	AuthorIds []uuid.UUID,
+	Tags []string,
 ) PostWasUpdated {
 	return PostWasUpdated{
-		ID:        ID,
-		CreatedAt: CreatedAt,
-		UpdatedAt: UpdatedAt,
-		Slug:      Slug,
-		Title:     Title,
-		Content:   Content,
-		AuthorId:  AuthorId,
+		ID:            ID,
+		CreatedAt:     CreatedAt,
+		UpdatedAt:     UpdatedAt,
+		Slug:          Slug,
+		Title:         Title,
+		Content:       Content,
+		ContentFormat: ContentFormat,
+		AuthorId:      AuthorId,
+		AuthorIds:     AuthorIds,
+		Tags:          Tags,
 	}
 }
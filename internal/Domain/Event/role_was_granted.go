@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleWasGranted struct {
+	UserId    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	GrantedAt time.Time `json:"granted_at"`
+	GrantedBy uuid.UUID `json:"granted_by"`
+}
+
+func NewRoleWasGranted(
+	UserId uuid.UUID,
+	Role string,
+	GrantedAt time.Time,
+	GrantedBy uuid.UUID,
+) RoleWasGranted {
+	return RoleWasGranted{
+		UserId:    UserId,
+		Role:      Role,
+		GrantedAt: GrantedAt,
+		GrantedBy: GrantedBy,
+	}
+}
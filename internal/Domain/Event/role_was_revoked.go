@@ -0,0 +1,28 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleWasRevoked struct {
+	UserId    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	RevokedAt time.Time `json:"revoked_at"`
+	RevokedBy uuid.UUID `json:"revoked_by"`
+}
+
+func NewRoleWasRevoked(
+	UserId uuid.UUID,
+	Role string,
+	RevokedAt time.Time,
+	RevokedBy uuid.UUID,
+) RoleWasRevoked {
+	return RoleWasRevoked{
+		UserId:    UserId,
+		Role:      Role,
+		RevokedAt: RevokedAt,
+		RevokedBy: RevokedBy,
+	}
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeriesWasCreated struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	AuthorId    uuid.UUID `json:"author_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+}
+
+func NewSeriesWasCreated(
+	ID uuid.UUID,
+	CreatedAt time.Time,
+	AuthorId uuid.UUID,
+	Title string,
+	Description string,
+) SeriesWasCreated {
+	return SeriesWasCreated{
+		ID:          ID,
+		CreatedAt:   CreatedAt,
+		AuthorId:    AuthorId,
+		Title:       Title,
+		Description: Description,
+	}
+}
@@ -0,0 +1,25 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeriesWasReordered struct {
+	ID        uuid.UUID   `json:"id"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	PostIds   []uuid.UUID `json:"post_ids"`
+}
+
+func NewSeriesWasReordered(
+	ID uuid.UUID,
+	UpdatedAt time.Time,
+	PostIds []uuid.UUID,
+) SeriesWasReordered {
+	return SeriesWasReordered{
+		ID:        ID,
+		UpdatedAt: UpdatedAt,
+		PostIds:   PostIds,
+	}
+}
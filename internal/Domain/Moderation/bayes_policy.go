@@ -0,0 +1,80 @@
+package moderation
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"math"
+	"regexp"
+	"strings"
+)
+
+var tokenPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+const maxTokenLength = 64
+
+// Tokenize splits content into the distinct lowercase words the classifier is
+// trained on.
+func Tokenize(content string) []string {
+	seen := make(map[string]bool)
+	tokens := make([]string, 0)
+	for _, token := range tokenPattern.FindAllString(strings.ToLower(content), -1) {
+		if len(token) < 2 || len(token) > maxTokenLength || seen[token] {
+			continue
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// BayesPolicy is a naive-Bayes classifier trained on comments that post
+// authors approved or rejected. It stays out of the way (approves) until both
+// labels have at least MinDocuments training documents.
+type BayesPolicy struct {
+	TrainingRepository repository.ModerationTrainingRepository
+	MinDocuments       int64
+	HoldThreshold      float64
+	RejectThreshold    float64
+}
+
+func (p BayesPolicy) Decide(ctx context.Context, comment entity.Comment) (Decision, error) {
+	tokens := Tokenize(comment.Content)
+	data, err := p.TrainingRepository.FindTrainingData(ctx, tokens)
+	if err != nil {
+		return DecisionHold, err
+	}
+
+	if data.Approved.Documents < p.MinDocuments || data.Rejected.Documents < p.MinDocuments {
+		return DecisionApprove, nil
+	}
+
+	spam := SpamProbability(data, tokens)
+	switch {
+	case spam >= p.RejectThreshold:
+		return DecisionReject, nil
+	case spam >= p.HoldThreshold:
+		return DecisionHold, nil
+	}
+	return DecisionApprove, nil
+}
+
+// SpamProbability returns the probability that a document with the given
+// tokens would be rejected, using Laplace smoothing for unseen tokens.
+func SpamProbability(data repository.ModerationTrainingData, tokens []string) float64 {
+	if data.Approved.Documents == 0 || data.Rejected.Documents == 0 {
+		return 0.5
+	}
+
+	documents := float64(data.Approved.Documents + data.Rejected.Documents)
+	vocabulary := float64(max(data.Vocabulary, 1))
+	approved := math.Log(float64(data.Approved.Documents) / documents)
+	rejected := math.Log(float64(data.Rejected.Documents) / documents)
+	for _, token := range tokens {
+		count := data.Tokens[token]
+		approved += math.Log((float64(count.Approved) + 1) / (float64(data.Approved.Tokens) + vocabulary))
+		rejected += math.Log((float64(count.Rejected) + 1) / (float64(data.Rejected.Tokens) + vocabulary))
+	}
+
+	return 1 / (1 + math.Exp(approved-rejected))
+}
@@ -0,0 +1,32 @@
+package moderation
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	"regexp"
+	"strings"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.`)
+
+// HeuristicPolicy rejects comments containing a blocked keyword and holds
+// comments with more than MaxLinks links for manual review.
+type HeuristicPolicy struct {
+	BlockedKeywords []string
+	MaxLinks        int
+}
+
+func (p HeuristicPolicy) Decide(ctx context.Context, comment entity.Comment) (Decision, error) {
+	content := strings.ToLower(comment.Content)
+	for _, keyword := range p.BlockedKeywords {
+		if keyword != "" && strings.Contains(content, strings.ToLower(keyword)) {
+			return DecisionReject, nil
+		}
+	}
+
+	if len(linkPattern.FindAllStringIndex(content, -1)) > p.MaxLinks {
+		return DecisionHold, nil
+	}
+
+	return DecisionApprove, nil
+}
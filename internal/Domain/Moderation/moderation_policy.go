@@ -0,0 +1,61 @@
+package moderation
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	"time"
+)
+
+// Decision is the outcome of moderating a comment. Decisions are ordered from
+// the most to the least permissive.
+type Decision int
+
+const (
+	DecisionApprove Decision = iota
+	DecisionHold
+	DecisionReject
+)
+
+func (d Decision) String() string {
+	switch d {
+	case DecisionApprove:
+		return "approve"
+	case DecisionHold:
+		return "hold"
+	case DecisionReject:
+		return "reject"
+	}
+	return "unknown"
+}
+
+// Apply moves the comment into the status matching the decision.
+func (d Decision) Apply(comment *entity.Comment, at time.Time) {
+	switch d {
+	case DecisionApprove:
+		comment.Approve(at)
+	case DecisionReject:
+		comment.Reject(at)
+	default:
+		comment.Hold(at)
+	}
+}
+
+type ModerationPolicy interface {
+	Decide(ctx context.Context, comment entity.Comment) (Decision, error)
+}
+
+// CombinedPolicy asks every policy and keeps the strictest decision, so a
+// comment is only auto-approved when no policy objects to it.
+type CombinedPolicy []ModerationPolicy
+
+func (p CombinedPolicy) Decide(ctx context.Context, comment entity.Comment) (Decision, error) {
+	decision := DecisionApprove
+	for _, policy := range p {
+		d, err := policy.Decide(ctx, comment)
+		if err != nil {
+			return DecisionHold, err
+		}
+		decision = max(decision, d)
+	}
+	return decision, nil
+}
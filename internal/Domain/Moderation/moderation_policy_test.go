@@ -0,0 +1,127 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type mockModerationTrainingRepository struct {
+	data repository.ModerationTrainingData
+	err  error
+}
+
+func (m *mockModerationTrainingRepository) Train(ctx context.Context, label entity.CommentStatus, tokens []string) error {
+	return nil
+}
+
+func (m *mockModerationTrainingRepository) FindTrainingData(ctx context.Context, tokens []string) (repository.ModerationTrainingData, error) {
+	return m.data, m.err
+}
+
+type fixedPolicy struct {
+	decision Decision
+	err      error
+}
+
+func (p fixedPolicy) Decide(ctx context.Context, comment entity.Comment) (Decision, error) {
+	return p.decision, p.err
+}
+
+type ModerationPolicyTestSuite struct {
+	suite.Suite
+}
+
+func (s *ModerationPolicyTestSuite) TestHeuristicPolicy() {
+	policy := HeuristicPolicy{BlockedKeywords: []string{"Casino"}, MaxLinks: 1}
+
+	tests := []struct {
+		name     string
+		content  string
+		expected Decision
+	}{
+		{name: "Clean", content: "Great write-up, thanks", expected: DecisionApprove},
+		{name: "BlockedKeyword", content: "Visit my CASINO today", expected: DecisionReject},
+		{name: "OneLink", content: "See https://example.com for details", expected: DecisionApprove},
+		{name: "TooManyLinks", content: "https://a.example and www.b.example", expected: DecisionHold},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			decision, err := policy.Decide(context.Background(), entity.Comment{Content: tt.content})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, decision)
+		})
+	}
+}
+
+func (s *ModerationPolicyTestSuite) TestBayesPolicy() {
+	trained := repository.ModerationTrainingData{
+		Approved:   repository.ModerationLabelCount{Documents: 10, Tokens: 40},
+		Rejected:   repository.ModerationLabelCount{Documents: 10, Tokens: 40},
+		Vocabulary: 20,
+		Tokens: map[string]repository.ModerationTokenCount{
+			"cheap":   {Approved: 0, Rejected: 9},
+			"pills":   {Approved: 0, Rejected: 8},
+			"article": {Approved: 9, Rejected: 0},
+			"thanks":  {Approved: 8, Rejected: 1},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		data     repository.ModerationTrainingData
+		content  string
+		expected Decision
+	}{
+		{name: "Ham", data: trained, content: "Thanks for the article", expected: DecisionApprove},
+		{name: "Spam", data: trained, content: "cheap pills cheap", expected: DecisionReject},
+		{name: "Undecided", data: trained, content: "cheap article", expected: DecisionHold},
+		{
+			name:     "NotEnoughTraining",
+			data:     repository.ModerationTrainingData{Approved: repository.ModerationLabelCount{Documents: 10}},
+			content:  "cheap pills",
+			expected: DecisionApprove,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			policy := BayesPolicy{
+				TrainingRepository: &mockModerationTrainingRepository{data: tt.data},
+				MinDocuments:       5,
+				HoldThreshold:      0.4,
+				RejectThreshold:    0.9,
+			}
+			decision, err := policy.Decide(context.Background(), entity.Comment{Content: tt.content})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, decision)
+		})
+	}
+}
+
+func (s *ModerationPolicyTestSuite) TestCombinedPolicy() {
+	decision, err := CombinedPolicy{fixedPolicy{decision: DecisionApprove}, fixedPolicy{decision: DecisionHold}}.Decide(context.Background(), entity.Comment{})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), DecisionHold, decision)
+
+	decision, err = CombinedPolicy{fixedPolicy{decision: DecisionReject}, fixedPolicy{decision: DecisionApprove}}.Decide(context.Background(), entity.Comment{})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), DecisionReject, decision)
+
+	_, err = CombinedPolicy{fixedPolicy{err: errors.New("database error")}}.Decide(context.Background(), entity.Comment{})
+	assert.Error(s.T(), err)
+}
+
+func (s *ModerationPolicyTestSuite) TestTokenize() {
+	assert.Equal(s.T(), []string{"hello", "world", "über"}, Tokenize("Hello, world! hello a ÜBER"))
+}
+
+func TestModerationPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(ModerationPolicyTestSuite))
+}
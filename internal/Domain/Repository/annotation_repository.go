@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type AnnotationRepository interface {
+	Save(ctx context.Context, annotation entity.Annotation) error
+	Update(ctx context.Context, annotation entity.Annotation) error
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Annotation, error)
+	// FindAllByPostId returns the annotations of a post in the order of
+	// their passages. Resolved annotations are left out unless
+	// includeResolved is set.
+	FindAllByPostId(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepository interface {
+	Save(ctx context.Context, category entity.Category) error
+	// Update stores the category and, when it moved, rewrites the paths of
+	// its descendants to follow it. The move is checked again against the
+	// locked rows, so it fails with entity.ErrCategoryCycle when a concurrent
+	// move has put the new parent below the category.
+	Update(ctx context.Context, category entity.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error)
+	// FindAll returns every category, ordered by name.
+	FindAll(ctx context.Context) ([]entity.Category, error)
+	// FindWithAncestors returns the category preceded by its ancestors, root
+	// first.
+	FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error)
+}
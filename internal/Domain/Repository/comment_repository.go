@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type CommentRepository interface {
+	Save(ctx context.Context, comment entity.Comment) error
+	Update(ctx context.Context, comment entity.Comment) error
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error)
+	// Delete removes the comment together with all of its replies.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindThreadsByPostId paginates over the approved top-level comments of a
+	// post. Items holds the top-level comments of the page followed by all of
+	// their approved nested replies, oldest first; Total counts top-level
+	// comments only.
+	FindThreadsByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (PaginatedResult[entity.Comment], error)
+	// FindAllPendingByPostAuthorId paginates over the comments awaiting
+	// moderation on posts written by the given author, oldest first.
+	FindAllPendingByPostAuthorId(ctx context.Context, authorId uuid.UUID, page int, pageSize int) (PaginatedResult[entity.Comment], error)
+}
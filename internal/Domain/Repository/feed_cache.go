@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// CachedFeed is a serialized feed together with the validators used to
+// answer conditional requests.
+type CachedFeed struct {
+	Body         string
+	ETag         string
+	LastModified time.Time
+}
+
+type FeedCache interface {
+	// Fetch returns the feed cached under key. On a miss it calls build and
+	// caches the result, unless the cache was invalidated in the meantime.
+	Fetch(ctx context.Context, key string, build func() (CachedFeed, error)) (CachedFeed, error)
+	// Invalidate drops every cached feed at once, as a post can appear in the
+	// main feed as well as in the feeds of its author and tags.
+	Invalidate(ctx context.Context) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type MediaRepository interface {
+	// SaveWithinQuota stores the media unless the media of its owner would
+	// then take up more than quota bytes, in which case it returns
+	// entity.ErrMediaQuotaExceeded. Concurrent uploads of one owner are
+	// serialized so they cannot overrun the quota together.
+	SaveWithinQuota(ctx context.Context, media entity.Media, quota int64) error
+	// FindByID loads the media with its variants.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Media, error)
+	// SaveVariants stores the dimensions of the media and replaces all of
+	// its variants with media.Variants.
+	SaveVariants(ctx context.Context, media entity.Media) error
+	// UsedBytes returns the total size of the media owned by the user.
+	UsedBytes(ctx context.Context, ownerId uuid.UUID) (int64, error)
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+)
+
+// ModerationLabelCount holds how many documents were trained under a label and
+// how many tokens they contained in total.
+type ModerationLabelCount struct {
+	Documents int64
+	Tokens    int64
+}
+
+// ModerationTokenCount holds in how many approved and rejected documents a
+// token occurred.
+type ModerationTokenCount struct {
+	Approved int64
+	Rejected int64
+}
+
+type ModerationTrainingData struct {
+	Approved   ModerationLabelCount
+	Rejected   ModerationLabelCount
+	Vocabulary int64
+	Tokens     map[string]ModerationTokenCount
+}
+
+type ModerationTrainingRepository interface {
+	// Train records one document with the given tokens under the label. Only
+	// approved and rejected are valid labels.
+	Train(ctx context.Context, label entity.CommentStatus, tokens []string) error
+	// FindTrainingData returns the label totals and the counts of the given tokens.
+	FindTrainingData(ctx context.Context, tokens []string) (ModerationTrainingData, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+// PostCollaboratorRepository stores the collaborators of a post. They are
+// loaded together with the post by the PostRepository.
+type PostCollaboratorRepository interface {
+	// Save adds the collaborator or updates the role of an existing one.
+	Save(ctx context.Context, collaborator entity.PostCollaborator) error
+	Delete(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type PostFilters struct {
+	Slug string
+	// Text is a web search style query (quoted phrases, or, -exclusions)
+	// matched against the title and content.
+	Text   string
+	Author string
+	Status entity.PostStatus
+	// TagsAny matches posts carrying at least one of the tags.
+	TagsAny []string
+	// TagsAll matches posts carrying every one of the tags.
+	TagsAll []string
+	// Category matches posts whose primary category is the one with this
+	// slug or one of its descendants.
+	Category string
+	// AuthorId restricts the result to posts written by the given user.
+	AuthorId uuid.UUID
+	// Scheduled restricts the result to pending posts with a publish_at.
+	Scheduled bool
+	// ReviewQueue restricts the result to posts in review, the ones
+	// submitted first coming first.
+	ReviewQueue bool
+	// ReviewerId restricts the result to posts the user was invited to as
+	// editor or reviewer.
+	ReviewerId uuid.UUID
+	// ViewerId limits unpublished posts to the ones the viewer authored or
+	// collaborates on.
+	// uuid.Nil means an anonymous viewer who only ever sees published posts.
+	ViewerId uuid.UUID
+	// IncludeUnpublished lifts the ViewerId rule and returns posts in every
+	// status. It is meant for internal jobs such as rebuilding the search index.
+	IncludeUnpublished bool
+	// Sort orders the result; the zero value sorts oldest first.
+	Sort PostSort
+	// WithoutContent skips loading the content and its rendering, for
+	// listings that only show summaries.
+	WithoutContent bool
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IndexedPost is the document kept in the post search index.
+type IndexedPost struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	Slug       string
+	Title      string
+	Content    string
+	AuthorId   uuid.UUID
+	AuthorName string
+	Tags       []string
+	Status     string
+}
+
+type PostIndexQuery struct {
+	Text string
+	// Fuzziness is the edit distance allowed between query and indexed
+	// terms. A negative value picks it from the term length.
+	Fuzziness int
+	// Author and Tags are exact filters; a post must carry every tag.
+	Author string
+	Tags   []string
+	// ViewerId lets the viewer find their own unpublished posts too. Unlike
+	// PostFilters.ViewerId it leaves out the posts they collaborate on, as
+	// the index does not hold the collaborators.
+	ViewerId uuid.UUID
+	Page     int
+	PageSize int
+}
+
+type PostIndexHit struct {
+	Post  IndexedPost
+	Score float64
+	// Highlights holds the matching fragments per field with matches
+	// wrapped in <mark> tags.
+	Highlights map[string][]string
+}
+
+type PostIndexFacet struct {
+	Term  string
+	Count int
+}
+
+type PostIndexResult struct {
+	Hits  []PostIndexHit
+	Total int64
+	// Facets counts the matching posts per author and per tag.
+	Facets map[string][]PostIndexFacet
+}
+
+type PostIndexRepository interface {
+	Index(ctx context.Context, post IndexedPost) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	Search(ctx context.Context, query PostIndexQuery) (PostIndexResult, error)
+	// Rebuild replaces the whole index with the posts passed to add by fill.
+	Rebuild(ctx context.Context, fill func(add func(post IndexedPost) error) error) error
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	entity "main/internal/Domain/Entity"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +12,16 @@ type PostRepository interface {
 	Save(ctx context.Context, post entity.Post) error
 	Update(ctx context.Context, post entity.Post) error
 	FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error)
-	FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (PaginatedResult[entity.Post], error)
+	FindBySlug(ctx context.Context, slug string) (entity.Post, error)
+	FindAllBy(ctx context.Context, page int, pageSize int, filters PostFilters) (PaginatedResult[entity.Post], error)
+	// Delete moves the post to the trash. Trashed posts are left out of every
+	// other method and are reached through the PostTrashRepository.
 	Delete(ctx context.Context, id uuid.UUID) error
+	// SaveRenderedContent stores ContentHTML and Toc of the post unless its
+	// content or format changed after it was loaded.
+	SaveRenderedContent(ctx context.Context, post entity.Post) error
+	// ClaimScheduledPosts locks up to limit approved posts whose publish_at is
+	// due and hands them to claim. Rows locked by another caller are skipped.
+	// The schedule is cleared only when claim succeeds.
+	ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type PostRevisionRepository interface {
+	// Save stores the revision under the next free revision number of its post
+	// and returns the number it was given.
+	Save(ctx context.Context, revision entity.PostRevision) (int, error)
+	FindByPostIdAndRevision(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error)
+	FindAllByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (PaginatedResult[entity.PostRevision], error)
+}
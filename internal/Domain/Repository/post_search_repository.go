@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+)
+
+// PostSearchResult is a post matching a full-text search together with its
+// rank and the matching fragments of its title and content, with matches
+// wrapped in <mark> tags.
+type PostSearchResult struct {
+	Post             entity.Post
+	Rank             float64
+	TitleHighlight   string
+	ContentHighlight string
+}
+
+type PostSearchRepository interface {
+	// Search runs a full-text search for filters.Text, which must not be
+	// empty. All other filters apply as in PostRepository.FindAllBy.
+	Search(ctx context.Context, page int, pageSize int, filters PostFilters) (PaginatedResult[PostSearchResult], error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+type PostSort string
+
+const (
+	PostSortOldest PostSort = "oldest"
+	PostSortNewest PostSort = "newest"
+	// PostSortRelevance ranks posts by how well they match the text filter.
+	// Without a text filter it falls back to PostSortOldest.
+	PostSortRelevance PostSort = "relevance"
+	// PostSortRecentlyPublished orders posts by their first publication,
+	// newest first. It backs the feeds and is not offered by the API.
+	PostSortRecentlyPublished PostSort = "recently_published"
+)
+
+func (s PostSort) IsValid() bool {
+	switch s {
+	case PostSortOldest, PostSortNewest, PostSortRelevance:
+		return true
+	}
+	return false
+}
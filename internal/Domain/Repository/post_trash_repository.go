@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entity "main/internal/Domain/Entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrPostNotInTrash = errors.New("post is not in the trash")
+
+// PostTrashRepository reaches the posts moved to the trash by
+// PostRepository.Delete, which every other repository leaves out.
+type PostTrashRepository interface {
+	// FindByID loads a trashed post with its tags and collaborators, or
+	// returns ErrPostNotInTrash.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error)
+	// FindAllByOwnerId paginates over the trashed posts written or co-authored
+	// by the user, most recently trashed first.
+	FindAllByOwnerId(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (PaginatedResult[entity.Post], error)
+	// FindAllDeletedBefore returns up to limit posts trashed before the given
+	// time, oldest first.
+	FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]entity.Post, error)
+	// Restore takes the post out of the trash and sets its updated_at.
+	Restore(ctx context.Context, id uuid.UUID, at time.Time) error
+	// Purge deletes a trashed post for good, together with everything that
+	// belongs to it.
+	Purge(ctx context.Context, id uuid.UUID) error
+}
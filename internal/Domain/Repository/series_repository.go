@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+var ErrSeriesNotFound = errors.New("series not found")
+
+type SeriesRepository interface {
+	Save(ctx context.Context, series entity.Series) error
+	// Update stores the series together with its membership, replacing the
+	// stored positions.
+	Update(ctx context.Context, series entity.Series) error
+	// FindByID loads the series with its posts in order, each with its Post,
+	// trashed posts included.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error)
+	// FindByPostId loads the series the post is part of like FindByID does,
+	// or returns ErrSeriesNotFound.
+	FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrSitemapFileNotFound = errors.New("sitemap file not found")
+
+// SitemapRepository stores the generated sitemap files by name. Save replaces
+// the previous set of files as a whole, so readers never see a sitemap index
+// pointing at files of another generation.
+type SitemapRepository interface {
+	Save(ctx context.Context, files map[string]string) error
+	// Find returns ErrSitemapFileNotFound for unknown names and before the
+	// first Save.
+	Find(ctx context.Context, name string) (string, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+type SlugHistoryRepository interface {
+	// Save records the slug for the post. A slug that was already recorded
+	// for another post is taken over, since only the latest owner can be
+	// redirected to.
+	Save(ctx context.Context, slugHistory entity.SlugHistory) error
+	FindPostIdBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrStorageObjectNotFound = errors.New("storage object not found")
+
+// Storage keeps the files behind media. Keys are slash separated relative
+// paths such as "<owner id>/<media id>.png".
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get returns ErrStorageObjectNotFound for unknown keys.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// URL returns the address clients download the object from.
+	URL(key string) string
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+)
+
+type TagWithPostCount struct {
+	Tag       entity.Tag
+	PostCount int64
+}
+
+type TagRepository interface {
+	// FindOrCreateByNames returns the tags with the given names, creating the
+	// ones that do not exist yet. Names are expected to be normalized.
+	FindOrCreateByNames(ctx context.Context, names []string) ([]entity.Tag, error)
+	// FindAllWithPostCount returns every tag used by at least one published post.
+	FindAllWithPostCount(ctx context.Context) ([]TagWithPostCount, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+
+	"github.com/google/uuid"
+)
+
+// UserRoleRepository stores the roles granted to users. They are loaded
+// together with the user by the UserRepository.
+type UserRoleRepository interface {
+	// Save grants the role, keeping an existing grant as it is.
+	Save(ctx context.Context, userRole entity.UserRole) error
+	Delete(ctx context.Context, userId uuid.UUID, role entity.Role) error
+}
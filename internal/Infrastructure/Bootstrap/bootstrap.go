@@ -1,9 +1,20 @@
 package bootstrap
 
 import (
+	post_query "main/internal/Application/Query/Post"
+	entity "main/internal/Domain/Entity"
+	config "main/internal/Infrastructure/Config"
 	dependency_injection "main/internal/Infrastructure/DependencyInjection"
 	auth "main/internal/UserInterface/Api/Handler/Auth"
+	author "main/internal/UserInterface/Api/Handler/Author"
+	category "main/internal/UserInterface/Api/Handler/Category"
+	comment "main/internal/UserInterface/Api/Handler/Comment"
+	feed "main/internal/UserInterface/Api/Handler/Feed"
+	media "main/internal/UserInterface/Api/Handler/Media"
 	post "main/internal/UserInterface/Api/Handler/Post"
+	series "main/internal/UserInterface/Api/Handler/Series"
+	sitemap "main/internal/UserInterface/Api/Handler/Sitemap"
+	tag "main/internal/UserInterface/Api/Handler/Tag"
 	user "main/internal/UserInterface/Api/Handler/User"
 	middleware "main/internal/UserInterface/Api/Middleware"
 	"os"
@@ -32,6 +43,10 @@ func BootstrapGin(container dependency_injection.Container) *gin.Engine {
 		MaxAge: 12 * time.Hour,
 	}))
 	authGroup := r.Group("/auth")
+	feedGroup := r.Group("/feeds")
+	// publicGroup serves anonymous readers and must only expose published
+	// content; everything else goes through apiGroup.
+	publicGroup := r.Group("/api/v1")
 	apiGroup := r.Group("/api/v1", middleware.RequireAuth())
 
 	{
@@ -54,24 +69,208 @@ func BootstrapGin(container dependency_injection.Container) *gin.Engine {
 	}
 
 	{
-		apiGroup.GET("/posts", func(ctx *gin.Context) {
+		r.GET("/robots.txt", func(ctx *gin.Context) {
+			sitemap.GetRobots(ctx, config.GetSitemapConfig().SitemapURL+"/sitemap.xml")
+		})
+		r.GET("/sitemap.xml", func(ctx *gin.Context) {
+			sitemap.GetSitemap(ctx, container.QueryBus, "sitemap.xml")
+		})
+		r.GET("/sitemaps/:file", func(ctx *gin.Context) {
+			sitemap.GetSitemap(ctx, container.QueryBus, "sitemaps/"+ctx.Param("file"))
+		})
+		r.GET("/media/files/*key", func(ctx *gin.Context) {
+			media.ServeMediaFile(ctx, container.Storage)
+		})
+	}
+
+	{
+		feedFiles := map[string]post_query.FeedFormat{
+			"rss.xml":   post_query.FeedFormatRss,
+			"atom.xml":  post_query.FeedFormatAtom,
+			"feed.json": post_query.FeedFormatJSON,
+		}
+		for file, format := range feedFiles {
+			getFeed := func(ctx *gin.Context) {
+				feed.GetFeed(ctx, container.QueryBus, format)
+			}
+			feedGroup.GET("/"+file, getFeed)
+			feedGroup.GET("/authors/:id/"+file, getFeed)
+			feedGroup.GET("/tags/:tag/"+file, getFeed)
+		}
+	}
+
+	{
+		publicGroup.GET("/posts", func(ctx *gin.Context) {
 			post.ListPosts(ctx, container.QueryBus)
 		})
-		apiGroup.GET("/posts/:id", func(ctx *gin.Context) {
+		publicGroup.GET("/posts/:id", func(ctx *gin.Context) {
 			post.GetPostById(ctx, container.QueryBus)
 		})
-		apiGroup.POST("/posts", func(ctx *gin.Context) {
+		publicGroup.GET("/posts/by-slug/:slug", func(ctx *gin.Context) {
+			post.GetPostBySlug(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/posts/by-slug/:slug/meta", func(ctx *gin.Context) {
+			post.GetPostMeta(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/posts/:id/comments", func(ctx *gin.Context) {
+			comment.ListComments(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/series/:id", func(ctx *gin.Context) {
+			series.GetSeries(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/categories", func(ctx *gin.Context) {
+			category.ListCategories(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/authors/:id", func(ctx *gin.Context) {
+			author.GetAuthor(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/authors/:id/posts", func(ctx *gin.Context) {
+			author.ListAuthorPosts(ctx, container.QueryBus)
+		})
+		publicGroup.GET("/media/:id", func(ctx *gin.Context) {
+			media.GetMedia(ctx, container.QueryBus)
+		})
+	}
+
+	{
+		apiGroup.GET("/posts/search", func(ctx *gin.Context) {
+			post.SearchPosts(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/posts/fuzzy-search", func(ctx *gin.Context) {
+			post.SearchIndexedPosts(ctx, container.QueryBus)
+		})
+		apiGroup.POST("/posts", middleware.RequirePermission(container.QueryBus, entity.PermissionCreatePosts), func(ctx *gin.Context) {
 			post.CreatePost(ctx, container.CommandBus, container.QueryBus)
 		})
 		apiGroup.PUT("/posts/:id", func(ctx *gin.Context) {
 			post.UpdatePost(ctx, container.CommandBus, container.QueryBus)
 		})
 		apiGroup.DELETE("/posts/:id", func(ctx *gin.Context) {
-			post.DeletePost(ctx, container.CommandBus)
+			post.DeletePost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/publish", func(ctx *gin.Context) {
+			post.PublishPost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/unpublish", func(ctx *gin.Context) {
+			post.UnpublishPost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/archive", func(ctx *gin.Context) {
+			post.ArchivePost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/submit-for-review", func(ctx *gin.Context) {
+			post.SubmitPostForReview(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/request-changes", func(ctx *gin.Context) {
+			post.RequestPostChanges(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/approve", func(ctx *gin.Context) {
+			post.ApprovePost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.GET("/posts/:id/revisions", func(ctx *gin.Context) {
+			post.ListPostRevisions(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/posts/:id/revisions/diff", func(ctx *gin.Context) {
+			post.DiffPostRevisions(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/posts/:id/revisions/:revision", func(ctx *gin.Context) {
+			post.GetPostRevision(ctx, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/revisions/:revision/restore", func(ctx *gin.Context) {
+			post.RestorePostRevision(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/collaborators", func(ctx *gin.Context) {
+			post.InvitePostCollaborator(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/posts/:id/collaborators/:userId", func(ctx *gin.Context) {
+			post.RemovePostCollaborator(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.GET("/posts/:id/annotations", func(ctx *gin.Context) {
+			post.ListAnnotations(ctx, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/annotations", func(ctx *gin.Context) {
+			post.CreateAnnotation(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/annotations/:annotationId/resolve", func(ctx *gin.Context) {
+			post.ResolveAnnotation(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/annotations/:annotationId/unresolve", func(ctx *gin.Context) {
+			post.UnresolveAnnotation(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/comments", middleware.RequirePermission(container.QueryBus, entity.PermissionCreateComments), func(ctx *gin.Context) {
+			comment.CreateComment(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.PUT("/posts/:id/comments/:commentId", func(ctx *gin.Context) {
+			comment.UpdateComment(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/posts/:id/comments/:commentId", func(ctx *gin.Context) {
+			comment.DeleteComment(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/comments/:commentId/approve", func(ctx *gin.Context) {
+			comment.ApproveComment(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/posts/:id/comments/:commentId/reject", func(ctx *gin.Context) {
+			comment.RejectComment(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/series", middleware.RequirePermission(container.QueryBus, entity.PermissionCreatePosts), func(ctx *gin.Context) {
+			series.CreateSeries(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/series/:id/posts", func(ctx *gin.Context) {
+			series.AddPostToSeries(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.PUT("/series/:id/posts", func(ctx *gin.Context) {
+			series.ReorderSeriesPosts(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/series/:id/posts/:postId", func(ctx *gin.Context) {
+			series.RemovePostFromSeries(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/categories", func(ctx *gin.Context) {
+			category.CreateCategory(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.PUT("/categories/:id", func(ctx *gin.Context) {
+			category.UpdateCategory(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/categories/:id", func(ctx *gin.Context) {
+			category.DeleteCategory(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.POST("/media", middleware.RequirePermission(container.QueryBus, entity.PermissionCreatePosts), func(ctx *gin.Context) {
+			media.UploadMedia(ctx, container.MediaUploader, container.QueryBus)
+		})
+		apiGroup.GET("/tags", func(ctx *gin.Context) {
+			tag.ListTags(ctx, container.QueryBus)
 		})
 		apiGroup.GET("/users/me", func(ctx *gin.Context) {
 			user.GetMe(ctx, container.QueryBus)
 		})
+		apiGroup.GET("/users/me/posts", func(ctx *gin.Context) {
+			post.ListOwnPosts(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/users/me/posts/:id", func(ctx *gin.Context) {
+			post.GetOwnPost(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/users/me/scheduled-posts", func(ctx *gin.Context) {
+			post.ListScheduledPosts(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/users/me/review-queue", func(ctx *gin.Context) {
+			post.ListReviewQueue(ctx, container.QueryBus)
+		})
+		apiGroup.GET("/users/me/trash", func(ctx *gin.Context) {
+			post.ListTrashedPosts(ctx, container.QueryBus)
+		})
+		apiGroup.POST("/users/me/trash/:id/restore", func(ctx *gin.Context) {
+			post.RestorePost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/users/me/trash/:id", func(ctx *gin.Context) {
+			post.PurgePost(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.GET("/users/me/pending-comments", func(ctx *gin.Context) {
+			comment.ListPendingComments(ctx, container.QueryBus)
+		})
+		apiGroup.POST("/users/:id/roles", middleware.RequirePermission(container.QueryBus, entity.PermissionManageRoles), func(ctx *gin.Context) {
+			user.GrantRole(ctx, container.CommandBus, container.QueryBus)
+		})
+		apiGroup.DELETE("/users/:id/roles/:role", middleware.RequirePermission(container.QueryBus, entity.PermissionManageRoles), func(ctx *gin.Context) {
+			user.RevokeRole(ctx, container.CommandBus, container.QueryBus)
+		})
 	}
 
 	return r
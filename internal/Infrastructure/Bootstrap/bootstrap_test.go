@@ -15,14 +15,77 @@ func TestBootstrapGin(t *testing.T) {
 
 	expectedRoutes := [][]string{
 		{"GET", "/api/v1/posts"},
+		{"GET", "/api/v1/posts/search"},
+		{"GET", "/api/v1/posts/fuzzy-search"},
 		{"GET", "/api/v1/posts/:id"},
+		{"GET", "/api/v1/posts/by-slug/:slug"},
+		{"GET", "/api/v1/posts/by-slug/:slug/meta"},
 		{"PUT", "/api/v1/posts/:id"},
 		{"POST", "/api/v1/posts"},
 		{"DELETE", "/api/v1/posts/:id"},
+		{"POST", "/api/v1/posts/:id/publish"},
+		{"POST", "/api/v1/posts/:id/unpublish"},
+		{"POST", "/api/v1/posts/:id/archive"},
+		{"POST", "/api/v1/posts/:id/submit-for-review"},
+		{"POST", "/api/v1/posts/:id/request-changes"},
+		{"POST", "/api/v1/posts/:id/approve"},
+		{"GET", "/api/v1/posts/:id/revisions"},
+		{"GET", "/api/v1/posts/:id/revisions/diff"},
+		{"GET", "/api/v1/posts/:id/revisions/:revision"},
+		{"POST", "/api/v1/posts/:id/revisions/:revision/restore"},
+		{"POST", "/api/v1/posts/:id/collaborators"},
+		{"DELETE", "/api/v1/posts/:id/collaborators/:userId"},
+		{"GET", "/api/v1/posts/:id/annotations"},
+		{"POST", "/api/v1/posts/:id/annotations"},
+		{"POST", "/api/v1/posts/:id/annotations/:annotationId/resolve"},
+		{"POST", "/api/v1/posts/:id/annotations/:annotationId/unresolve"},
 		{"GET", "/auth/:provider/callback"},
 		{"GET", "/auth/:provider"},
 		{"GET", "/auth/logout/:provider"},
+		{"GET", "/api/v1/posts/:id/comments"},
+		{"POST", "/api/v1/posts/:id/comments"},
+		{"PUT", "/api/v1/posts/:id/comments/:commentId"},
+		{"DELETE", "/api/v1/posts/:id/comments/:commentId"},
+		{"POST", "/api/v1/posts/:id/comments/:commentId/approve"},
+		{"POST", "/api/v1/posts/:id/comments/:commentId/reject"},
+		{"GET", "/api/v1/series/:id"},
+		{"POST", "/api/v1/series"},
+		{"POST", "/api/v1/series/:id/posts"},
+		{"PUT", "/api/v1/series/:id/posts"},
+		{"DELETE", "/api/v1/series/:id/posts/:postId"},
+		{"GET", "/api/v1/categories"},
+		{"POST", "/api/v1/categories"},
+		{"PUT", "/api/v1/categories/:id"},
+		{"DELETE", "/api/v1/categories/:id"},
+		{"GET", "/api/v1/tags"},
+		{"GET", "/api/v1/authors/:id"},
+		{"GET", "/api/v1/authors/:id/posts"},
 		{"GET", "/api/v1/users/me"},
+		{"GET", "/api/v1/users/me/posts"},
+		{"GET", "/api/v1/users/me/posts/:id"},
+		{"GET", "/api/v1/users/me/scheduled-posts"},
+		{"GET", "/api/v1/users/me/review-queue"},
+		{"GET", "/api/v1/users/me/trash"},
+		{"POST", "/api/v1/users/me/trash/:id/restore"},
+		{"DELETE", "/api/v1/users/me/trash/:id"},
+		{"GET", "/api/v1/users/me/pending-comments"},
+		{"POST", "/api/v1/users/:id/roles"},
+		{"DELETE", "/api/v1/users/:id/roles/:role"},
+		{"GET", "/robots.txt"},
+		{"GET", "/sitemap.xml"},
+		{"GET", "/sitemaps/:file"},
+		{"GET", "/media/files/*key"},
+		{"GET", "/api/v1/media/:id"},
+		{"POST", "/api/v1/media"},
+		{"GET", "/feeds/rss.xml"},
+		{"GET", "/feeds/atom.xml"},
+		{"GET", "/feeds/feed.json"},
+		{"GET", "/feeds/authors/:id/rss.xml"},
+		{"GET", "/feeds/authors/:id/atom.xml"},
+		{"GET", "/feeds/authors/:id/feed.json"},
+		{"GET", "/feeds/tags/:tag/rss.xml"},
+		{"GET", "/feeds/tags/:tag/atom.xml"},
+		{"GET", "/feeds/tags/:tag/feed.json"},
 	}
 	for _, route := range r.Routes() {
 		found := false
@@ -0,0 +1,119 @@
+package command_bus
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	"slices"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+)
+
+const (
+	actorMetadataKey          = "actor"
+	actorSignatureMetadataKey = "actor_signature"
+	systemActorValue          = "system"
+)
+
+var (
+	ErrInvalidActorSignature = errors.New("invalid actor signature")
+	ErrSystemActorNotAllowed = errors.New("command may not be sent by the system actor")
+	ErrEmptySigningKey       = errors.New("command signing key is empty")
+)
+
+// ActorSigner carries the acting user of a command across the message broker.
+// The actor is stored in the message metadata along with an HMAC over the
+// actor, the command name, the topic, the message UUID and the payload, so a
+// consumer only trusts actors signed by a holder of the key and an actor
+// cannot be replayed on another command, even one with the same payload.
+type ActorSigner struct {
+	key            []byte
+	systemCommands []string
+}
+
+// NewActorSigner refuses an empty key, with which anybody could sign actors.
+// The system actor, which is allowed everything, is only accepted on the
+// systemCommands, those sent by the jobs of the site.
+func NewActorSigner(key []byte, systemCommands ...string) (ActorSigner, error) {
+	if len(key) == 0 {
+		return ActorSigner{}, ErrEmptySigningKey
+	}
+
+	return ActorSigner{key: key, systemCommands: systemCommands}, nil
+}
+
+// Sign stores the actor of the message context in its metadata. Messages sent
+// without an actor are left unsigned, which Verify rejects.
+func (s ActorSigner) Sign(msg *message.Message, commandName string, topic string) {
+	actor, ok := authorization.ActorFromContext(msg.Context())
+	if !ok {
+		return
+	}
+
+	value := systemActorValue
+	if !actor.System {
+		value = actor.UserId.String()
+	}
+
+	msg.Metadata.Set(actorMetadataKey, value)
+	msg.Metadata.Set(actorSignatureMetadataKey, hex.EncodeToString(s.signature(msg, commandName, topic, value)))
+}
+
+// Verify returns the message context carrying the actor stored in the message
+// metadata. A message without actor fails with authorization.ErrNoActor, a
+// forged or tampered actor with ErrInvalidActorSignature and the system actor
+// on a command outside the systemCommands with ErrSystemActorNotAllowed.
+// commandName and topic are those of the handler receiving the message, not
+// the ones claimed by its metadata.
+func (s ActorSigner) Verify(msg *message.Message, commandName string, topic string) (context.Context, error) {
+	ctx := msg.Context()
+
+	value := msg.Metadata.Get(actorMetadataKey)
+	if value == "" {
+		return ctx, authorization.ErrNoActor
+	}
+
+	signature, err := hex.DecodeString(msg.Metadata.Get(actorSignatureMetadataKey))
+	if err != nil || !hmac.Equal(signature, s.signature(msg, commandName, topic, value)) {
+		return ctx, ErrInvalidActorSignature
+	}
+
+	if value == systemActorValue {
+		if !slices.Contains(s.systemCommands, commandName) {
+			return ctx, ErrSystemActorNotAllowed
+		}
+		return authorization.WithActor(ctx, authorization.SystemActor), nil
+	}
+
+	userId, err := uuid.Parse(value)
+	if err != nil {
+		return ctx, ErrInvalidActorSignature
+	}
+
+	return authorization.WithActor(ctx, authorization.NewUserActor(userId)), nil
+}
+
+// ClaimedActor returns the actor stored in the message metadata without
+// verifying it, e.g. to log who a rejected command claimed to be sent by.
+func ClaimedActor(msg *message.Message) string {
+	return msg.Metadata.Get(actorMetadataKey)
+}
+
+func (s ActorSigner) signature(msg *message.Message, commandName string, topic string, value string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(value))
+	mac.Write([]byte{0})
+	mac.Write([]byte(commandName))
+	mac.Write([]byte{0})
+	mac.Write([]byte(topic))
+	mac.Write([]byte{0})
+	mac.Write([]byte(msg.UUID))
+	mac.Write([]byte{0})
+	mac.Write(msg.Payload)
+
+	return mac.Sum(nil)
+}
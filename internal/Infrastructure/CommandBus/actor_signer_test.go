@@ -0,0 +1,172 @@
+package command_bus
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	restorePostCommand = "RestorePostCommand"
+	restorePostTopic   = "commands.RestorePostCommand"
+	purgePostCommand   = "PurgePostCommand"
+	purgePostTopic     = "commands.PurgePostCommand"
+)
+
+type ActorSignerTestSuite struct {
+	suite.Suite
+	Signer ActorSigner
+}
+
+func (s *ActorSignerTestSuite) SetupTest() {
+	signer, err := NewActorSigner([]byte("secret"), purgePostCommand)
+	s.Require().NoError(err)
+	s.Signer = signer
+}
+
+func (s *ActorSignerTestSuite) newMessage(ctx context.Context) *message.Message {
+	msg := message.NewMessage(watermill.NewUUID(), []byte(`{"Id":"c50e8400-e29b-41d4-a716-446655440000"}`))
+	msg.SetContext(ctx)
+
+	return msg
+}
+
+func (s *ActorSignerTestSuite) TestSignAndVerifyUser() {
+	userId := uuid.New()
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.NewUserActor(userId)))
+
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+	msg.SetContext(context.Background())
+
+	ctx, err := s.Signer.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.NoError(s.T(), err)
+	actor, ok := authorization.ActorFromContext(ctx)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), authorization.NewUserActor(userId), actor)
+}
+
+func (s *ActorSignerTestSuite) TestSignAndVerifySystem() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.SystemActor))
+
+	s.Signer.Sign(msg, purgePostCommand, purgePostTopic)
+	msg.SetContext(context.Background())
+
+	ctx, err := s.Signer.Verify(msg, purgePostCommand, purgePostTopic)
+
+	assert.NoError(s.T(), err)
+	actor, ok := authorization.ActorFromContext(ctx)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), authorization.SystemActor, actor)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyWithoutActor() {
+	msg := s.newMessage(context.Background())
+
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	ctx, err := s.Signer.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, authorization.ErrNoActor)
+	_, ok := authorization.ActorFromContext(ctx)
+	assert.False(s.T(), ok)
+}
+
+func (s *ActorSignerTestSuite) TestVerifySystemActorOnUserCommand() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.SystemActor))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	_, err := s.Signer.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrSystemActorNotAllowed)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyForgedActor() {
+	msg := s.newMessage(context.Background())
+	msg.Metadata.Set(actorMetadataKey, systemActorValue)
+
+	_, err := s.Signer.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyTamperedActor() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.NewUserActor(uuid.New())))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	msg.Metadata.Set(actorMetadataKey, uuid.New().String())
+
+	_, err := s.Signer.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyActorMovedToAnotherCommand() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.SystemActor))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	other := message.NewMessage(watermill.NewUUID(), []byte(`{"Id":"d50e8400-e29b-41d4-a716-446655440000"}`))
+	other.Metadata.Set(actorMetadataKey, msg.Metadata.Get(actorMetadataKey))
+	other.Metadata.Set(actorSignatureMetadataKey, msg.Metadata.Get(actorSignatureMetadataKey))
+
+	_, err := s.Signer.Verify(other, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyActorReplayedAsAnotherCommand() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.NewUserActor(uuid.New())))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	msg.Metadata.Set("name", "PurgePostCommand")
+
+	_, err := s.Signer.Verify(msg, "PurgePostCommand", restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyActorReplayedOnAnotherTopic() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.NewUserActor(uuid.New())))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	_, err := s.Signer.Verify(msg, restorePostCommand, "commands.PurgePostCommand")
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestVerifyWithAnotherKey() {
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.SystemActor))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	other, err := NewActorSigner([]byte("other"))
+	s.Require().NoError(err)
+
+	_, err = other.Verify(msg, restorePostCommand, restorePostTopic)
+
+	assert.ErrorIs(s.T(), err, ErrInvalidActorSignature)
+}
+
+func (s *ActorSignerTestSuite) TestClaimedActor() {
+	userId := uuid.New()
+	msg := s.newMessage(authorization.WithActor(context.Background(), authorization.NewUserActor(userId)))
+	s.Signer.Sign(msg, restorePostCommand, restorePostTopic)
+
+	assert.Equal(s.T(), userId.String(), ClaimedActor(msg))
+	assert.Empty(s.T(), ClaimedActor(s.newMessage(context.Background())))
+}
+
+func (s *ActorSignerTestSuite) TestNewActorSignerWithEmptyKey() {
+	_, err := NewActorSigner(nil)
+
+	assert.ErrorIs(s.T(), err, ErrEmptySigningKey)
+}
+
+func TestActorSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(ActorSignerTestSuite))
+}
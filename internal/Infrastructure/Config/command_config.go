@@ -0,0 +1,16 @@
+package config
+
+import "os"
+
+type CommandConfig struct {
+	// SigningKey signs the acting user carried by commands on the message
+	// broker. Every producer and consumer of the bus must share it. It is a
+	// key of its own, not the session secret, and must not be empty.
+	SigningKey []byte
+}
+
+func GetCommandConfig() *CommandConfig {
+	return &CommandConfig{
+		SigningKey: []byte(os.Getenv("COMMAND_SIGNING_KEY")),
+	}
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+type FeedConfig struct {
+	Title       string
+	Description string
+	SiteURL     string
+	ItemLimit   int
+	CacheTTL    time.Duration
+}
+
+func GetFeedConfig() *FeedConfig {
+	title := os.Getenv("FEED_TITLE")
+	if title == "" {
+		title = "Blog"
+	}
+
+	itemLimit, err := strconv.Atoi(os.Getenv("FEED_ITEM_LIMIT"))
+	if err != nil || itemLimit <= 0 {
+		itemLimit = 20
+	}
+
+	cacheTTL, err := time.ParseDuration(os.Getenv("FEED_CACHE_TTL"))
+	if err != nil || cacheTTL < time.Second {
+		cacheTTL = time.Hour
+	}
+
+	return &FeedConfig{
+		Title:       title,
+		Description: os.Getenv("FEED_DESCRIPTION"),
+		SiteURL:     os.Getenv("CLIENT_URL"),
+		ItemLimit:   itemLimit,
+		CacheTTL:    cacheTTL,
+	}
+}
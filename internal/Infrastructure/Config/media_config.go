@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+const (
+	MediaStorageLocal = "local"
+	MediaStorageS3    = "s3"
+)
+
+type MediaConfig struct {
+	// Storage selects the backend, MediaStorageLocal or MediaStorageS3.
+	Storage   string
+	LocalPath string
+	// LocalURL is where the API server serves files of the local storage.
+	LocalURL    string
+	S3Endpoint  string
+	S3Region    string
+	S3AccessKey string
+	S3SecretKey string
+	S3UseSSL    bool
+	S3Bucket    string
+	S3PublicURL string
+	MaxSize     int64
+	Quota       int64
+}
+
+func GetMediaConfig() *MediaConfig {
+	storage := os.Getenv("MEDIA_STORAGE")
+	if storage != MediaStorageS3 {
+		storage = MediaStorageLocal
+	}
+
+	localPath := os.Getenv("MEDIA_LOCAL_PATH")
+	if localPath == "" {
+		localPath = "data/media"
+	}
+
+	region := os.Getenv("MEDIA_S3_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	maxSize, err := strconv.ParseInt(os.Getenv("MEDIA_MAX_SIZE"), 10, 64)
+	if err != nil || maxSize <= 0 {
+		maxSize = 10 << 20
+	}
+
+	quota, err := strconv.ParseInt(os.Getenv("MEDIA_QUOTA"), 10, 64)
+	if err != nil || quota <= 0 {
+		quota = 100 << 20
+	}
+
+	return &MediaConfig{
+		Storage:     storage,
+		LocalPath:   localPath,
+		LocalURL:    os.Getenv("API_URL") + "/media/files",
+		S3Endpoint:  os.Getenv("MEDIA_S3_ENDPOINT"),
+		S3Region:    region,
+		S3AccessKey: os.Getenv("MEDIA_S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("MEDIA_S3_SECRET_KEY"),
+		S3UseSSL:    os.Getenv("MEDIA_S3_USE_SSL") == "true",
+		S3Bucket:    os.Getenv("MEDIA_S3_BUCKET"),
+		S3PublicURL: os.Getenv("MEDIA_S3_PUBLIC_URL"),
+		MaxSize:     maxSize,
+		Quota:       quota,
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+import "os"
+
+type MetaConfig struct {
+	// SiteURL is where the client serves the pages described by the meta
+	// tags.
+	SiteURL     string
+	SiteName    string
+	TwitterSite string
+}
+
+func GetMetaConfig() *MetaConfig {
+	siteName := os.Getenv("SITE_NAME")
+	if siteName == "" {
+		siteName = "Blog"
+	}
+
+	return &MetaConfig{
+		SiteURL:     os.Getenv("CLIENT_URL"),
+		SiteName:    siteName,
+		TwitterSite: os.Getenv("TWITTER_SITE"),
+	}
+}
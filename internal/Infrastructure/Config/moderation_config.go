@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+type ModerationConfig struct {
+	BlockedKeywords []string
+	MaxLinks        int
+	MinDocuments    int64
+	HoldThreshold   float64
+	RejectThreshold float64
+}
+
+func GetModerationConfig() *ModerationConfig {
+	blockedKeywords := make([]string, 0)
+	for _, keyword := range strings.Split(os.Getenv("MODERATION_BLOCKED_KEYWORDS"), ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			blockedKeywords = append(blockedKeywords, keyword)
+		}
+	}
+
+	maxLinks, err := strconv.Atoi(os.Getenv("MODERATION_MAX_LINKS"))
+	if err != nil || maxLinks < 0 {
+		maxLinks = 2
+	}
+
+	minDocuments, err := strconv.ParseInt(os.Getenv("MODERATION_MIN_DOCUMENTS"), 10, 64)
+	if err != nil || minDocuments <= 0 {
+		minDocuments = 20
+	}
+
+	holdThreshold, err := strconv.ParseFloat(os.Getenv("MODERATION_HOLD_THRESHOLD"), 64)
+	if err != nil || holdThreshold <= 0 || holdThreshold > 1 {
+		holdThreshold = 0.5
+	}
+
+	rejectThreshold, err := strconv.ParseFloat(os.Getenv("MODERATION_REJECT_THRESHOLD"), 64)
+	if err != nil || rejectThreshold < holdThreshold || rejectThreshold > 1 {
+		rejectThreshold = max(0.95, holdThreshold)
+	}
+
+	return &ModerationConfig{
+		BlockedKeywords: blockedKeywords,
+		MaxLinks:        maxLinks,
+		MinDocuments:    minDocuments,
+		HoldThreshold:   holdThreshold,
+		RejectThreshold: rejectThreshold,
+	}
+}
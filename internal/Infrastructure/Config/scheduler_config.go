@@ -0,0 +1,38 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+type SchedulerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	// TrashRetention is how long deleted posts stay in the trash before they
+	// are purged.
+	TrashRetention time.Duration
+}
+
+func GetSchedulerConfig() *SchedulerConfig {
+	interval, err := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL"))
+	if err != nil || interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	batchSize, err := strconv.Atoi(os.Getenv("SCHEDULER_BATCH_SIZE"))
+	if err != nil || batchSize <= 0 {
+		batchSize = 100
+	}
+
+	trashRetentionDays, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err != nil || trashRetentionDays <= 0 {
+		trashRetentionDays = 30
+	}
+
+	return &SchedulerConfig{
+		Interval:       interval,
+		BatchSize:      batchSize,
+		TrashRetention: time.Duration(trashRetentionDays) * 24 * time.Hour,
+	}
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+type SearchIndexConfig struct {
+	Path        string
+	LockTimeout time.Duration
+}
+
+func GetSearchIndexConfig() *SearchIndexConfig {
+	path := os.Getenv("SEARCH_INDEX_PATH")
+	if path == "" {
+		path = "data/search-index"
+	}
+
+	lockTimeout, err := time.ParseDuration(os.Getenv("SEARCH_INDEX_LOCK_TIMEOUT"))
+	if err != nil || lockTimeout <= 0 {
+		lockTimeout = 5 * time.Second
+	}
+
+	return &SearchIndexConfig{
+		Path:        path,
+		LockTimeout: lockTimeout,
+	}
+}
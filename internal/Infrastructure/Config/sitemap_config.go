@@ -0,0 +1,17 @@
+package config
+
+import "os"
+
+type SitemapConfig struct {
+	// SiteURL is where the listed pages live.
+	SiteURL string
+	// SitemapURL is where the sitemap files are served.
+	SitemapURL string
+}
+
+func GetSitemapConfig() *SitemapConfig {
+	return &SitemapConfig{
+		SiteURL:    os.Getenv("CLIENT_URL"),
+		SitemapURL: os.Getenv("API_URL"),
+	}
+}
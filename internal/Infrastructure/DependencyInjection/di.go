@@ -2,17 +2,41 @@ package dependency_injection
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	authorization "main/internal/Application/Authorization"
+	annotation_command "main/internal/Application/Command/Annotation"
+	category_command "main/internal/Application/Command/Category"
+	comment_command "main/internal/Application/Command/Comment"
 	post_command "main/internal/Application/Command/Post"
+	series_command "main/internal/Application/Command/Series"
 	user_command "main/internal/Application/Command/User"
+	comment_event_handler "main/internal/Application/EventHandler/Comment"
+	media_event_handler "main/internal/Application/EventHandler/Media"
+	post_event_handler "main/internal/Application/EventHandler/Post"
+	media "main/internal/Application/Media"
+	annotation_query "main/internal/Application/Query/Annotation"
+	category_query "main/internal/Application/Query/Category"
+	comment_query "main/internal/Application/Query/Comment"
+	media_query "main/internal/Application/Query/Media"
 	post_query "main/internal/Application/Query/Post"
+	series_query "main/internal/Application/Query/Series"
+	sitemap_query "main/internal/Application/Query/Sitemap"
+	tag_query "main/internal/Application/Query/Tag"
 	user_query "main/internal/Application/Query/User"
+	rendering "main/internal/Application/Rendering"
+	search "main/internal/Application/Search"
+	sitemap "main/internal/Application/Sitemap"
+	moderation "main/internal/Domain/Moderation"
 	domain_repository "main/internal/Domain/Repository"
 	infra_amqp "main/internal/Infrastructure/Amqp"
+	command_bus "main/internal/Infrastructure/CommandBus"
 	config "main/internal/Infrastructure/Config"
 	open_telemetry "main/internal/Infrastructure/OpenTelemetry"
 	query_bus "main/internal/Infrastructure/QueryBus"
 	infra_repository "main/internal/Infrastructure/Repository"
+	scheduler "main/internal/Infrastructure/Scheduler"
+	storage "main/internal/Infrastructure/Storage"
 	"net/http"
 	"os"
 	"sync"
@@ -24,6 +48,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/boj/redistore"
 	wotelfloss "github.com/dentech-floss/watermill-opentelemetry-go-extra/pkg/opentelemetry"
+	"github.com/google/uuid"
 	"github.com/markbates/goth/gothic"
 	wotel "github.com/voi-oss/watermill-opentelemetry/pkg/opentelemetry"
 	"gorm.io/driver/postgres"
@@ -41,6 +66,13 @@ type Container struct {
 	CommandProcessor *cqrs.CommandProcessor
 	EventProcessor   *cqrs.EventProcessor
 	SessionStore     *redistore.RediStore
+	Scheduler        *scheduler.Scheduler
+	PostIndexer      search.PostIndexer
+	PostRenderer     rendering.PostRenderer
+	FeedCache        domain_repository.FeedCache
+	SitemapGenerator sitemap.SitemapGenerator
+	MediaUploader    media.MediaUploader
+	Storage          domain_repository.Storage
 }
 
 var lock = sync.Mutex{}
@@ -63,25 +95,55 @@ func GetContainer() *Container {
 		}
 
 		postRepository := infra_repository.NewPostRepository(gormDb)
+		postSearchRepository := infra_repository.NewPostSearchRepository(gormDb)
 		userRepository := infra_repository.NewUserRepository(gormDb)
+		postRevisionRepository := infra_repository.NewPostRevisionRepository(gormDb)
+		slugHistoryRepository := infra_repository.NewSlugHistoryRepository(gormDb)
+		tagRepository := infra_repository.NewTagRepository(gormDb)
+		commentRepository := infra_repository.NewCommentRepository(gormDb)
+		moderationTrainingRepository := infra_repository.NewModerationTrainingRepository(gormDb)
+		mediaRepository := infra_repository.NewMediaRepository(gormDb)
+		seriesRepository := infra_repository.NewSeriesRepository(gormDb)
+		categoryRepository := infra_repository.NewCategoryRepository(gormDb)
+		postCollaboratorRepository := infra_repository.NewPostCollaboratorRepository(gormDb)
+		annotationRepository := infra_repository.NewAnnotationRepository(gormDb)
+		postTrashRepository := infra_repository.NewPostTrashRepository(gormDb)
+		userRoleRepository := infra_repository.NewUserRoleRepository(gormDb)
+		authorizer := authorization.Authorizer{UserRepository: userRepository}
+		mediaStorage := buildStorage()
+		postIndexRepository := buildPostIndexRepository()
+		postIndexer := search.PostIndexer{PostRepository: postRepository, UserRepository: userRepository, PostIndexRepository: postIndexRepository}
+		contentRenderer := rendering.NewContentRenderer()
+		postRenderer := rendering.PostRenderer{PostRepository: postRepository, ContentRenderer: contentRenderer}
+		sessionStore := buildSessionStore()
+		feedCache := buildFeedCache(sessionStore)
+		sitemapRepository := infra_repository.NewSitemapRepository(sessionStore.Pool)
+		sitemapGenerator := buildSitemapGenerator(postRepository, sitemapRepository)
 
 		queryBus := buildQueryBus(telemetry)
-		registerQueryHandlers(queryBus, postRepository, userRepository, telemetry)
+		registerQueryHandlers(queryBus, postRepository, postSearchRepository, postIndexRepository, userRepository, postRevisionRepository, slugHistoryRepository, tagRepository, commentRepository, feedCache, sitemapRepository, sitemapGenerator, mediaRepository, mediaStorage, seriesRepository, categoryRepository, annotationRepository, postTrashRepository, contentRenderer, telemetry)
 
 		logger := buildWatermillLogger()
 		cqrsMarshaller := buildCqrsMarshaller()
+		actorSigner := buildActorSigner(cqrsMarshaller)
 		router := buildRouter(logger)
 		amqpConfig := buildAMQPConfig(os.Getenv("AMQP_URI"))
+		eventsAMQPConfig := buildEventsAMQPConfig(os.Getenv("AMQP_URI"), amqp.GenerateQueueNameTopicName)
 		publisher := buildPublisher(&amqpConfig, logger)
+		eventPublisher := buildPublisher(&eventsAMQPConfig, logger)
 		subscriber := buildSubscriber(&amqpConfig, logger)
 		generateCommandsTopic := buildGenerateCommandsTopicFunc()
 		generateEventsTopic := buildGenerateEventsTopicFunc()
-		commandBus := buildCommandBus(logger, cqrsMarshaller, publisher, generateCommandsTopic)
-		eventBus := buildEventBus(publisher, cqrsMarshaller, logger, generateEventsTopic)
-		commandProcessor := buildCommandProcessor(router, subscriber, cqrsMarshaller, logger, generateCommandsTopic)
-		registerCommandHandlers(commandProcessor, postRepository, userRepository, eventBus)
-		eventProcessor := buildEventProcessor(router, subscriber, cqrsMarshaller, logger, generateEventsTopic)
-		registerEventHandlers(eventProcessor, eventBus)
+		commandBus := buildCommandBus(logger, cqrsMarshaller, publisher, generateCommandsTopic, actorSigner)
+		eventBus := buildEventBus(eventPublisher, cqrsMarshaller, logger, generateEventsTopic)
+		commandProcessor := buildCommandProcessor(router, subscriber, cqrsMarshaller, logger, generateCommandsTopic, actorSigner)
+		moderationPolicy := buildModerationPolicy(moderationTrainingRepository)
+		registerCommandHandlers(commandProcessor, postRepository, userRepository, postRevisionRepository, slugHistoryRepository, tagRepository, commentRepository, seriesRepository, categoryRepository, postCollaboratorRepository, annotationRepository, postTrashRepository, userRoleRepository, authorizer, moderationPolicy, contentRenderer, eventBus)
+		eventProcessor := buildEventProcessor(router, buildEventSubscriberConstructor(os.Getenv("AMQP_URI"), logger), cqrsMarshaller, logger, generateEventsTopic)
+		mediaUploader := buildMediaUploader(mediaRepository, mediaStorage, eventBus)
+		mediaVariantGenerator := media.MediaVariantGenerator{MediaRepository: mediaRepository, Storage: mediaStorage}
+		registerEventHandlers(eventProcessor, eventBus, moderationTrainingRepository, postIndexer, postRenderer, feedCache, sitemapGenerator, mediaVariantGenerator)
+		scheduler := buildScheduler(logger, postRepository, postTrashRepository, commandBus)
 
 		container = &Container{
 			DB:               gormDb,
@@ -92,7 +154,14 @@ func GetContainer() *Container {
 			Router:           router,
 			CommandProcessor: commandProcessor,
 			EventProcessor:   eventProcessor,
-			SessionStore:     buildSessionStore(),
+			SessionStore:     sessionStore,
+			Scheduler:        scheduler,
+			PostIndexer:      postIndexer,
+			PostRenderer:     postRenderer,
+			FeedCache:        feedCache,
+			SitemapGenerator: sitemapGenerator,
+			MediaUploader:    mediaUploader,
+			Storage:          mediaStorage,
 		}
 	}
 	return container
@@ -121,6 +190,23 @@ func buildSessionStore() *redistore.RediStore {
 	return sessionStore
 }
 
+// buildActorSigner accepts the system actor only on the commands of the
+// scheduler jobs and on the sign-up of new users, which has no user to act
+// on behalf of yet.
+func buildActorSigner(cqrsMarshaller *cqrs.JSONMarshaler) command_bus.ActorSigner {
+	actorSigner, err := command_bus.NewActorSigner(
+		config.GetCommandConfig().SigningKey,
+		cqrsMarshaller.Name(post_command.NewPublishPostCommand(uuid.Nil)),
+		cqrsMarshaller.Name(post_command.NewPurgePostCommand(uuid.Nil)),
+		cqrsMarshaller.Name(user_command.CreateUserCommand{}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return actorSigner
+}
+
 func buildGenerateCommandsTopicFunc() func(commandName string) string {
 	return func(commandName string) string {
 		return "commands." + commandName
@@ -168,6 +254,16 @@ func buildAMQPConfig(amqpURL string) amqp.Config {
 	return config
 }
 
+// buildEventsAMQPConfig publishes every event to a fanout exchange named after
+// its topic. Each event handler consumes from its own queue bound to that
+// exchange, so handlers of the same event do not compete for messages.
+func buildEventsAMQPConfig(amqpURL string, generateQueueName amqp.QueueNameGenerator) amqp.Config {
+	config := amqp.NewDurablePubSubConfig(amqpURL, generateQueueName)
+	config.TopologyBuilder = &infra_amqp.MyTopologyBuilder{}
+	config.Consume.NoRequeueOnNack = true
+	return config
+}
+
 func buildPublisher(amqpConfig *amqp.Config, logger watermill.LoggerAdapter) message.Publisher {
 	publisher, err := amqp.NewPublisher(*amqpConfig, logger)
 
@@ -189,11 +285,19 @@ func buildSubscriber(amqpConfig *amqp.Config, logger watermill.LoggerAdapter) me
 	return subscriber
 }
 
+func buildEventSubscriberConstructor(amqpURL string, logger watermill.LoggerAdapter) cqrs.EventProcessorSubscriberConstructorFn {
+	return func(params cqrs.EventProcessorSubscriberConstructorParams) (message.Subscriber, error) {
+		config := buildEventsAMQPConfig(amqpURL, amqp.GenerateQueueNameTopicNameWithSuffix(params.HandlerName))
+		return amqp.NewSubscriber(config, logger)
+	}
+}
+
 func buildCommandBus(
 	logger watermill.LoggerAdapter,
 	cqrsMarshaller *cqrs.JSONMarshaler,
 	publisher message.Publisher,
 	generateCommandsTopic func(commandName string) string,
+	actorSigner command_bus.ActorSigner,
 ) *cqrs.CommandBus {
 	commandBus, err := cqrs.NewCommandBusWithConfig(publisher, cqrs.CommandBusConfig{
 		GeneratePublishTopic: func(params cqrs.CommandBusGeneratePublishTopicParams) (string, error) {
@@ -205,6 +309,7 @@ func buildCommandBus(
 			})
 
 			params.Message.Metadata.Set("sent_at", time.Now().String())
+			actorSigner.Sign(params.Message, params.CommandName, generateCommandsTopic(params.CommandName))
 
 			return nil
 		},
@@ -254,6 +359,7 @@ func buildCommandProcessor(
 	cqrsMarshaller *cqrs.JSONMarshaler,
 	logger watermill.LoggerAdapter,
 	generateCommandsTopic func(commandName string) string,
+	actorSigner command_bus.ActorSigner,
 ) *cqrs.CommandProcessor {
 	commandProcessor, err := cqrs.NewCommandProcessorWithConfig(
 		router,
@@ -267,7 +373,12 @@ func buildCommandProcessor(
 			OnHandle: func(params cqrs.CommandProcessorOnHandleParams) error {
 				start := time.Now()
 
-				err := params.Handler.Handle(params.Message.Context(), params.Command)
+				// The actor is verified before the handler runs, so a producer
+				// without the signing key cannot act on behalf of anybody.
+				ctx, err := actorSigner.Verify(params.Message, params.CommandName, generateCommandsTopic(params.CommandName))
+				if err == nil {
+					err = params.Handler.Handle(ctx, params.Command)
+				}
 
 				logger.Info("Command handled", watermill.LogFields{
 					"command_name": params.CommandName,
@@ -275,6 +386,21 @@ func buildCommandProcessor(
 					"err":          err,
 				})
 
+				// A forged or unauthorized command fails the same way on every
+				// redelivery, so it is acknowledged and dropped instead.
+				if errors.Is(err, command_bus.ErrInvalidActorSignature) ||
+					errors.Is(err, command_bus.ErrSystemActorNotAllowed) ||
+					errors.Is(err, authorization.ErrNoActor) ||
+					errors.Is(err, authorization.ErrPermissionDenied) {
+					logger.Error("Command rejected", err, watermill.LogFields{
+						"command_name": params.CommandName,
+						"actor":        command_bus.ClaimedActor(params.Message),
+						"message_uuid": params.Message.UUID,
+					})
+
+					return nil
+				}
+
 				return err
 			},
 			Marshaler: cqrsMarshaller,
@@ -291,7 +417,7 @@ func buildCommandProcessor(
 
 func buildEventProcessor(
 	router *message.Router,
-	subscriber message.Subscriber,
+	subscriberConstructor cqrs.EventProcessorSubscriberConstructorFn,
 	cqrsMarshaller *cqrs.JSONMarshaler,
 	logger watermill.LoggerAdapter,
 	generateEventsTopic func(eventName string) string,
@@ -302,9 +428,7 @@ func buildEventProcessor(
 			GenerateSubscribeTopic: func(params cqrs.EventProcessorGenerateSubscribeTopicParams) (string, error) {
 				return generateEventsTopic(params.EventName), nil
 			},
-			SubscriberConstructor: func(params cqrs.EventProcessorSubscriberConstructorParams) (message.Subscriber, error) {
-				return subscriber, nil
-			},
+			SubscriberConstructor: subscriberConstructor,
 			OnHandle: func(params cqrs.EventProcessorOnHandleParams) error {
 				start := time.Now()
 
@@ -331,31 +455,292 @@ func buildEventProcessor(
 	return eventProcessor
 }
 
+func buildScheduler(
+	logger watermill.LoggerAdapter,
+	postRepository domain_repository.PostRepository,
+	postTrashRepository domain_repository.PostTrashRepository,
+	commandBus *cqrs.CommandBus,
+) *scheduler.Scheduler {
+	schedulerConfig := config.GetSchedulerConfig()
+
+	return scheduler.NewScheduler(
+		schedulerConfig.Interval,
+		logger,
+		scheduler.PublishScheduledPostsJob{
+			PostRepository: postRepository,
+			CommandBus:     commandBus,
+			BatchSize:      schedulerConfig.BatchSize,
+		},
+		scheduler.PurgeTrashedPostsJob{
+			PostTrashRepository: postTrashRepository,
+			CommandBus:          commandBus,
+			Retention:           schedulerConfig.TrashRetention,
+			BatchSize:           schedulerConfig.BatchSize,
+		},
+	)
+}
+
+func buildFeedCache(sessionStore *redistore.RediStore) domain_repository.FeedCache {
+	feedConfig := config.GetFeedConfig()
+
+	return infra_repository.NewFeedCache(sessionStore.Pool, feedConfig.CacheTTL)
+}
+
+func buildGetPostMetaQueryHandler(
+	postRepository domain_repository.PostRepository,
+	mediaRepository domain_repository.MediaRepository,
+	mediaStorage domain_repository.Storage,
+) post_query.GetPostMetaQueryHandler {
+	metaConfig := config.GetMetaConfig()
+
+	return post_query.GetPostMetaQueryHandler{
+		PostRepository:  postRepository,
+		MediaRepository: mediaRepository,
+		Storage:         mediaStorage,
+		SiteURL:         metaConfig.SiteURL,
+		SiteName:        metaConfig.SiteName,
+		TwitterSite:     metaConfig.TwitterSite,
+	}
+}
+
+func buildGetPostFeedQueryHandler(
+	postRepository domain_repository.PostRepository,
+	userRepository domain_repository.UserRepository,
+	feedCache domain_repository.FeedCache,
+	contentRenderer rendering.ContentRenderer,
+) post_query.GetPostFeedQueryHandler {
+	feedConfig := config.GetFeedConfig()
+
+	return post_query.GetPostFeedQueryHandler{
+		PostRepository:  postRepository,
+		UserRepository:  userRepository,
+		FeedCache:       feedCache,
+		ContentRenderer: contentRenderer,
+		Title:           feedConfig.Title,
+		Description:     feedConfig.Description,
+		SiteURL:         feedConfig.SiteURL,
+		ItemLimit:       feedConfig.ItemLimit,
+	}
+}
+
+func buildSitemapGenerator(postRepository domain_repository.PostRepository, sitemapRepository domain_repository.SitemapRepository) sitemap.SitemapGenerator {
+	sitemapConfig := config.GetSitemapConfig()
+
+	return sitemap.SitemapGenerator{
+		PostRepository:    postRepository,
+		SitemapRepository: sitemapRepository,
+		SiteURL:           sitemapConfig.SiteURL,
+		SitemapURL:        sitemapConfig.SitemapURL,
+	}
+}
+
+func buildStorage() domain_repository.Storage {
+	mediaConfig := config.GetMediaConfig()
+
+	if mediaConfig.Storage == config.MediaStorageS3 {
+		s3Storage, err := storage.NewS3Storage(
+			mediaConfig.S3Endpoint,
+			mediaConfig.S3Region,
+			mediaConfig.S3AccessKey,
+			mediaConfig.S3SecretKey,
+			mediaConfig.S3UseSSL,
+			mediaConfig.S3Bucket,
+			mediaConfig.S3PublicURL,
+		)
+		if err != nil {
+			panic(err)
+		}
+		return s3Storage
+	}
+
+	return storage.NewLocalStorage(mediaConfig.LocalPath, mediaConfig.LocalURL)
+}
+
+func buildMediaUploader(mediaRepository domain_repository.MediaRepository, mediaStorage domain_repository.Storage, eventBus *cqrs.EventBus) media.MediaUploader {
+	mediaConfig := config.GetMediaConfig()
+
+	return media.MediaUploader{
+		MediaRepository: mediaRepository,
+		Storage:         mediaStorage,
+		EventBus:        eventBus,
+		MaxSize:         mediaConfig.MaxSize,
+		Quota:           mediaConfig.Quota,
+	}
+}
+
+func buildPostIndexRepository() domain_repository.PostIndexRepository {
+	searchIndexConfig := config.GetSearchIndexConfig()
+
+	return infra_repository.NewPostIndexRepository(searchIndexConfig.Path, searchIndexConfig.LockTimeout)
+}
+
+func buildModerationPolicy(moderationTrainingRepository domain_repository.ModerationTrainingRepository) moderation.ModerationPolicy {
+	moderationConfig := config.GetModerationConfig()
+
+	return moderation.CombinedPolicy{
+		moderation.HeuristicPolicy{
+			BlockedKeywords: moderationConfig.BlockedKeywords,
+			MaxLinks:        moderationConfig.MaxLinks,
+		},
+		moderation.BayesPolicy{
+			TrainingRepository: moderationTrainingRepository,
+			MinDocuments:       moderationConfig.MinDocuments,
+			HoldThreshold:      moderationConfig.HoldThreshold,
+			RejectThreshold:    moderationConfig.RejectThreshold,
+		},
+	}
+}
+
 func registerQueryHandlers(
 	queryBus query_bus.QueryBus,
 	postRepository domain_repository.PostRepository,
+	postSearchRepository domain_repository.PostSearchRepository,
+	postIndexRepository domain_repository.PostIndexRepository,
 	userRepository domain_repository.UserRepository,
+	postRevisionRepository domain_repository.PostRevisionRepository,
+	slugHistoryRepository domain_repository.SlugHistoryRepository,
+	tagRepository domain_repository.TagRepository,
+	commentRepository domain_repository.CommentRepository,
+	feedCache domain_repository.FeedCache,
+	sitemapRepository domain_repository.SitemapRepository,
+	sitemapGenerator sitemap.SitemapGenerator,
+	mediaRepository domain_repository.MediaRepository,
+	mediaStorage domain_repository.Storage,
+	seriesRepository domain_repository.SeriesRepository,
+	categoryRepository domain_repository.CategoryRepository,
+	annotationRepository domain_repository.AnnotationRepository,
+	postTrashRepository domain_repository.PostTrashRepository,
+	contentRenderer rendering.ContentRenderer,
 	telemetry open_telemetry.TelemetryProvider,
 ) {
-	queryBus.RegisterHandler(post_query.GetPostQueryHandler{PostRepository: postRepository})
+	queryBus.RegisterHandler(post_query.GetPostQueryHandler{PostRepository: postRepository, SeriesRepository: seriesRepository, CategoryRepository: categoryRepository})
+	queryBus.RegisterHandler(post_query.GetPostBySlugQueryHandler{PostRepository: postRepository, SlugHistoryRepository: slugHistoryRepository, CategoryRepository: categoryRepository})
 	queryBus.RegisterHandler(post_query.FindAllByQueryHandler{PostRepository: postRepository})
+	queryBus.RegisterHandler(post_query.FindScheduledPostsQueryHandler{PostRepository: postRepository})
+	queryBus.RegisterHandler(post_query.FindReviewQueueQueryHandler{PostRepository: postRepository})
+	queryBus.RegisterHandler(post_query.ListTrashedPostsQueryHandler{PostTrashRepository: postTrashRepository})
+	queryBus.RegisterHandler(post_query.GetTrashedPostQueryHandler{PostTrashRepository: postTrashRepository})
+	queryBus.RegisterHandler(post_query.FindPostsByAuthorQueryHandler{PostRepository: postRepository})
+	queryBus.RegisterHandler(post_query.FullTextSearchQueryHandler{PostSearchRepository: postSearchRepository})
+	queryBus.RegisterHandler(post_query.SearchPostsQueryHandler{PostIndexRepository: postIndexRepository})
+	queryBus.RegisterHandler(post_query.ListPostRevisionsQueryHandler{PostRevisionRepository: postRevisionRepository})
+	queryBus.RegisterHandler(post_query.GetPostRevisionQueryHandler{PostRevisionRepository: postRevisionRepository})
+	queryBus.RegisterHandler(post_query.DiffPostRevisionsQueryHandler{PostRevisionRepository: postRevisionRepository})
+	queryBus.RegisterHandler(buildGetPostFeedQueryHandler(postRepository, userRepository, feedCache, contentRenderer))
+	queryBus.RegisterHandler(buildGetPostMetaQueryHandler(postRepository, mediaRepository, mediaStorage))
+	queryBus.RegisterHandler(sitemap_query.GetSitemapQueryHandler{SitemapRepository: sitemapRepository, SitemapGenerator: sitemapGenerator})
+	queryBus.RegisterHandler(media_query.GetMediaQueryHandler{MediaRepository: mediaRepository, Storage: mediaStorage})
+	queryBus.RegisterHandler(series_query.GetSeriesQueryHandler{SeriesRepository: seriesRepository})
+	queryBus.RegisterHandler(category_query.ListCategoriesQueryHandler{CategoryRepository: categoryRepository})
+	queryBus.RegisterHandler(category_query.GetCategoryQueryHandler{CategoryRepository: categoryRepository})
+	queryBus.RegisterHandler(tag_query.ListTagsQueryHandler{TagRepository: tagRepository})
+	queryBus.RegisterHandler(annotation_query.GetAnnotationQueryHandler{AnnotationRepository: annotationRepository})
+	queryBus.RegisterHandler(annotation_query.ListOpenAnnotationsQueryHandler{AnnotationRepository: annotationRepository})
+	queryBus.RegisterHandler(comment_query.GetCommentQueryHandler{CommentRepository: commentRepository})
+	queryBus.RegisterHandler(comment_query.ListCommentsByPostQueryHandler{CommentRepository: commentRepository})
+	queryBus.RegisterHandler(comment_query.ListPendingCommentsQueryHandler{CommentRepository: commentRepository})
 	queryBus.RegisterHandler(user_query.FindUserByQueryHandler{UserRepository: userRepository, Telemetry: telemetry})
+	queryBus.RegisterHandler(user_query.GetAuthorQueryHandler{UserRepository: userRepository})
+	queryBus.RegisterHandler(user_query.GetUserQueryHandler{UserRepository: userRepository})
 }
 
 func registerCommandHandlers(
 	commandProcessor *cqrs.CommandProcessor,
 	postRepository domain_repository.PostRepository,
 	userRepository domain_repository.UserRepository,
+	postRevisionRepository domain_repository.PostRevisionRepository,
+	slugHistoryRepository domain_repository.SlugHistoryRepository,
+	tagRepository domain_repository.TagRepository,
+	commentRepository domain_repository.CommentRepository,
+	seriesRepository domain_repository.SeriesRepository,
+	categoryRepository domain_repository.CategoryRepository,
+	postCollaboratorRepository domain_repository.PostCollaboratorRepository,
+	annotationRepository domain_repository.AnnotationRepository,
+	postTrashRepository domain_repository.PostTrashRepository,
+	userRoleRepository domain_repository.UserRoleRepository,
+	authorizer authorization.Authorizer,
+	moderationPolicy moderation.ModerationPolicy,
+	contentRenderer rendering.ContentRenderer,
 	eventBus *cqrs.EventBus,
 ) {
 	commandProcessor.AddHandlers(
-		cqrs.NewCommandHandler("CreatePostCommandHandler", post_command.CreatePostCommandHandler{PostRepository: postRepository, EventBus: eventBus}.Handle),
-		cqrs.NewCommandHandler("UpdatePostCommandHandler", post_command.UpdatePostCommandHandler{PostRepository: postRepository, EventBus: eventBus}.Handle),
-		cqrs.NewCommandHandler("DeletePostCommandHandler", post_command.DeletePostCommandHandler{PostRepository: postRepository, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("CreatePostCommandHandler", post_command.CreatePostCommandHandler{PostRepository: postRepository, PostRevisionRepository: postRevisionRepository, TagRepository: tagRepository, ContentRenderer: contentRenderer, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("UpdatePostCommandHandler", post_command.UpdatePostCommandHandler{PostRepository: postRepository, PostRevisionRepository: postRevisionRepository, TagRepository: tagRepository, SlugHistoryRepository: slugHistoryRepository, AnnotationRepository: annotationRepository, ContentRenderer: contentRenderer, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("DeletePostCommandHandler", post_command.DeletePostCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RestorePostCommandHandler", post_command.RestorePostCommandHandler{PostTrashRepository: postTrashRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("PurgePostCommandHandler", post_command.PurgePostCommandHandler{PostTrashRepository: postTrashRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("PublishPostCommandHandler", post_command.PublishPostCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("InvitePostCollaboratorCommandHandler", post_command.InvitePostCollaboratorCommandHandler{PostRepository: postRepository, PostCollaboratorRepository: postCollaboratorRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RemovePostCollaboratorCommandHandler", post_command.RemovePostCollaboratorCommandHandler{PostRepository: postRepository, PostCollaboratorRepository: postCollaboratorRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("UnpublishPostCommandHandler", post_command.UnpublishPostCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("ArchivePostCommandHandler", post_command.ArchivePostCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("SubmitPostForReviewCommandHandler", post_command.SubmitPostForReviewCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RequestPostChangesCommandHandler", post_command.RequestPostChangesCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("ApprovePostCommandHandler", post_command.ApprovePostCommandHandler{PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RestorePostRevisionCommandHandler", post_command.RestorePostRevisionCommandHandler{PostRepository: postRepository, PostRevisionRepository: postRevisionRepository, SlugHistoryRepository: slugHistoryRepository, AnnotationRepository: annotationRepository, ContentRenderer: contentRenderer, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("CreateAnnotationCommandHandler", annotation_command.CreateAnnotationCommandHandler{PostRepository: postRepository, AnnotationRepository: annotationRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("ResolveAnnotationCommandHandler", annotation_command.ResolveAnnotationCommandHandler{PostRepository: postRepository, AnnotationRepository: annotationRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("UnresolveAnnotationCommandHandler", annotation_command.UnresolveAnnotationCommandHandler{PostRepository: postRepository, AnnotationRepository: annotationRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("CreateCommentCommandHandler", comment_command.CreateCommentCommandHandler{CommentRepository: commentRepository, ModerationPolicy: moderationPolicy, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("EditCommentCommandHandler", comment_command.EditCommentCommandHandler{CommentRepository: commentRepository, ModerationPolicy: moderationPolicy, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("DeleteCommentCommandHandler", comment_command.DeleteCommentCommandHandler{CommentRepository: commentRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("ApproveCommentCommandHandler", comment_command.ApproveCommentCommandHandler{CommentRepository: commentRepository, PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RejectCommentCommandHandler", comment_command.RejectCommentCommandHandler{CommentRepository: commentRepository, PostRepository: postRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("CreateSeriesCommandHandler", series_command.CreateSeriesCommandHandler{SeriesRepository: seriesRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("AddPostToSeriesCommandHandler", series_command.AddPostToSeriesCommandHandler{SeriesRepository: seriesRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RemovePostFromSeriesCommandHandler", series_command.RemovePostFromSeriesCommandHandler{SeriesRepository: seriesRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("ReorderSeriesPostsCommandHandler", series_command.ReorderSeriesPostsCommandHandler{SeriesRepository: seriesRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("CreateCategoryCommandHandler", category_command.CreateCategoryCommandHandler{CategoryRepository: categoryRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("UpdateCategoryCommandHandler", category_command.UpdateCategoryCommandHandler{CategoryRepository: categoryRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("DeleteCategoryCommandHandler", category_command.DeleteCategoryCommandHandler{CategoryRepository: categoryRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
 		cqrs.NewCommandHandler("CreateUserCommandHandler", user_command.CreateUserCommandHandler{UserRepository: userRepository, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("GrantRoleCommandHandler", user_command.GrantRoleCommandHandler{UserRepository: userRepository, UserRoleRepository: userRoleRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
+		cqrs.NewCommandHandler("RevokeRoleCommandHandler", user_command.RevokeRoleCommandHandler{UserRepository: userRepository, UserRoleRepository: userRoleRepository, Authorizer: authorizer, EventBus: eventBus}.Handle),
 	)
 }
 
-func registerEventHandlers(eventProcessor *cqrs.EventProcessor, eventBus *cqrs.EventBus) {
-	eventProcessor.AddHandlers()
+func registerEventHandlers(
+	eventProcessor *cqrs.EventProcessor,
+	eventBus *cqrs.EventBus,
+	moderationTrainingRepository domain_repository.ModerationTrainingRepository,
+	postIndexer search.PostIndexer,
+	postRenderer rendering.PostRenderer,
+	feedCache domain_repository.FeedCache,
+	sitemapGenerator sitemap.SitemapGenerator,
+	mediaVariantGenerator media.MediaVariantGenerator,
+) {
+	trainModerationEventHandler := comment_event_handler.TrainModerationEventHandler{ModerationTrainingRepository: moderationTrainingRepository}
+	indexPostEventHandler := post_event_handler.IndexPostEventHandler{PostIndexer: postIndexer}
+	renderPostEventHandler := post_event_handler.RenderPostEventHandler{PostRenderer: postRenderer}
+	invalidateFeedsEventHandler := post_event_handler.InvalidateFeedsEventHandler{FeedCache: feedCache}
+	generateSitemapEventHandler := post_event_handler.GenerateSitemapEventHandler{SitemapGenerator: sitemapGenerator}
+	generateMediaVariantsEventHandler := media_event_handler.GenerateMediaVariantsEventHandler{MediaVariantGenerator: mediaVariantGenerator}
+
+	eventProcessor.AddHandlers(
+		cqrs.NewEventHandler("TrainModerationOnCommentWasApproved", trainModerationEventHandler.HandleCommentWasApproved),
+		cqrs.NewEventHandler("TrainModerationOnCommentWasRejected", trainModerationEventHandler.HandleCommentWasRejected),
+		cqrs.NewEventHandler("IndexPostOnPostWasCreated", indexPostEventHandler.HandlePostWasCreated),
+		cqrs.NewEventHandler("IndexPostOnPostWasUpdated", indexPostEventHandler.HandlePostWasUpdated),
+		cqrs.NewEventHandler("IndexPostOnPostWasPublished", indexPostEventHandler.HandlePostWasPublished),
+		cqrs.NewEventHandler("IndexPostOnPostWasUnpublished", indexPostEventHandler.HandlePostWasUnpublished),
+		cqrs.NewEventHandler("IndexPostOnPostWasArchived", indexPostEventHandler.HandlePostWasArchived),
+		cqrs.NewEventHandler("IndexPostOnPostWasDeleted", indexPostEventHandler.HandlePostWasDeleted),
+		cqrs.NewEventHandler("IndexPostOnPostWasRestored", indexPostEventHandler.HandlePostWasRestored),
+		cqrs.NewEventHandler("RenderPostOnPostWasCreated", renderPostEventHandler.HandlePostWasCreated),
+		cqrs.NewEventHandler("RenderPostOnPostWasUpdated", renderPostEventHandler.HandlePostWasUpdated),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasUpdated", invalidateFeedsEventHandler.HandlePostWasUpdated),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasPublished", invalidateFeedsEventHandler.HandlePostWasPublished),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasUnpublished", invalidateFeedsEventHandler.HandlePostWasUnpublished),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasArchived", invalidateFeedsEventHandler.HandlePostWasArchived),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasDeleted", invalidateFeedsEventHandler.HandlePostWasDeleted),
+		cqrs.NewEventHandler("InvalidateFeedsOnPostWasRestored", invalidateFeedsEventHandler.HandlePostWasRestored),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasCreated", generateSitemapEventHandler.HandlePostWasCreated),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasUpdated", generateSitemapEventHandler.HandlePostWasUpdated),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasPublished", generateSitemapEventHandler.HandlePostWasPublished),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasUnpublished", generateSitemapEventHandler.HandlePostWasUnpublished),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasArchived", generateSitemapEventHandler.HandlePostWasArchived),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasDeleted", generateSitemapEventHandler.HandlePostWasDeleted),
+		cqrs.NewEventHandler("GenerateSitemapOnPostWasRestored", generateSitemapEventHandler.HandlePostWasRestored),
+		cqrs.NewEventHandler("GenerateMediaVariantsOnMediaWasUploaded", generateMediaVariantsEventHandler.HandleMediaWasUploaded),
+	)
 }
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type annotationRepository struct {
+	db *gorm.DB
+}
+
+func (a annotationRepository) Save(ctx context.Context, annotation entity.Annotation) error {
+	return a.db.WithContext(ctx).Create(&annotation).Error
+}
+
+func (a annotationRepository) Update(ctx context.Context, annotation entity.Annotation) error {
+	return a.db.WithContext(ctx).Model(&annotation).Where("id = ?", annotation.ID).Updates(map[string]interface{}{
+		"start_offset": annotation.Start,
+		"end_offset":   annotation.End,
+		"detached":     annotation.Detached,
+		"resolved_at":  annotation.ResolvedAt,
+		"resolved_by":  annotation.ResolvedBy,
+		"updated_at":   annotation.UpdatedAt,
+	}).Error
+}
+
+func (a annotationRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Annotation, error) {
+	return gorm.G[entity.Annotation](a.db).Where("id = ?", id).First(ctx)
+}
+
+func (a annotationRepository) FindAllByPostId(ctx context.Context, postId uuid.UUID, includeResolved bool) ([]entity.Annotation, error) {
+	annotations := make([]entity.Annotation, 0)
+	tx := a.db.WithContext(ctx).Where("post_id = ?", postId)
+	if !includeResolved {
+		tx = tx.Where("resolved_at IS NULL")
+	}
+	err := tx.Order("start_offset, created_at, id").Find(&annotations).Error
+	return annotations, err
+}
+
+func NewAnnotationRepository(db *gorm.DB) repository.AnnotationRepository {
+	return &annotationRepository{db: db}
+}
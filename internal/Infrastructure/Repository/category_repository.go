@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func (c categoryRepository) Save(ctx context.Context, category entity.Category) error {
+	return c.db.WithContext(ctx).Create(&category).Error
+}
+
+func (c categoryRepository) Update(ctx context.Context, category entity.Category) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The category and its new parent are locked in a fixed order and the
+		// move is checked again against their committed paths. Two moves that
+		// passed the check in the handler at the same time, e.g. A below B and
+		// B below A, would otherwise leave a cycle.
+		ids := []uuid.UUID{category.ID}
+		if category.ParentId != nil {
+			if *category.ParentId == category.ID {
+				return entity.ErrCategoryCycle
+			}
+			ids = append(ids, *category.ParentId)
+		}
+
+		var locked []entity.Category
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).Where("id IN ?", ids).Order("id").Find(&locked).Error
+		if err != nil {
+			return err
+		}
+		if len(locked) != len(ids) {
+			return gorm.ErrRecordNotFound
+		}
+
+		var stored entity.Category
+		var parent *entity.Category
+		for i := range locked {
+			if locked[i].ID == category.ID {
+				stored = locked[i]
+			} else {
+				parent = &locked[i]
+			}
+		}
+
+		category.Path = stored.Path
+		if err := category.MoveTo(parent, category.UpdatedAt); err != nil {
+			return err
+		}
+
+		err = tx.Model(&category).Where("id = ?", category.ID).Updates(map[string]interface{}{
+			"parent_id":  category.ParentId,
+			"name":       category.Name,
+			"slug":       category.Slug,
+			"path":       category.Path,
+			"updated_at": category.UpdatedAt,
+		}).Error
+		if err != nil || stored.Path == category.Path {
+			return err
+		}
+
+		return tx.Exec(
+			"UPDATE categories SET path = ? || substr(path, ?) WHERE path LIKE ? AND id <> ?",
+			category.Path, len(stored.Path)+1, stored.Path+"%", category.ID,
+		).Error
+	})
+}
+
+func (c categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// Posts of the category lose it through the ON DELETE SET NULL on
+	// posts.category_id; subcategories keep it from being deleted.
+	return c.db.WithContext(ctx).Delete(&entity.Category{}, id).Error
+}
+
+func (c categoryRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Category, error) {
+	return gorm.G[entity.Category](c.db).Where("id = ?", id).First(ctx)
+}
+
+func (c categoryRepository) FindAll(ctx context.Context) ([]entity.Category, error) {
+	return gorm.G[entity.Category](c.db).Order("name").Order("id").Find(ctx)
+}
+
+func (c categoryRepository) FindWithAncestors(ctx context.Context, id uuid.UUID) ([]entity.Category, error) {
+	category, err := c.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := gorm.G[entity.Category](c.db).Where("id IN ?", category.AncestorIds()).Order("length(path)").Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ancestors, category), nil
+}
+
+func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
+	return &categoryRepository{db: db}
+}
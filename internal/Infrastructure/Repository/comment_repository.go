@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+func (c commentRepository) Save(ctx context.Context, comment entity.Comment) error {
+	return c.db.WithContext(ctx).Create(&comment).Error
+}
+
+func (c commentRepository) Update(ctx context.Context, comment entity.Comment) error {
+	return c.db.WithContext(ctx).Model(&comment).Where("id = ?", comment.ID).Updates(map[string]interface{}{
+		"content":    comment.Content,
+		"status":     comment.Status,
+		"updated_at": comment.UpdatedAt,
+	}).Error
+}
+
+func (c commentRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+	return gorm.G[entity.Comment](c.db).Where("id = ?", id).First(ctx)
+}
+
+func (c commentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	// Replies are removed by the ON DELETE CASCADE on comments.parent_id.
+	return c.db.WithContext(ctx).Delete(&entity.Comment{}, id).Error
+}
+
+func (c commentRepository) FindThreadsByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	var total int64
+	err := c.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Where("post_id = ? AND parent_id IS NULL AND status = ?", postId, entity.CommentStatusApproved).
+		Count(&total).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Comment]{}, err
+	}
+
+	comments := make([]entity.Comment, 0)
+	err = c.db.WithContext(ctx).Raw(`
+		WITH RECURSIVE roots AS (
+			SELECT * FROM comments
+			WHERE post_id = ? AND parent_id IS NULL AND status = ?
+			ORDER BY created_at, id
+			LIMIT ? OFFSET ?
+		), thread AS (
+			SELECT * FROM roots
+			UNION ALL
+			SELECT comments.* FROM comments JOIN thread ON comments.parent_id = thread.id
+			WHERE comments.status = ?
+		)
+		SELECT * FROM thread ORDER BY created_at, id
+	`, postId, entity.CommentStatusApproved, pageSize, (page-1)*pageSize, entity.CommentStatusApproved).Scan(&comments).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Comment]{}, err
+	}
+
+	return repository.PaginatedResult[entity.Comment]{Items: comments, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+func (c commentRepository) FindAllPendingByPostAuthorId(ctx context.Context, authorId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Comment], error) {
+	var total int64
+	tx := c.db.WithContext(ctx).
+		Model(&entity.Comment{}).
+		Joins("JOIN posts ON posts.id = comments.post_id AND posts.author_id = ? AND posts.deleted_at IS NULL", authorId).
+		Where("comments.status = ?", entity.CommentStatusPending)
+	err := tx.Count(&total).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Comment]{}, err
+	}
+
+	comments := make([]entity.Comment, 0)
+	err = tx.Order("comments.created_at, comments.id").Offset((page - 1) * pageSize).Limit(pageSize).Find(&comments).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Comment]{}, err
+	}
+
+	return repository.PaginatedResult[entity.Comment]{Items: comments, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+func NewCommentRepository(db *gorm.DB) repository.CommentRepository {
+	return &commentRepository{db: db}
+}
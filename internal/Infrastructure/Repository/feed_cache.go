@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const feedCacheGenerationKey = "feeds:generation"
+
+// feedCache keeps feeds in Redis hashes whose keys start with a generation
+// number. Invalidating bumps the generation, so older entries are never read
+// again and simply expire after ttl. A feed is stored under the generation
+// read before it was built, which keeps a feed built from data older than an
+// invalidation out of the current generation.
+type feedCache struct {
+	pool *redis.Pool
+	ttl  time.Duration
+}
+
+func (c feedCache) Fetch(ctx context.Context, key string, build func() (repository.CachedFeed, error)) (repository.CachedFeed, error) {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+	defer conn.Close()
+
+	generation, err := redis.Int64(conn.Do("GET", feedCacheGenerationKey))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return repository.CachedFeed{}, err
+	}
+	entryKey := fmt.Sprintf("feeds:%d:%s", generation, key)
+
+	values, err := redis.StringMap(conn.Do("HGETALL", entryKey))
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+	if len(values) > 0 {
+		lastModified, err := time.Parse(time.RFC3339Nano, values["last_modified"])
+		if err == nil {
+			return repository.CachedFeed{Body: values["body"], ETag: values["etag"], LastModified: lastModified}, nil
+		}
+	}
+
+	feed, err := build()
+	if err != nil {
+		return repository.CachedFeed{}, err
+	}
+
+	conn.Send("MULTI")
+	conn.Send("HSET", entryKey, "body", feed.Body, "etag", feed.ETag, "last_modified", feed.LastModified.Format(time.RFC3339Nano))
+	conn.Send("EXPIRE", entryKey, int(c.ttl/time.Second))
+	if _, err := conn.Do("EXEC"); err != nil {
+		return repository.CachedFeed{}, err
+	}
+
+	return feed, nil
+}
+
+func (c feedCache) Invalidate(ctx context.Context) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("INCR", feedCacheGenerationKey)
+	return err
+}
+
+func NewFeedCache(pool *redis.Pool, ttl time.Duration) repository.FeedCache {
+	return feedCache{pool: pool, ttl: ttl}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type mediaRepository struct {
+	db *gorm.DB
+}
+
+// SaveWithinQuota takes a transaction scoped advisory lock on the owner, so
+// the usage it reads cannot change before the insert is committed.
+func (m mediaRepository) SaveWithinQuota(ctx context.Context, media entity.Media, quota int64) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "media:"+media.OwnerId.String()).Error; err != nil {
+			return err
+		}
+
+		used, err := usedBytes(tx, media.OwnerId)
+		if err != nil {
+			return err
+		}
+		if used+media.Size > quota {
+			return entity.ErrMediaQuotaExceeded
+		}
+
+		return tx.Create(&media).Error
+	})
+}
+
+func (m mediaRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Media, error) {
+	return gorm.G[entity.Media](m.db).Preload("Variants", nil).Where("id = ?", id).First(ctx)
+}
+
+func (m mediaRepository) SaveVariants(ctx context.Context, media entity.Media) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Media{}).Where("id = ?", media.ID).Updates(map[string]any{
+			"width":  media.Width,
+			"height": media.Height,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("media_id = ?", media.ID).Delete(&entity.MediaVariant{}).Error; err != nil {
+			return err
+		}
+		if len(media.Variants) == 0 {
+			return nil
+		}
+		return tx.Create(&media.Variants).Error
+	})
+}
+
+func (m mediaRepository) UsedBytes(ctx context.Context, ownerId uuid.UUID) (int64, error) {
+	return usedBytes(m.db.WithContext(ctx), ownerId)
+}
+
+func usedBytes(db *gorm.DB, ownerId uuid.UUID) (int64, error) {
+	var used int64
+	err := db.Model(&entity.Media{}).Where("owner_id = ?", ownerId).Select("COALESCE(SUM(size), 0)").Scan(&used).Error
+	return used, err
+}
+
+func NewMediaRepository(db *gorm.DB) repository.MediaRepository {
+	return &mediaRepository{db: db}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type moderationLabel struct {
+	Label     string `gorm:"primaryKey;column:label"`
+	Documents int64  `gorm:"column:documents"`
+	Tokens    int64  `gorm:"column:tokens"`
+}
+
+func (moderationLabel) TableName() string {
+	return "moderation_labels"
+}
+
+type moderationToken struct {
+	Token    string `gorm:"primaryKey;column:token"`
+	Approved int64  `gorm:"column:approved"`
+	Rejected int64  `gorm:"column:rejected"`
+}
+
+func (moderationToken) TableName() string {
+	return "moderation_tokens"
+}
+
+type moderationTrainingRepository struct {
+	db *gorm.DB
+}
+
+func (m moderationTrainingRepository) Train(ctx context.Context, label entity.CommentStatus, tokens []string) error {
+	var column string
+	switch label {
+	case entity.CommentStatusApproved:
+		column = "approved"
+	case entity.CommentStatusRejected:
+		column = "rejected"
+	default:
+		return fmt.Errorf("cannot train moderation on %q comments", label)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "label"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"documents": gorm.Expr("moderation_labels.documents + 1"),
+				"tokens":    gorm.Expr("moderation_labels.tokens + ?", len(tokens)),
+			}),
+		}).Create(&moderationLabel{Label: string(label), Documents: 1, Tokens: int64(len(tokens))}).Error
+		if err != nil {
+			return err
+		}
+		if len(tokens) == 0 {
+			return nil
+		}
+
+		rows := make([]moderationToken, len(tokens))
+		for i, token := range tokens {
+			rows[i] = moderationToken{Token: token}
+			if label == entity.CommentStatusApproved {
+				rows[i].Approved = 1
+			} else {
+				rows[i].Rejected = 1
+			}
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "token"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				column: gorm.Expr("moderation_tokens." + column + " + 1"),
+			}),
+		}).Create(&rows).Error
+	})
+}
+
+func (m moderationTrainingRepository) FindTrainingData(ctx context.Context, tokens []string) (repository.ModerationTrainingData, error) {
+	data := repository.ModerationTrainingData{Tokens: make(map[string]repository.ModerationTokenCount, len(tokens))}
+
+	labels := make([]moderationLabel, 0)
+	err := m.db.WithContext(ctx).Find(&labels).Error
+	if err != nil {
+		return repository.ModerationTrainingData{}, err
+	}
+	for _, label := range labels {
+		count := repository.ModerationLabelCount{Documents: label.Documents, Tokens: label.Tokens}
+		switch entity.CommentStatus(label.Label) {
+		case entity.CommentStatusApproved:
+			data.Approved = count
+		case entity.CommentStatusRejected:
+			data.Rejected = count
+		}
+	}
+
+	err = m.db.WithContext(ctx).Model(&moderationToken{}).Count(&data.Vocabulary).Error
+	if err != nil {
+		return repository.ModerationTrainingData{}, err
+	}
+
+	if len(tokens) == 0 {
+		return data, nil
+	}
+
+	rows := make([]moderationToken, 0)
+	err = m.db.WithContext(ctx).Where("token IN ?", tokens).Find(&rows).Error
+	if err != nil {
+		return repository.ModerationTrainingData{}, err
+	}
+	for _, row := range rows {
+		data.Tokens[row.Token] = repository.ModerationTokenCount{Approved: row.Approved, Rejected: row.Rejected}
+	}
+
+	return data, nil
+}
+
+func NewModerationTrainingRepository(db *gorm.DB) repository.ModerationTrainingRepository {
+	return &moderationTrainingRepository{db: db}
+}
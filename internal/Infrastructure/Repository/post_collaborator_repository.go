@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postCollaboratorRepository struct {
+	db *gorm.DB
+}
+
+func (p postCollaboratorRepository) Save(ctx context.Context, collaborator entity.PostCollaborator) error {
+	return p.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(&collaborator).Error
+}
+
+func (p postCollaboratorRepository) Delete(ctx context.Context, postId uuid.UUID, userId uuid.UUID) error {
+	return p.db.WithContext(ctx).Where("post_id = ? AND user_id = ?", postId, userId).Delete(&entity.PostCollaborator{}).Error
+}
+
+func NewPostCollaboratorRepository(db *gorm.DB) repository.PostCollaboratorRepository {
+	return &postCollaboratorRepository{db: db}
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	repository "main/internal/Domain/Repository"
+	"os"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/index/scorch"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/format/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/google/uuid"
+)
+
+const (
+	postIndexFacetSize  = 10
+	postIndexBatchSize  = 500
+	postIndexTitleBoost = 2.0
+)
+
+// postIndexDocument is the shape stored in Bleve. Field names double as
+// facet names in PostIndexResult.
+type postIndexDocument struct {
+	CreatedAt time.Time `json:"created_at"`
+	Slug      string    `json:"slug"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	AuthorId  string    `json:"author_id"`
+	Author    string    `json:"author"`
+	Tags      []string  `json:"tags"`
+	Status    string    `json:"status"`
+}
+
+// postIndexRepository keeps the index on disk and opens it for every
+// operation. The consumer writes to it while the API server reads from it,
+// and Bleve holds an exclusive file lock while an index is open for writing,
+// so neither process may keep it open. lockTimeout bounds how long an
+// operation waits for the other process to release the lock.
+type postIndexRepository struct {
+	path        string
+	lockTimeout time.Duration
+}
+
+func (p postIndexRepository) Index(ctx context.Context, post repository.IndexedPost) error {
+	return p.write(func(index bleve.Index) error {
+		return index.Index(post.ID.String(), newPostIndexDocument(post))
+	})
+}
+
+func (p postIndexRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return p.write(func(index bleve.Index) error {
+		return index.Delete(id.String())
+	})
+}
+
+func (p postIndexRepository) Search(ctx context.Context, q repository.PostIndexQuery) (repository.PostIndexResult, error) {
+	index, err := bleve.OpenUsing(p.path, map[string]interface{}{
+		"read_only":    true,
+		"bolt_timeout": p.lockTimeout.String(),
+	})
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		return repository.PostIndexResult{Hits: []repository.PostIndexHit{}, Facets: map[string][]repository.PostIndexFacet{}}, nil
+	}
+	if err != nil {
+		return repository.PostIndexResult{}, err
+	}
+	defer index.Close()
+
+	request := bleve.NewSearchRequestOptions(buildPostIndexQuery(q), q.PageSize, (q.Page-1)*q.PageSize, false)
+	request.Fields = []string{"*"}
+	request.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	request.Highlight.AddField("title")
+	request.Highlight.AddField("content")
+	request.AddFacet("author", bleve.NewFacetRequest("author", postIndexFacetSize))
+	request.AddFacet("tags", bleve.NewFacetRequest("tags", postIndexFacetSize))
+
+	searchResult, err := index.SearchInContext(ctx, request)
+	if err != nil {
+		return repository.PostIndexResult{}, err
+	}
+
+	hits := make([]repository.PostIndexHit, 0, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		id, err := uuid.Parse(hit.ID)
+		if err != nil {
+			return repository.PostIndexResult{}, err
+		}
+		hits = append(hits, repository.PostIndexHit{
+			Post:       newIndexedPost(id, hit.Fields),
+			Score:      hit.Score,
+			Highlights: hit.Fragments,
+		})
+	}
+
+	facets := make(map[string][]repository.PostIndexFacet, len(searchResult.Facets))
+	for name, facet := range searchResult.Facets {
+		terms := make([]repository.PostIndexFacet, 0)
+		if facet.Terms != nil {
+			for _, term := range facet.Terms.Terms() {
+				terms = append(terms, repository.PostIndexFacet{Term: term.Term, Count: term.Count})
+			}
+		}
+		facets[name] = terms
+	}
+
+	return repository.PostIndexResult{Hits: hits, Total: int64(searchResult.Total), Facets: facets}, nil
+}
+
+// Rebuild writes a fresh index next to the live one and swaps the
+// directories once it is complete, so searches keep working meanwhile.
+func (p postIndexRepository) Rebuild(ctx context.Context, fill func(add func(post repository.IndexedPost) error) error) error {
+	rebuildPath := p.path + ".rebuild"
+	if err := os.RemoveAll(rebuildPath); err != nil {
+		return err
+	}
+
+	index, err := bleve.NewUsing(rebuildPath, buildPostIndexMapping(), scorch.Name, scorch.Name, nil)
+	if err != nil {
+		return err
+	}
+
+	batch := index.NewBatch()
+	err = fill(func(post repository.IndexedPost) error {
+		if err := batch.Index(post.ID.String(), newPostIndexDocument(post)); err != nil {
+			return err
+		}
+		if batch.Size() < postIndexBatchSize {
+			return nil
+		}
+		if err := index.Batch(batch); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	})
+	if err == nil {
+		err = index.Batch(batch)
+	}
+	if closeErr := index.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(err, os.RemoveAll(rebuildPath))
+	}
+
+	stalePath := p.path + ".stale"
+	if err := os.RemoveAll(stalePath); err != nil {
+		return err
+	}
+	if err := os.Rename(p.path, stalePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.Rename(rebuildPath, p.path); err != nil {
+		return err
+	}
+	return os.RemoveAll(stalePath)
+}
+
+func (p postIndexRepository) write(apply func(index bleve.Index) error) error {
+	config := map[string]interface{}{"bolt_timeout": p.lockTimeout.String()}
+
+	index, err := bleve.OpenUsing(p.path, config)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.NewUsing(p.path, buildPostIndexMapping(), scorch.Name, scorch.Name, config)
+	}
+	if err != nil {
+		return fmt.Errorf("opening post index: %w", err)
+	}
+
+	err = apply(index)
+	if closeErr := index.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func buildPostIndexQuery(q repository.PostIndexQuery) query.Query {
+	title := bleve.NewMatchQuery(q.Text)
+	title.SetField("title")
+	title.SetBoost(postIndexTitleBoost)
+	content := bleve.NewMatchQuery(q.Text)
+	content.SetField("content")
+	for _, match := range []*query.MatchQuery{title, content} {
+		if q.Fuzziness < 0 {
+			match.SetAutoFuzziness(true)
+		} else {
+			match.SetFuzziness(q.Fuzziness)
+		}
+	}
+
+	conjuncts := []query.Query{bleve.NewDisjunctionQuery(title, content)}
+
+	if q.Author != "" {
+		author := bleve.NewTermQuery(q.Author)
+		author.SetField("author")
+		conjuncts = append(conjuncts, author)
+	}
+	for _, tag := range q.Tags {
+		tagQuery := bleve.NewTermQuery(tag)
+		tagQuery.SetField("tags")
+		conjuncts = append(conjuncts, tagQuery)
+	}
+
+	published := bleve.NewTermQuery("published")
+	published.SetField("status")
+	if q.ViewerId == uuid.Nil {
+		conjuncts = append(conjuncts, published)
+	} else {
+		ownPosts := bleve.NewTermQuery(q.ViewerId.String())
+		ownPosts.SetField("author_id")
+		conjuncts = append(conjuncts, bleve.NewDisjunctionQuery(published, ownPosts))
+	}
+
+	return bleve.NewConjunctionQuery(conjuncts...)
+}
+
+func buildPostIndexMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = en.AnalyzerName
+	keyword := bleve.NewKeywordFieldMapping()
+	unindexedKeyword := bleve.NewKeywordFieldMapping()
+	unindexedKeyword.Index = false
+
+	post := bleve.NewDocumentMapping()
+	post.AddFieldMappingsAt("created_at", bleve.NewDateTimeFieldMapping())
+	post.AddFieldMappingsAt("slug", unindexedKeyword)
+	post.AddFieldMappingsAt("title", text)
+	post.AddFieldMappingsAt("content", text)
+	post.AddFieldMappingsAt("author_id", keyword)
+	post.AddFieldMappingsAt("author", keyword)
+	post.AddFieldMappingsAt("tags", keyword)
+	post.AddFieldMappingsAt("status", keyword)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = post
+	return indexMapping
+}
+
+func newPostIndexDocument(post repository.IndexedPost) postIndexDocument {
+	return postIndexDocument{
+		CreatedAt: post.CreatedAt,
+		Slug:      post.Slug,
+		Title:     post.Title,
+		Content:   post.Content,
+		AuthorId:  post.AuthorId.String(),
+		Author:    post.AuthorName,
+		Tags:      post.Tags,
+		Status:    post.Status,
+	}
+}
+
+func newIndexedPost(id uuid.UUID, fields map[string]interface{}) repository.IndexedPost {
+	post := repository.IndexedPost{
+		ID:         id,
+		Slug:       stringField(fields, "slug"),
+		Title:      stringField(fields, "title"),
+		Content:    stringField(fields, "content"),
+		AuthorName: stringField(fields, "author"),
+		Status:     stringField(fields, "status"),
+		Tags:       []string{},
+	}
+	post.AuthorId, _ = uuid.Parse(stringField(fields, "author_id"))
+	post.CreatedAt, _ = time.Parse(time.RFC3339, stringField(fields, "created_at"))
+
+	// Bleve returns a single value instead of a list for one-element arrays.
+	switch tags := fields["tags"].(type) {
+	case string:
+		post.Tags = append(post.Tags, tags)
+	case []interface{}:
+		for _, tag := range tags {
+			if name, ok := tag.(string); ok {
+				post.Tags = append(post.Tags, name)
+			}
+		}
+	}
+	return post
+}
+
+func stringField(fields map[string]interface{}, name string) string {
+	value, _ := fields[name].(string)
+	return value
+}
+
+func NewPostIndexRepository(path string, lockTimeout time.Duration) repository.PostIndexRepository {
+	return &postIndexRepository{path: path, lockTimeout: lockTimeout}
+}
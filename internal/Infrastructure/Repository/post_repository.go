@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	entity "main/internal/Domain/Entity"
 	repository "main/internal/Domain/Repository"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postRepository struct {
@@ -14,41 +17,81 @@ type postRepository struct {
 }
 
 func (p postRepository) Save(ctx context.Context, post entity.Post) error {
-	return p.db.WithContext(ctx).Create(&post).Error
+	return p.db.WithContext(ctx).Omit("Collaborators").Create(&post).Error
 }
 
 func (p postRepository) Update(ctx context.Context, post entity.Post) error {
-	return p.db.WithContext(ctx).Model(&post).Where("id = ?", post.ID).Updates(map[string]interface{}{
-		"slug":       post.Slug,
-		"title":      post.Title,
-		"content":    post.Content,
-		"updated_at": post.UpdatedAt,
-	}).Error
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&post).Omit("Collaborators").Where("id = ?", post.ID).Updates(map[string]interface{}{
+			"slug":                 post.Slug,
+			"title":                post.Title,
+			"content":              post.Content,
+			"content_format":       post.ContentFormat,
+			"excerpt":              post.Excerpt,
+			"word_count":           post.WordCount,
+			"reading_time_minutes": post.ReadingTimeMinutes,
+			"status":               post.Status,
+			"published_at":         post.PublishedAt,
+			"publish_at":           post.PublishAt,
+			"updated_at":           post.UpdatedAt,
+			"cover_media_id":       post.CoverMediaId,
+			"seo_title":            post.SeoTitle,
+			"meta_description":     post.MetaDescription,
+			"canonical_url":        post.CanonicalURL,
+			"category_id":          post.CategoryId,
+			"submitted_at":         post.SubmittedAt,
+			"reviewer_id":          post.ReviewerId,
+			"reviewed_at":          post.ReviewedAt,
+			"review_notes":         post.ReviewNotes,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&post).Association("Tags").Replace(post.Tags)
+	})
+}
+
+func (p postRepository) SaveRenderedContent(ctx context.Context, post entity.Post) error {
+	toc, err := json.Marshal(post.Toc)
+	if err != nil {
+		return err
+	}
+
+	return p.db.WithContext(ctx).Exec(
+		"UPDATE posts SET content_html = ?, toc = ? WHERE id = ? AND content = ? AND content_format = ?",
+		post.ContentHTML, string(toc), post.ID, post.Content, post.ContentFormat,
+	).Error
 }
 
 func (p postRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
-	return gorm.G[entity.Post](p.db).Where("id = ?", id).First(ctx)
+	return gorm.G[entity.Post](p.db).Preload("Tags", nil).Preload("Collaborators", orderCollaborators).Where("id = ?", id).First(ctx)
 }
 
-func (p postRepository) FindAllBy(ctx context.Context, page int, pageSize int, slug string, text string, author string) (repository.PaginatedResult[entity.Post], error) {
+func (p postRepository) FindBySlug(ctx context.Context, slug string) (entity.Post, error) {
+	return gorm.G[entity.Post](p.db).Preload("Tags", nil).Preload("Collaborators", orderCollaborators).Where("slug = ?", slug).First(ctx)
+}
+
+// orderCollaborators lists the collaborators of a post in invitation order.
+func orderCollaborators(db gorm.PreloadBuilder) error {
+	db.Order("created_at").Order("user_id")
+	return nil
+}
+
+func (p postRepository) FindAllBy(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[entity.Post], error) {
 	var total int64
-	tx := p.db.WithContext(ctx).Model(&entity.Post{})
-	if slug != "" {
-		tx = tx.Where("slug LIKE ?", "%"+slug+"%")
-	}
-	if text != "" {
-		tx = tx.Where("content LIKE ? OR title LIKE ?", "%"+text+"%", "%"+text+"%")
-	}
-	if author != "" {
-		tx = tx.Joins("JOIN users ON posts.author_id = users.id AND users.name LIKE ?", "%"+author+"%")
-	}
+	tx := applyPostFilters(p.db.WithContext(ctx).Model(&entity.Post{}), filters)
 	err := tx.Count(&total).Error
 	if err != nil {
 		return repository.PaginatedResult[entity.Post]{}, err
 	}
 
+	if filters.WithoutContent {
+		tx = tx.Omit("content", "content_html", "toc")
+	}
+
 	posts := make([]entity.Post, 0)
-	err = tx.Offset((page - 1) * pageSize).Limit(pageSize).Find(&posts).Error
+	err = applyPostSort(tx, filters).Preload("Tags").Offset((page - 1) * pageSize).Limit(pageSize).Find(&posts).Error
 	if err != nil {
 		return repository.PaginatedResult[entity.Post]{}, err
 	}
@@ -60,6 +103,122 @@ func (p postRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return p.db.WithContext(ctx).Delete(&entity.Post{}, id).Error
 }
 
+func (p postRepository) ClaimScheduledPosts(ctx context.Context, now time.Time, limit int, claim func(posts []entity.Post) error) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		posts := make([]entity.Post, 0)
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("status = ? AND publish_at <= ?", entity.PostStatusApproved, now).
+			Order("publish_at").
+			Limit(limit).
+			Find(&posts).Error
+		if err != nil {
+			return err
+		}
+		if len(posts) == 0 {
+			return nil
+		}
+
+		if err := claim(posts); err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(posts))
+		for i, post := range posts {
+			ids[i] = post.ID
+		}
+
+		return tx.Model(&entity.Post{}).Where("id IN ?", ids).Update("publish_at", nil).Error
+	})
+}
+
+// textSearchQuery is the tsquery built from a PostFilters.Text value.
+const textSearchQuery = "websearch_to_tsquery('english', ?)"
+
+func applyPostFilters(tx *gorm.DB, filters repository.PostFilters) *gorm.DB {
+	if filters.Slug != "" {
+		tx = tx.Where("posts.slug LIKE ?", "%"+filters.Slug+"%")
+	}
+	if filters.Text != "" {
+		tx = tx.Where("posts.search_vector @@ "+textSearchQuery, filters.Text)
+	}
+	if filters.Author != "" {
+		tx = tx.Joins("JOIN users ON posts.author_id = users.id AND users.name LIKE ?", "%"+filters.Author+"%")
+	}
+	if filters.Status != "" {
+		tx = tx.Where("posts.status = ?", filters.Status)
+	}
+	if len(filters.TagsAny) > 0 {
+		tx = tx.Where(
+			"posts.id IN (SELECT post_tags.post_id FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE tags.name IN ?)",
+			filters.TagsAny,
+		)
+	}
+	if len(filters.TagsAll) > 0 {
+		tx = tx.Where(
+			"posts.id IN (SELECT post_tags.post_id FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE tags.name IN ? GROUP BY post_tags.post_id HAVING COUNT(DISTINCT tags.id) = ?)",
+			filters.TagsAll,
+			len(filters.TagsAll),
+		)
+	}
+	if filters.Category != "" {
+		tx = tx.Where(
+			"posts.category_id IN (SELECT descendants.id FROM categories JOIN categories descendants ON descendants.path LIKE categories.path || '%' WHERE categories.slug = ?)",
+			filters.Category,
+		)
+	}
+	if filters.AuthorId != uuid.Nil {
+		tx = tx.Where("posts.author_id = ?", filters.AuthorId)
+	}
+	if filters.Scheduled {
+		tx = tx.Where("posts.status IN ? AND posts.publish_at IS NOT NULL", entity.PendingPostStatuses)
+	}
+	if filters.ReviewQueue {
+		tx = tx.Where("posts.status = ?", entity.PostStatusInReview)
+	}
+	if filters.ReviewerId != uuid.Nil {
+		tx = tx.Where(
+			"posts.id IN (SELECT post_collaborators.post_id FROM post_collaborators WHERE post_collaborators.user_id = ? AND post_collaborators.role IN ?)",
+			filters.ReviewerId,
+			entity.ReviewerCollaboratorRoles,
+		)
+	}
+	switch {
+	case filters.IncludeUnpublished:
+	case filters.ViewerId == uuid.Nil:
+		tx = tx.Where("posts.status = ?", entity.PostStatusPublished)
+	default:
+		tx = tx.Where(
+			"posts.status = ? OR posts.author_id = ? OR posts.id IN (SELECT post_id FROM post_collaborators WHERE user_id = ?)",
+			entity.PostStatusPublished, filters.ViewerId, filters.ViewerId,
+		)
+	}
+	return tx
+}
+
+// applyPostSort orders the result. It has to run after counting, since
+// Postgres rejects ORDER BY on a COUNT(*) query.
+func applyPostSort(tx *gorm.DB, filters repository.PostFilters) *gorm.DB {
+	if filters.Scheduled {
+		return tx.Order("posts.publish_at")
+	}
+	if filters.ReviewQueue {
+		return tx.Order("posts.submitted_at").Order("posts.id")
+	}
+
+	switch {
+	case filters.Sort == repository.PostSortRelevance && filters.Text != "":
+		tx = tx.Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "ts_rank_cd(posts.search_vector, " + textSearchQuery + ") DESC",
+			Vars: []interface{}{filters.Text},
+		}})
+	case filters.Sort == repository.PostSortNewest:
+		return tx.Order("posts.created_at DESC").Order("posts.id")
+	case filters.Sort == repository.PostSortRecentlyPublished:
+		return tx.Order("posts.published_at DESC").Order("posts.id")
+	}
+	return tx.Order("posts.created_at").Order("posts.id")
+}
+
 func NewPostRepository(db *gorm.DB) repository.PostRepository {
 	return &postRepository{db: db}
 }
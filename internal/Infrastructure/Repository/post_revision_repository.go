@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postRevisionRepository struct {
+	db *gorm.DB
+}
+
+func (p postRevisionRepository) Save(ctx context.Context, revision entity.PostRevision) (int, error) {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the post row so concurrent saves for the same post are numbered one after another.
+		err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("id").
+			Where("id = ?", revision.PostId).
+			First(&entity.Post{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entity.PostRevision{}).
+			Select("COALESCE(MAX(revision), 0) + 1").
+			Where("post_id = ?", revision.PostId).
+			Scan(&revision.Revision).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&revision).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return revision.Revision, nil
+}
+
+func (p postRevisionRepository) FindByPostIdAndRevision(ctx context.Context, postId uuid.UUID, revision int) (entity.PostRevision, error) {
+	return gorm.G[entity.PostRevision](p.db).Where("post_id = ? AND revision = ?", postId, revision).First(ctx)
+}
+
+func (p postRevisionRepository) FindAllByPostId(ctx context.Context, postId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.PostRevision], error) {
+	var total int64
+	tx := p.db.WithContext(ctx).Model(&entity.PostRevision{}).Where("post_id = ?", postId)
+	err := tx.Count(&total).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.PostRevision]{}, err
+	}
+
+	revisions := make([]entity.PostRevision, 0)
+	err = tx.Order("revision DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&revisions).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.PostRevision]{}, err
+	}
+
+	return repository.PaginatedResult[entity.PostRevision]{Items: revisions, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+func NewPostRevisionRepository(db *gorm.DB) repository.PostRevisionRepository {
+	return &postRevisionRepository{db: db}
+}
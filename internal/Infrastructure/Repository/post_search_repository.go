@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	titleHeadlineOptions   = "HighlightAll=true, StartSel=<mark>, StopSel=</mark>"
+	contentHeadlineOptions = "MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=\" … \", StartSel=<mark>, StopSel=</mark>"
+)
+
+type postSearchRepository struct {
+	db *gorm.DB
+}
+
+func (p postSearchRepository) Search(ctx context.Context, page int, pageSize int, filters repository.PostFilters) (repository.PaginatedResult[repository.PostSearchResult], error) {
+	var total int64
+	tx := applyPostFilters(p.db.WithContext(ctx).Model(&entity.Post{}), filters)
+	err := tx.Count(&total).Error
+	if err != nil {
+		return repository.PaginatedResult[repository.PostSearchResult]{}, err
+	}
+
+	rows := make([]struct {
+		ID               uuid.UUID `gorm:"column:id"`
+		Rank             float64   `gorm:"column:rank"`
+		TitleHighlight   string    `gorm:"column:title_highlight"`
+		ContentHighlight string    `gorm:"column:content_highlight"`
+	}, 0)
+	err = applyPostSort(tx, filters).
+		Select(
+			"posts.id, "+
+				"ts_rank_cd(posts.search_vector, "+textSearchQuery+") AS rank, "+
+				"ts_headline('english', posts.title, "+textSearchQuery+", ?) AS title_highlight, "+
+				"ts_headline('english', posts.content, "+textSearchQuery+", ?) AS content_highlight",
+			filters.Text,
+			filters.Text,
+			titleHeadlineOptions,
+			filters.Text,
+			contentHeadlineOptions,
+		).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&rows).Error
+	if err != nil {
+		return repository.PaginatedResult[repository.PostSearchResult]{}, err
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	posts := make([]entity.Post, 0, len(rows))
+	if len(ids) > 0 {
+		err = p.db.WithContext(ctx).Preload("Tags").Where("id IN ?", ids).Find(&posts).Error
+		if err != nil {
+			return repository.PaginatedResult[repository.PostSearchResult]{}, err
+		}
+	}
+	postsById := make(map[uuid.UUID]entity.Post, len(posts))
+	for _, post := range posts {
+		postsById[post.ID] = post
+	}
+
+	results := make([]repository.PostSearchResult, 0, len(rows))
+	for _, row := range rows {
+		post, ok := postsById[row.ID]
+		if !ok {
+			continue
+		}
+		results = append(results, repository.PostSearchResult{
+			Post:             post,
+			Rank:             row.Rank,
+			TitleHighlight:   row.TitleHighlight,
+			ContentHighlight: row.ContentHighlight,
+		})
+	}
+
+	return repository.PaginatedResult[repository.PostSearchResult]{Items: results, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+func NewPostSearchRepository(db *gorm.DB) repository.PostSearchRepository {
+	return &postSearchRepository{db: db}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type postTrashRepository struct {
+	db *gorm.DB
+}
+
+func (p postTrashRepository) trashed(ctx context.Context) *gorm.DB {
+	return p.db.WithContext(ctx).Unscoped().Model(&entity.Post{}).Where("posts.deleted_at IS NOT NULL")
+}
+
+func (p postTrashRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	var post entity.Post
+	err := p.trashed(ctx).
+		Preload("Tags").
+		Preload("Collaborators", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at").Order("user_id")
+		}).
+		Where("posts.id = ?", id).
+		First(&post).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.Post{}, repository.ErrPostNotInTrash
+	}
+	return post, err
+}
+
+func (p postTrashRepository) FindAllByOwnerId(ctx context.Context, ownerId uuid.UUID, page int, pageSize int) (repository.PaginatedResult[entity.Post], error) {
+	var total int64
+	tx := p.trashed(ctx).Where(
+		"posts.author_id = ? OR posts.id IN (SELECT post_collaborators.post_id FROM post_collaborators WHERE post_collaborators.user_id = ? AND post_collaborators.role = ?)",
+		ownerId,
+		ownerId,
+		entity.CollaboratorRoleCoAuthor,
+	)
+	err := tx.Count(&total).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Post]{}, err
+	}
+
+	posts := make([]entity.Post, 0)
+	err = tx.Preload("Tags").Order("posts.deleted_at DESC").Order("posts.id").Offset((page - 1) * pageSize).Limit(pageSize).Find(&posts).Error
+	if err != nil {
+		return repository.PaginatedResult[entity.Post]{}, err
+	}
+
+	return repository.PaginatedResult[entity.Post]{Items: posts, Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+func (p postTrashRepository) FindAllDeletedBefore(ctx context.Context, before time.Time, limit int) ([]entity.Post, error) {
+	posts := make([]entity.Post, 0)
+	err := p.trashed(ctx).Where("posts.deleted_at < ?", before).Order("posts.deleted_at").Limit(limit).Find(&posts).Error
+	return posts, err
+}
+
+func (p postTrashRepository) Restore(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return p.trashed(ctx).Where("posts.id = ?", id).UpdateColumns(map[string]any{
+		"deleted_at": nil,
+		"updated_at": at,
+	}).Error
+}
+
+func (p postTrashRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	// Revisions, comments, annotations and the rest go with the post through
+	// the ON DELETE CASCADE of their foreign keys.
+	return p.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Delete(&entity.Post{}, id).Error
+}
+
+func NewPostTrashRepository(db *gorm.DB) repository.PostTrashRepository {
+	return &postTrashRepository{db: db}
+}
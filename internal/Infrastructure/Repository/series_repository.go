@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type seriesRepository struct {
+	db *gorm.DB
+}
+
+func (s seriesRepository) Save(ctx context.Context, series entity.Series) error {
+	return s.db.WithContext(ctx).Omit("Posts").Create(&series).Error
+}
+
+func (s seriesRepository) Update(ctx context.Context, series entity.Series) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Series{}).Where("id = ?", series.ID).Updates(map[string]any{
+			"title":       series.Title,
+			"description": series.Description,
+			"updated_at":  series.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// Rewriting the membership as a whole keeps the unique positions
+		// from colliding while posts swap places.
+		if err := tx.Where("series_id = ?", series.ID).Delete(&entity.SeriesPost{}).Error; err != nil {
+			return err
+		}
+		if len(series.Posts) == 0 {
+			return nil
+		}
+		return tx.Omit("Post").Create(&series.Posts).Error
+	})
+}
+
+func (s seriesRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.Series, error) {
+	var series entity.Series
+	err := s.db.WithContext(ctx).
+		Preload("Posts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		// Trashed posts are loaded too, so that reordering keeps their place.
+		Preload("Posts.Post", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Select("id", "slug", "title", "status", "published_at", "author_id", "deleted_at")
+		}).
+		Where("id = ?", id).
+		First(&series).Error
+	return series, err
+}
+
+func (s seriesRepository) FindByPostId(ctx context.Context, postId uuid.UUID) (entity.Series, error) {
+	var seriesPost entity.SeriesPost
+	err := s.db.WithContext(ctx).Where("post_id = ?", postId).First(&seriesPost).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.Series{}, repository.ErrSeriesNotFound
+	}
+	if err != nil {
+		return entity.Series{}, err
+	}
+	return s.FindByID(ctx, seriesPost.SeriesId)
+}
+
+func NewSeriesRepository(db *gorm.DB) repository.SeriesRepository {
+	return &seriesRepository{db: db}
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const sitemapKey = "sitemap"
+
+// sitemapRepository keeps all sitemap files in a single Redis hash, which is
+// replaced in one transaction.
+type sitemapRepository struct {
+	pool *redis.Pool
+}
+
+func (s sitemapRepository) Save(ctx context.Context, files map[string]string) error {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	args := redis.Args{}.Add(sitemapKey)
+	for name, content := range files {
+		args = args.Add(name, content)
+	}
+
+	conn.Send("MULTI")
+	conn.Send("DEL", sitemapKey)
+	if len(files) > 0 {
+		conn.Send("HSET", args...)
+	}
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func (s sitemapRepository) Find(ctx context.Context, name string) (string, error) {
+	conn, err := s.pool.GetContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	content, err := redis.String(conn.Do("HGET", sitemapKey, name))
+	if errors.Is(err, redis.ErrNil) {
+		return "", repository.ErrSitemapFileNotFound
+	}
+	return content, err
+}
+
+func NewSitemapRepository(pool *redis.Pool) repository.SitemapRepository {
+	return sitemapRepository{pool: pool}
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type slugHistoryRepository struct {
+	db *gorm.DB
+}
+
+func (s slugHistoryRepository) Save(ctx context.Context, slugHistory entity.SlugHistory) error {
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"post_id", "created_at"}),
+	}).Create(&slugHistory).Error
+}
+
+func (s slugHistoryRepository) FindPostIdBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	slugHistory, err := gorm.G[entity.SlugHistory](s.db).Where("slug = ?", slug).First(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return slugHistory.PostId, nil
+}
+
+func NewSlugHistoryRepository(db *gorm.DB) repository.SlugHistoryRepository {
+	return &slugHistoryRepository{db: db}
+}
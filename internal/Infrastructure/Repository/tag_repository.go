@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func (t tagRepository) FindOrCreateByNames(ctx context.Context, names []string) ([]entity.Tag, error) {
+	tags := make([]entity.Tag, 0, len(names))
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	newTags := make([]entity.Tag, len(names))
+	for i, name := range names {
+		newTags[i] = entity.NewTag(uuid.New(), time.Now(), name)
+	}
+
+	err := t.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoNothing: true,
+	}).Create(&newTags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.db.WithContext(ctx).Where("name IN ?", names).Order("name").Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (t tagRepository) FindAllWithPostCount(ctx context.Context) ([]repository.TagWithPostCount, error) {
+	rows := make([]struct {
+		entity.Tag
+		PostCount int64 `gorm:"column:post_count"`
+	}, 0)
+	err := t.db.WithContext(ctx).
+		Model(&entity.Tag{}).
+		Select("tags.*, COUNT(posts.id) AS post_count").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.status = ? AND posts.deleted_at IS NULL", entity.PostStatusPublished).
+		Group("tags.id").
+		Order("post_count DESC, tags.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]repository.TagWithPostCount, len(rows))
+	for i, row := range rows {
+		tags[i] = repository.TagWithPostCount{Tag: row.Tag, PostCount: row.PostCount}
+	}
+
+	return tags, nil
+}
+
+func NewTagRepository(db *gorm.DB) repository.TagRepository {
+	return &tagRepository{db: db}
+}
@@ -19,7 +19,7 @@ func (u userRepository) Save(ctx context.Context, user entity.User) error {
 
 func (u userRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
 	var user entity.User
-	err := u.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
+	err := u.db.WithContext(ctx).Preload("Roles", orderRoles).Where("id = ?", id).First(&user).Error
 	if err != nil {
 		return entity.User{}, err
 	}
@@ -28,13 +28,18 @@ func (u userRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.User
 
 func (u userRepository) FindByProviderUserIdAndEmail(ctx context.Context, providerUserId string, userEmail string) (entity.User, error) {
 	var user entity.User
-	err := u.db.WithContext(ctx).Where("provider_user_id = ? AND email = ?", providerUserId, userEmail).First(&user).Error
+	err := u.db.WithContext(ctx).Preload("Roles", orderRoles).Where("provider_user_id = ? AND email = ?", providerUserId, userEmail).First(&user).Error
 	if err != nil {
 		return entity.User{}, err
 	}
 	return user, nil
 }
 
+// orderRoles lists the roles of a user in the order they were granted.
+func orderRoles(db *gorm.DB) *gorm.DB {
+	return db.Order("granted_at").Order("role")
+}
+
 func NewUserRepository(db *gorm.DB) repository.UserRepository {
 	return &userRepository{db: db}
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userRoleRepository struct {
+	db *gorm.DB
+}
+
+func (u userRoleRepository) Save(ctx context.Context, userRole entity.UserRole) error {
+	return u.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&userRole).Error
+}
+
+func (u userRoleRepository) Delete(ctx context.Context, userId uuid.UUID, role entity.Role) error {
+	return u.db.WithContext(ctx).Where("user_id = ? AND role = ?", userId, role).Delete(&entity.UserRole{}).Error
+}
+
+func NewUserRoleRepository(db *gorm.DB) repository.UserRoleRepository {
+	return &userRoleRepository{db: db}
+}
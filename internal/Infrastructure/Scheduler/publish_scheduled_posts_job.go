@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+// PublishScheduledPostsJob sends a PublishPost command for every approved
+// post whose publish_at is due; posts still in review wait for approval.
+// Claimed rows are locked with SKIP LOCKED, so several consumer replicas
+// never pick up the same post.
+type PublishScheduledPostsJob struct {
+	PostRepository repository.PostRepository
+	CommandBus     *cqrs.CommandBus
+	BatchSize      int
+}
+
+func (j PublishScheduledPostsJob) Name() string {
+	return "PublishScheduledPostsJob"
+}
+
+func (j PublishScheduledPostsJob) Run(ctx context.Context) error {
+	systemCtx := authorization.WithActor(ctx, authorization.SystemActor)
+
+	return j.PostRepository.ClaimScheduledPosts(ctx, time.Now(), j.BatchSize, func(posts []entity.Post) error {
+		for _, post := range posts {
+			if err := j.CommandBus.Send(systemCtx, post_command.NewPublishPostCommand(post.ID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	authorization "main/internal/Application/Authorization"
+	post_command "main/internal/Application/Command/Post"
+	repository "main/internal/Domain/Repository"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+)
+
+// PurgeTrashedPostsJob sends a PurgePost command for every post that has been
+// in the trash for longer than Retention. Nothing is locked: a post picked up
+// by several consumer replicas is purged once, the other commands find it
+// gone and do nothing.
+type PurgeTrashedPostsJob struct {
+	PostTrashRepository repository.PostTrashRepository
+	CommandBus          *cqrs.CommandBus
+	Retention           time.Duration
+	BatchSize           int
+}
+
+func (j PurgeTrashedPostsJob) Name() string {
+	return "PurgeTrashedPostsJob"
+}
+
+func (j PurgeTrashedPostsJob) Run(ctx context.Context) error {
+	posts, err := j.PostTrashRepository.FindAllDeletedBefore(ctx, time.Now().Add(-j.Retention), j.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	systemCtx := authorization.WithActor(ctx, authorization.SystemActor)
+	for _, post := range posts {
+		if err := j.CommandBus.Send(systemCtx, post_command.NewPurgePostCommand(post.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+)
+
+// Job is a unit of periodic work run by the Scheduler on every tick.
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+type Scheduler struct {
+	interval time.Duration
+	jobs     []Job
+	logger   watermill.LoggerAdapter
+}
+
+func NewScheduler(interval time.Duration, logger watermill.LoggerAdapter, jobs ...Job) *Scheduler {
+	return &Scheduler{interval: interval, jobs: jobs, logger: logger}
+}
+
+// Run executes all jobs once per interval until ctx is cancelled. A failing
+// job is logged and retried on the next tick.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runJobs(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runJobs(ctx context.Context) {
+	for _, job := range s.jobs {
+		start := time.Now()
+
+		err := job.Run(ctx)
+
+		s.logger.Info("Job run", watermill.LogFields{
+			"job_name": job.Name(),
+			"duration": time.Since(start),
+			"err":      err,
+		})
+	}
+}
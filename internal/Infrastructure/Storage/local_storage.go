@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	repository "main/internal/Domain/Repository"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localStorage keeps objects as files below root. The API server serves them
+// under baseURL, so root has to be shared with every process that writes
+// objects.
+type localStorage struct {
+	root    string
+	baseURL string
+}
+
+func (l localStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Writing to a temporary file first keeps readers from seeing a partially
+	// written object.
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (l localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		// No object can be stored under an invalid key.
+		return nil, repository.ErrStorageObjectNotFound
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, repository.ErrStorageObjectNotFound
+	}
+	return file, err
+}
+
+func (l localStorage) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (l localStorage) URL(key string) string {
+	return l.baseURL + "/" + key
+}
+
+// path rejects keys that would resolve outside of root.
+func (l localStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+func NewLocalStorage(root string, baseURL string) repository.Storage {
+	return localStorage{root: root, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
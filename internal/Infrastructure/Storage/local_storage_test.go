@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"io"
+	repository "main/internal/Domain/Repository"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalStorageTestSuite struct {
+	suite.Suite
+	Root    string
+	Storage repository.Storage
+}
+
+func (s *LocalStorageTestSuite) SetupTest() {
+	s.Root = s.T().TempDir()
+	s.Storage = NewLocalStorage(s.Root, "https://api.example.com/media/files/")
+}
+
+func (s *LocalStorageTestSuite) TestPutGetDelete() {
+	ctx := context.Background()
+
+	err := s.Storage.Put(ctx, "owner/file.png", strings.NewReader("content"), 7, "image/png")
+	assert.NoError(s.T(), err)
+
+	file, err := s.Storage.Get(ctx, "owner/file.png")
+	assert.NoError(s.T(), err)
+	content, err := io.ReadAll(file)
+	assert.NoError(s.T(), err)
+	assert.NoError(s.T(), file.Close())
+	assert.Equal(s.T(), "content", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(s.Root, "owner"))
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), entries, 1)
+
+	assert.NoError(s.T(), s.Storage.Delete(ctx, "owner/file.png"))
+	_, err = s.Storage.Get(ctx, "owner/file.png")
+	assert.ErrorIs(s.T(), err, repository.ErrStorageObjectNotFound)
+	assert.NoError(s.T(), s.Storage.Delete(ctx, "owner/file.png"))
+}
+
+func (s *LocalStorageTestSuite) TestRejectsKeysOutsideRoot() {
+	ctx := context.Background()
+
+	for _, key := range []string{"../file.png", "/etc/passwd", "owner/../../file.png", `owner\..\..\file.png`} {
+		assert.Error(s.T(), s.Storage.Put(ctx, key, strings.NewReader("content"), 7, "image/png"), key)
+		_, err := s.Storage.Get(ctx, key)
+		assert.ErrorIs(s.T(), err, repository.ErrStorageObjectNotFound, key)
+	}
+}
+
+func (s *LocalStorageTestSuite) TestURL() {
+	assert.Equal(s.T(), "https://api.example.com/media/files/owner/file.png", s.Storage.URL("owner/file.png"))
+}
+
+func TestLocalStorageTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalStorageTestSuite))
+}
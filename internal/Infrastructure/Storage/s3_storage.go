@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"io"
+	repository "main/internal/Domain/Repository"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3Storage keeps objects in a bucket of an S3 compatible service such as
+// AWS S3 or MinIO. Objects are expected to be publicly readable under
+// publicURL, e.g. through a bucket policy or a CDN in front of the bucket.
+type s3Storage struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+func (s s3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, content, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// GetObject is lazy; Stat surfaces a missing object before the caller
+	// starts reading.
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+			return nil, repository.ErrStorageObjectNotFound
+		}
+		return nil, err
+	}
+	return object, nil
+}
+
+func (s s3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s s3Storage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
+
+// NewS3Storage connects to endpoint, given as host[:port], with path style
+// requests, which every S3 compatible service supports. An empty publicURL
+// defaults to the bucket address on the endpoint.
+func NewS3Storage(endpoint string, region string, accessKey string, secretKey string, useSSL bool, bucket string, publicURL string) (repository.Storage, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure:       useSSL,
+		Region:       region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if publicURL == "" {
+		scheme := "http"
+		if useSSL {
+			scheme = "https"
+		}
+		publicURL = scheme + "://" + endpoint + "/" + bucket
+	}
+
+	return s3Storage{client: client, bucket: bucket, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"io"
+	repository "main/internal/Domain/Repository"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeS3 is a minimal stand-in for an S3 compatible service such as MinIO,
+// handling path style object requests of a single bucket.
+type fakeS3 struct {
+	mu           sync.Mutex
+	bucket       string
+	objects      map[string][]byte
+	contentTypes map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.Error(w, "unknown bucket", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := readPayload(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		f.objects[key] = body
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		body, ok := f.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", f.contentTypes[key])
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Wed, 01 Jan 2025 00:00:00 GMT")
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		if r.Method == http.MethodGet {
+			w.Write(body)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unsupported method", http.StatusMethodNotAllowed)
+	}
+}
+
+// readPayload decodes the signed chunks that clients stream over plain HTTP.
+func readPayload(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var payload []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return payload, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		payload = append(payload, chunk[:size]...)
+	}
+}
+
+type S3StorageTestSuite struct {
+	suite.Suite
+	Server  *httptest.Server
+	Fake    *fakeS3
+	Storage repository.Storage
+}
+
+func (s *S3StorageTestSuite) SetupTest() {
+	s.Fake = &fakeS3{bucket: "media", objects: map[string][]byte{}, contentTypes: map[string]string{}}
+	s.Server = httptest.NewServer(s.Fake)
+
+	storage, err := NewS3Storage(strings.TrimPrefix(s.Server.URL, "http://"), "us-east-1", "access", "secret", false, "media", "")
+	if err != nil {
+		panic(err)
+	}
+	s.Storage = storage
+}
+
+func (s *S3StorageTestSuite) TearDownTest() {
+	s.Server.Close()
+}
+
+func (s *S3StorageTestSuite) TestPutGetDelete() {
+	ctx := context.Background()
+
+	err := s.Storage.Put(ctx, "owner/file.png", strings.NewReader("content"), 7, "image/png")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "content", string(s.Fake.objects["owner/file.png"]))
+	assert.Equal(s.T(), "image/png", s.Fake.contentTypes["owner/file.png"])
+
+	object, err := s.Storage.Get(ctx, "owner/file.png")
+	assert.NoError(s.T(), err)
+	content, err := io.ReadAll(object)
+	assert.NoError(s.T(), err)
+	assert.NoError(s.T(), object.Close())
+	assert.Equal(s.T(), "content", string(content))
+
+	assert.NoError(s.T(), s.Storage.Delete(ctx, "owner/file.png"))
+	assert.Empty(s.T(), s.Fake.objects)
+}
+
+func (s *S3StorageTestSuite) TestGetMissingObject() {
+	_, err := s.Storage.Get(context.Background(), "owner/missing.png")
+
+	assert.ErrorIs(s.T(), err, repository.ErrStorageObjectNotFound)
+}
+
+func (s *S3StorageTestSuite) TestURL() {
+	assert.Equal(s.T(), s.Server.URL+"/media/owner/file.png", s.Storage.URL("owner/file.png"))
+
+	storage, err := NewS3Storage("s3.example.com", "eu-west-1", "access", "secret", true, "media", "https://cdn.example.com/")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "https://cdn.example.com/owner/file.png", storage.URL("owner/file.png"))
+}
+
+func TestS3StorageTestSuite(t *testing.T) {
+	suite.Run(t, new(S3StorageTestSuite))
+}
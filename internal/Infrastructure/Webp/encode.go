@@ -0,0 +1,627 @@
+// Package webp encodes images as lossless WebP (VP8L), as specified in
+// RFC 9649. It trades compression ratio for simplicity: pixels go through the
+// subtract green and predictor transforms and are then entropy coded with
+// runs of repeated pixels as the only backward references.
+package webp
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"sort"
+)
+
+const (
+	maxDimension = 1 << 14
+
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+
+	// predictorBits is the log-2 size of the tiles that share a predictor.
+	predictorBits = 4
+
+	nLiteralCodes  = 256
+	nLengthCodes   = 24
+	nDistanceCodes = 40
+	maxRunLength   = 4096
+	minRunLength   = 3
+	// distanceLeft is the distance code of the pixel to the left, see the
+	// distance mapping in section 4.2.2 of the specification.
+	distanceLeft = 2
+
+	maxCodeLength           = 15
+	maxCodeLengthCodeLength = 7
+)
+
+var (
+	ErrImageTooLarge = errors.New("webp: image is larger than 16384x16384 pixels")
+
+	codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	// predictorModes are the predictors tried for each tile: L, T,
+	// Average2(L, T), Select(L, T, TL) and ClampAddSubtractFull(L, T, TL).
+	predictorModes = []int{1, 2, 7, 11, 12}
+)
+
+// Encode writes m to w as a lossless WebP image.
+func Encode(w io.Writer, m image.Image) error {
+	bounds := m.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > maxDimension || height > maxDimension {
+		return ErrImageTooLarge
+	}
+
+	nrgba, ok := m.(*image.NRGBA)
+	if !ok || nrgba.Stride != 4*width || bounds.Min != (image.Point{}) {
+		nrgba = image.NewNRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(nrgba, nrgba.Bounds(), m, bounds.Min, draw.Src)
+	}
+	pix := make([]byte, len(nrgba.Pix))
+	copy(pix, nrgba.Pix)
+
+	bw := &bitWriter{}
+	bw.writeBits(0x2f, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	bw.writeBits(boolBit(hasAlpha(pix)), 1)
+	bw.writeBits(0, 3)
+
+	// Transforms are undone in reverse order, so the predictor works on the
+	// image with green already subtracted.
+	bw.writeBits(1, 1)
+	bw.writeBits(transformSubtractGreen, 2)
+	subtractGreen(pix)
+
+	bw.writeBits(1, 1)
+	bw.writeBits(transformPredictor, 2)
+	bw.writeBits(predictorBits-2, 3)
+	modes := predict(pix, width, height)
+	writeImage(bw, modes, false)
+
+	bw.writeBits(0, 1)
+	writeImage(bw, &argbImage{pix: pix, width: width}, true)
+
+	return writeContainer(w, bw.bytes())
+}
+
+type argbImage struct {
+	// pix holds the pixels in R, G, B, A byte order, like image.NRGBA.
+	pix   []byte
+	width int
+}
+
+func (a *argbImage) pixel(i int) uint32 {
+	return binary.LittleEndian.Uint32(a.pix[4*i:])
+}
+
+func hasAlpha(pix []byte) bool {
+	for i := 3; i < len(pix); i += 4 {
+		if pix[i] != 0xff {
+			return true
+		}
+	}
+	return false
+}
+
+func subtractGreen(pix []byte) {
+	for i := 0; i < len(pix); i += 4 {
+		pix[i] -= pix[i+1]
+		pix[i+2] -= pix[i+1]
+	}
+}
+
+// predict replaces pix with the residuals of the predictor chosen for each
+// tile and returns the image of the chosen predictors.
+func predict(pix []byte, width int, height int) *argbImage {
+	tilesX := (width + 1<<predictorBits - 1) >> predictorBits
+	tilesY := (height + 1<<predictorBits - 1) >> predictorBits
+	modes := &argbImage{pix: make([]byte, 4*tilesX*tilesY), width: tilesX}
+	residuals := make([]byte, len(pix))
+
+	for ty := 0; ty < tilesY; ty++ {
+		for tx := 0; tx < tilesX; tx++ {
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				forEachTilePixel(tx, ty, width, height, func(x, y int) {
+					p := 4 * (y*width + x)
+					prediction := predictPixel(pix, width, x, y, mode)
+					for c := 0; c < 4; c++ {
+						cost += residualCost(pix[p+c] - prediction[c])
+					}
+				})
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+
+			modes.pix[4*(ty*tilesX+tx)+1] = byte(best)
+			modes.pix[4*(ty*tilesX+tx)+3] = 0xff
+			forEachTilePixel(tx, ty, width, height, func(x, y int) {
+				p := 4 * (y*width + x)
+				prediction := predictPixel(pix, width, x, y, best)
+				for c := 0; c < 4; c++ {
+					residuals[p+c] = pix[p+c] - prediction[c]
+				}
+			})
+		}
+	}
+
+	copy(pix, residuals)
+	return modes
+}
+
+func forEachTilePixel(tx int, ty int, width int, height int, f func(x, y int)) {
+	for y := ty << predictorBits; y < min((ty+1)<<predictorBits, height); y++ {
+		for x := tx << predictorBits; x < min((tx+1)<<predictorBits, width); x++ {
+			f(x, y)
+		}
+	}
+}
+
+// residualCost approximates the cost of coding a residual by its distance
+// from zero.
+func residualCost(r byte) int {
+	if r >= 128 {
+		return 256 - int(r)
+	}
+	return int(r)
+}
+
+// predictPixel predicts the pixel at x, y from the original pixels around
+// it. The first row always uses L, the first column T and the first pixel
+// opaque black, whatever the mode.
+func predictPixel(pix []byte, width int, x int, y int, mode int) [4]byte {
+	p := 4 * (y*width + x)
+	switch {
+	case x == 0 && y == 0:
+		return [4]byte{0, 0, 0, 0xff}
+	case y == 0:
+		mode = 1
+	case x == 0:
+		mode = 2
+	}
+
+	var l, t, tl [4]byte
+	if x > 0 {
+		copy(l[:], pix[p-4:p])
+	}
+	if y > 0 {
+		copy(t[:], pix[p-4*width:p-4*width+4])
+	}
+	if x > 0 && y > 0 {
+		copy(tl[:], pix[p-4*width-4:p-4*width])
+	}
+
+	var prediction [4]byte
+	switch mode {
+	case 1:
+		return l
+	case 2:
+		return t
+	case 7:
+		for c := range prediction {
+			prediction[c] = average2(l[c], t[c])
+		}
+	case 11:
+		pL, pT := 0, 0
+		for c := range prediction {
+			pL += absDiff(t[c], tl[c])
+			pT += absDiff(l[c], tl[c])
+		}
+		if pL < pT {
+			return l
+		}
+		return t
+	case 12:
+		for c := range prediction {
+			prediction[c] = clamp(int(l[c]) + int(t[c]) - int(tl[c]))
+		}
+	}
+	return prediction
+}
+
+func average2(a byte, b byte) byte {
+	return byte((int(a) + int(b)) / 2)
+}
+
+func absDiff(a byte, b byte) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
+
+func clamp(v int) byte {
+	return byte(max(0, min(255, v)))
+}
+
+// token is either a literal pixel or a run of the pixel to the left, coded
+// as a length symbol and its extra bits.
+type token struct {
+	pixel     uint32
+	length    int
+	extra     uint32
+	extraBits int
+	symbol    int
+}
+
+// writeImage entropy codes img. Only the main image may carry a meta prefix
+// code, which is never used here.
+func writeImage(bw *bitWriter, img *argbImage, topLevel bool) {
+	tokens := tokenize(img)
+
+	var histograms [5][]int
+	for i, size := range []int{nLiteralCodes + nLengthCodes, nLiteralCodes, nLiteralCodes, nLiteralCodes, nDistanceCodes} {
+		histograms[i] = make([]int, size)
+	}
+	for _, t := range tokens {
+		if t.length > 0 {
+			histograms[0][t.symbol]++
+			histograms[4][distanceLeft-1]++
+			continue
+		}
+		histograms[0][t.pixel>>8&0xff]++
+		histograms[1][t.pixel&0xff]++
+		histograms[2][t.pixel>>16&0xff]++
+		histograms[3][t.pixel>>24]++
+	}
+
+	bw.writeBits(0, 1) // No color cache.
+	if topLevel {
+		bw.writeBits(0, 1) // No meta prefix codes.
+	}
+
+	var codes [5]prefixCode
+	for i, histogram := range histograms {
+		codes[i] = writePrefixCode(bw, histogram)
+	}
+
+	for _, t := range tokens {
+		if t.length > 0 {
+			codes[0].write(bw, t.symbol)
+			bw.writeBits(t.extra, t.extraBits)
+			codes[4].write(bw, distanceLeft-1)
+			continue
+		}
+		codes[0].write(bw, int(t.pixel>>8&0xff))
+		codes[1].write(bw, int(t.pixel&0xff))
+		codes[2].write(bw, int(t.pixel>>16&0xff))
+		codes[3].write(bw, int(t.pixel>>24))
+	}
+}
+
+// tokenize turns runs of a repeated pixel into backward references to the
+// pixel to the left and leaves every other pixel a literal.
+func tokenize(img *argbImage) []token {
+	n := len(img.pix) / 4
+	tokens := make([]token, 0, n)
+	for i := 0; i < n; {
+		run := 0
+		if i > 0 {
+			previous := img.pixel(i - 1)
+			for i+run < n && run < maxRunLength && img.pixel(i+run) == previous {
+				run++
+			}
+		}
+		if run >= minRunLength {
+			symbol, extra, extraBits := prefixEncode(run)
+			tokens = append(tokens, token{length: run, symbol: nLiteralCodes + symbol, extra: extra, extraBits: extraBits})
+			i += run
+			continue
+		}
+		tokens = append(tokens, token{pixel: img.pixel(i)})
+		i++
+	}
+	return tokens
+}
+
+// prefixEncode splits a backward reference length or distance into its
+// prefix symbol and extra bits, the inverse of section 5.2.2.
+func prefixEncode(value int) (symbol int, extra uint32, extraBits int) {
+	if value <= 4 {
+		return value - 1, 0, 0
+	}
+	v := value - 1
+	highest := 0
+	for v>>(highest+1) != 0 {
+		highest++
+	}
+	second := v >> (highest - 1) & 1
+	extraBits = highest - 1
+	return 2*highest + second, uint32(v) & (1<<extraBits - 1), extraBits
+}
+
+// prefixCode holds the canonical codes of an alphabet, stored bit reversed
+// as the bit stream is read least significant bit first.
+type prefixCode struct {
+	codes   []uint32
+	lengths []int
+}
+
+func (c prefixCode) write(bw *bitWriter, symbol int) {
+	bw.writeBits(c.codes[symbol], c.lengths[symbol])
+}
+
+// writePrefixCode writes the code for histogram and returns it. Alphabets
+// with one or two used symbols get a simple code.
+func writePrefixCode(bw *bitWriter, histogram []int) prefixCode {
+	var used []int
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, symbol)
+		}
+	}
+	if len(used) == 0 {
+		used = []int{0}
+	}
+
+	code := prefixCode{codes: make([]uint32, len(histogram)), lengths: make([]int, len(histogram))}
+	if len(used) <= 2 && used[len(used)-1] < nLiteralCodes {
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint32(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+			code.codes[used[1]], code.lengths[used[1]] = 1, 1
+			code.lengths[used[0]] = 1
+		}
+		return code
+	}
+
+	lengths := codeLengths(histogram, maxCodeLength)
+	bw.writeBits(0, 1)
+	writeCodeLengths(bw, lengths)
+	return newPrefixCode(lengths)
+}
+
+// newPrefixCode assigns canonical codes to the lengths. A single used symbol
+// takes no bits at all.
+func newPrefixCode(lengths []int) prefixCode {
+	code := prefixCode{codes: make([]uint32, len(lengths)), lengths: make([]int, len(lengths))}
+
+	used := 0
+	var count [maxCodeLength + 1]int
+	for _, length := range lengths {
+		if length > 0 {
+			count[length]++
+			used++
+		}
+	}
+	if used == 1 {
+		return code
+	}
+
+	var next [maxCodeLength + 1]uint32
+	for length, c := 1, uint32(0); length <= maxCodeLength; length++ {
+		c = (c + uint32(count[length-1])) << 1
+		next[length] = c
+	}
+
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+		code.codes[symbol] = reverse(next[length], length)
+		code.lengths[symbol] = length
+		next[length]++
+	}
+	return code
+}
+
+func reverse(code uint32, length int) uint32 {
+	reversed := uint32(0)
+	for i := 0; i < length; i++ {
+		reversed = reversed<<1 | code>>i&1
+	}
+	return reversed
+}
+
+// writeCodeLengths writes lengths with the code length code, using symbols
+// 16 to 18 for repeated lengths.
+func writeCodeLengths(bw *bitWriter, lengths []int) {
+	type lengthToken struct {
+		symbol    int
+		extra     uint32
+		extraBits int
+	}
+
+	var tokens []lengthToken
+	for i := 0; i < len(lengths); {
+		length := lengths[i]
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == length {
+			run++
+		}
+		i += run
+
+		if length == 0 {
+			for run >= 3 {
+				if run >= 11 {
+					n := min(run, 138)
+					tokens = append(tokens, lengthToken{18, uint32(n - 11), 7})
+					run -= n
+				} else {
+					n := min(run, 10)
+					tokens = append(tokens, lengthToken{17, uint32(n - 3), 3})
+					run -= n
+				}
+			}
+			for ; run > 0; run-- {
+				tokens = append(tokens, lengthToken{symbol: 0})
+			}
+			continue
+		}
+
+		// Symbol 16 repeats the previous length, so one literal comes first.
+		tokens = append(tokens, lengthToken{symbol: length})
+		run--
+		for run >= 3 {
+			n := min(run, 6)
+			tokens = append(tokens, lengthToken{16, uint32(n - 3), 2})
+			run -= n
+		}
+		for ; run > 0; run-- {
+			tokens = append(tokens, lengthToken{symbol: length})
+		}
+	}
+
+	histogram := make([]int, len(codeLengthCodeOrder))
+	for _, t := range tokens {
+		histogram[t.symbol]++
+	}
+	codeLengthCodeLengths := codeLengths(histogram, maxCodeLengthCodeLength)
+
+	n := len(codeLengthCodeOrder)
+	for n > 4 && codeLengthCodeLengths[codeLengthCodeOrder[n-1]] == 0 {
+		n--
+	}
+	bw.writeBits(uint32(n-4), 4)
+	for _, symbol := range codeLengthCodeOrder[:n] {
+		bw.writeBits(uint32(codeLengthCodeLengths[symbol]), 3)
+	}
+
+	bw.writeBits(0, 1) // Code lengths for the whole alphabet follow.
+	code := newPrefixCode(codeLengthCodeLengths)
+	for _, t := range tokens {
+		code.write(bw, t.symbol)
+		bw.writeBits(t.extra, t.extraBits)
+	}
+}
+
+// codeLengths returns Huffman code lengths for histogram no longer than
+// limit. Should the optimal code be too deep, rare symbols are counted as
+// more frequent until it fits.
+func codeLengths(histogram []int, limit int) []int {
+	for minCount := 1; ; minCount *= 2 {
+		lengths := huffmanLengths(histogram, minCount)
+		deepest := 0
+		for _, length := range lengths {
+			deepest = max(deepest, length)
+		}
+		if deepest <= limit {
+			return lengths
+		}
+	}
+}
+
+type huffmanNode struct {
+	count       int
+	symbol      int
+	left, right *huffmanNode
+}
+
+type huffmanQueue []*huffmanNode
+
+func (q huffmanQueue) Len() int { return len(q) }
+func (q huffmanQueue) Less(i, j int) bool {
+	if q[i].count != q[j].count {
+		return q[i].count < q[j].count
+	}
+	return q[i].symbol < q[j].symbol
+}
+func (q huffmanQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *huffmanQueue) Push(x any)   { *q = append(*q, x.(*huffmanNode)) }
+func (q *huffmanQueue) Pop() any {
+	old := *q
+	node := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return node
+}
+
+func huffmanLengths(histogram []int, minCount int) []int {
+	lengths := make([]int, len(histogram))
+
+	queue := huffmanQueue{}
+	for symbol, count := range histogram {
+		if count > 0 {
+			queue = append(queue, &huffmanNode{count: max(count, minCount), symbol: symbol})
+		}
+	}
+	switch len(queue) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[queue[0].symbol] = 1
+		return lengths
+	}
+
+	sort.Sort(queue)
+	heap.Init(&queue)
+	for queue.Len() > 1 {
+		left := heap.Pop(&queue).(*huffmanNode)
+		right := heap.Pop(&queue).(*huffmanNode)
+		heap.Push(&queue, &huffmanNode{count: left.count + right.count, symbol: min(left.symbol, right.symbol), left: left, right: right})
+	}
+
+	var walk func(node *huffmanNode, depth int)
+	walk = func(node *huffmanNode, depth int) {
+		if node.left == nil {
+			lengths[node.symbol] = depth
+			return
+		}
+		walk(node.left, depth+1)
+		walk(node.right, depth+1)
+	}
+	walk(queue[0], 0)
+	return lengths
+}
+
+func writeContainer(w io.Writer, data []byte) error {
+	var buf bytes.Buffer
+	padding := len(data) & 1
+
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(4+8+len(data)+padding))
+	buf.WriteString("WEBPVP8L")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	if padding == 1 {
+		buf.WriteByte(0)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// bitWriter packs bits least significant bit first.
+type bitWriter struct {
+	buf   []byte
+	bits  uint64
+	nBits int
+}
+
+func (b *bitWriter) writeBits(value uint32, n int) {
+	b.bits |= uint64(value&(1<<n-1)) << b.nBits
+	b.nBits += n
+	for b.nBits >= 8 {
+		b.buf = append(b.buf, byte(b.bits))
+		b.bits >>= 8
+		b.nBits -= 8
+	}
+}
+
+func (b *bitWriter) bytes() []byte {
+	if b.nBits > 0 {
+		b.buf = append(b.buf, byte(b.bits))
+		b.bits, b.nBits = 0, 0
+	}
+	return b.buf
+}
+
+func boolBit(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
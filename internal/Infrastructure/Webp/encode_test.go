@@ -0,0 +1,91 @@
+package webp
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xwebp "golang.org/x/image/webp"
+)
+
+func roundTrip(t *testing.T, m image.Image) image.Image {
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, m))
+
+	decoded, err := xwebp.Decode(&buf)
+	assert.NoError(t, err)
+	return decoded
+}
+
+func assertSamePixels(t *testing.T, expected image.Image, actual image.Image) {
+	assert.Equal(t, expected.Bounds().Size(), actual.Bounds().Size())
+	for y := 0; y < expected.Bounds().Dy(); y++ {
+		for x := 0; x < expected.Bounds().Dx(); x++ {
+			e := color.NRGBAModel.Convert(expected.At(expected.Bounds().Min.X+x, expected.Bounds().Min.Y+y))
+			a := color.NRGBAModel.Convert(actual.At(actual.Bounds().Min.X+x, actual.Bounds().Min.Y+y))
+			if !assert.Equal(t, e, a, "pixel %d,%d", x, y) {
+				return
+			}
+		}
+	}
+}
+
+func TestEncodeGradient(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 67, 45))
+	for y := 0; y < 45; y++ {
+		for x := 0; x < 67; x++ {
+			m.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 3), G: uint8(y * 5), B: uint8(x + y), A: 0xff})
+		}
+	}
+
+	assertSamePixels(t, m, roundTrip(t, m))
+}
+
+func TestEncodeNoise(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	m := image.NewNRGBA(image.Rect(0, 0, 50, 31))
+	random.Read(m.Pix)
+
+	assertSamePixels(t, m, roundTrip(t, m))
+}
+
+func TestEncodeFlatImage(t *testing.T) {
+	m := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	for i := range m.Pix {
+		m.Pix[i] = 0xff
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, m))
+	assert.Less(t, buf.Len(), 200)
+
+	decoded, err := xwebp.Decode(&buf)
+	assert.NoError(t, err)
+	assertSamePixels(t, m, decoded)
+}
+
+func TestEncodeSubImage(t *testing.T) {
+	m := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	for i := range m.Pix {
+		m.Pix[i] = uint8(i)
+	}
+	sub := m.SubImage(image.Rect(3, 4, 10, 9))
+
+	assertSamePixels(t, sub, roundTrip(t, sub))
+}
+
+func TestEncodeSinglePixel(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	m.SetNRGBA(0, 0, color.NRGBA{R: 10, G: 20, B: 30, A: 40})
+
+	assertSamePixels(t, m, roundTrip(t, m))
+}
+
+func TestEncodeTooLarge(t *testing.T) {
+	err := Encode(&bytes.Buffer{}, image.NewNRGBA(image.Rect(0, 0, maxDimension+1, 1)))
+
+	assert.ErrorIs(t, err, ErrImageTooLarge)
+}
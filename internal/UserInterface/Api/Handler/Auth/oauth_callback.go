@@ -1,6 +1,7 @@
 package auth
 
 import (
+	authorization "main/internal/Application/Authorization"
 	command "main/internal/Application/Command/User"
 	query "main/internal/Application/Query/User"
 	open_telemetry "main/internal/Infrastructure/OpenTelemetry"
@@ -75,7 +76,9 @@ func OauthCallback(
 		return
 	}
 
-	commandBus.Send(ctx.Request.Context(), command.NewCreateUserCommand(
+	// Nobody is signed in yet, so the sign-up is sent by the system actor.
+	systemCtx := authorization.WithActor(ctx.Request.Context(), authorization.SystemActor)
+	commandBus.Send(systemCtx, command.NewCreateUserCommand(
 		id,
 		gothUser.Email,
 		"",
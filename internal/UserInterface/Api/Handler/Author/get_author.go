@@ -0,0 +1,33 @@
+package author
+
+import (
+	user_query "main/internal/Application/Query/User"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetAuthor(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	authorId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid author ID"})
+		return
+	}
+
+	author, err := queryBus.Execute(ctx.Request.Context(), user_query.NewGetAuthorQuery(authorId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Author not found"})
+		return
+	}
+
+	authorView, ok := author.(view.AuthorView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid author data"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, authorView)
+}
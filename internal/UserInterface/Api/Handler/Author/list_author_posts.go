@@ -0,0 +1,41 @@
+package author
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListAuthorPosts lists the published posts of an author, newest first.
+func ListAuthorPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	authorId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid author ID"})
+		return
+	}
+
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	q := post_query.NewFindPostsByAuthorQuery(pageInt, pageSizeInt, authorId, "", uuid.Nil)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
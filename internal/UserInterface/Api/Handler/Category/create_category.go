@@ -0,0 +1,58 @@
+package category
+
+import (
+	category_command "main/internal/Application/Command/Category"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateCategory adds a category below parent_id, or at the root when it is
+// left out. Only admins manage categories.
+func CreateCategory(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.CreateCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, ok := requireAdmin(ctx, queryBus); !ok {
+		return
+	}
+
+	categories, ok := findCategoryTree(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	categoryId := uuid.MustParse(req.Id)
+	if slugTaken(categories, req.Slug, categoryId) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Category slug is already taken"})
+		return
+	}
+
+	var parentId *uuid.UUID
+	if req.ParentId != nil {
+		id := uuid.MustParse(*req.ParentId)
+		if _, found := findInTree(categories, id); !found {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Parent category not found"})
+			return
+		}
+		parentId = &id
+	}
+
+	command := category_command.NewCreateCategoryCommand(
+		categoryId,
+		parentId,
+		req.Name,
+		req.Slug,
+	)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Category created"})
+}
@@ -0,0 +1,45 @@
+package category
+
+import (
+	category_command "main/internal/Application/Command/Category"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteCategory removes a category without subcategories. Its posts are
+// left without a category.
+func DeleteCategory(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	categoryId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		return
+	}
+
+	if _, ok := requireAdmin(ctx, queryBus); !ok {
+		return
+	}
+
+	categories, ok := findCategoryTree(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	category, found := findInTree(categories, categoryId)
+	if !found {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+		return
+	}
+
+	if len(category.Children) > 0 {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Category has subcategories"})
+		return
+	}
+
+	commandBus.Send(ctx.Request.Context(), category_command.NewDeleteCategoryCommand(categoryId))
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Category deleted"})
+}
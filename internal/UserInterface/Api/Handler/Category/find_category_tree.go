@@ -0,0 +1,56 @@
+package category
+
+import (
+	category_query "main/internal/Application/Query/Category"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findCategoryTree loads the whole category tree. On failure the error
+// response is already written and false is returned.
+func findCategoryTree(ctx *gin.Context, queryBus query_bus.QueryBus) ([]view.CategoryView, bool) {
+	result, err := queryBus.Execute(ctx.Request.Context(), category_query.NewListCategoriesQuery())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	categories, ok := result.([]view.CategoryView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid category data"})
+		return nil, false
+	}
+
+	return categories, true
+}
+
+// findInTree looks the category with the given id up in the tree.
+func findInTree(categories []view.CategoryView, id uuid.UUID) (view.CategoryView, bool) {
+	for _, category := range categories {
+		if category.Id == id {
+			return category, true
+		}
+		if found, ok := findInTree(category.Children, id); ok {
+			return found, true
+		}
+	}
+	return view.CategoryView{}, false
+}
+
+// slugTaken tells whether a category other than the one with the given id
+// already uses the slug.
+func slugTaken(categories []view.CategoryView, slug string, id uuid.UUID) bool {
+	for _, category := range categories {
+		if category.Slug == slug && category.Id != id {
+			return true
+		}
+		if slugTaken(category.Children, slug, id) {
+			return true
+		}
+	}
+	return false
+}
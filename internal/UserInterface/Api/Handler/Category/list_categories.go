@@ -0,0 +1,18 @@
+package category
+
+import (
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListCategories returns the category tree, root categories first.
+func ListCategories(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	categories, ok := findCategoryTree(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, categories)
+}
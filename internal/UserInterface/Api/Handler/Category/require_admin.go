@@ -0,0 +1,35 @@
+package category
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requireAdmin answers 403 early for users who may not manage the category
+// tree; the command handlers check the permission again. On failure the
+// error response is already written and false is returned.
+func requireAdmin(ctx *gin.Context, queryBus query_bus.QueryBus) (view.UserView, bool) {
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.UserView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.UserView{}, false
+	}
+
+	if !authorization.Can(userView, entity.PermissionManageCategories) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to manage categories"})
+		return view.UserView{}, false
+	}
+
+	return userView, true
+}
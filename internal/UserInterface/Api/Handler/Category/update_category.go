@@ -0,0 +1,74 @@
+package category
+
+import (
+	category_command "main/internal/Application/Command/Category"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UpdateCategory renames a category and moves it, with its subcategories,
+// below parent_id. Leaving parent_id out makes it a root category.
+func UpdateCategory(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	categoryId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		return
+	}
+
+	var req request.UpdateCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, ok := requireAdmin(ctx, queryBus); !ok {
+		return
+	}
+
+	categories, ok := findCategoryTree(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	category, found := findInTree(categories, categoryId)
+	if !found {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Category not found"})
+		return
+	}
+
+	if slugTaken(categories, req.Slug, categoryId) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Category slug is already taken"})
+		return
+	}
+
+	var parentId *uuid.UUID
+	if req.ParentId != nil {
+		id := uuid.MustParse(*req.ParentId)
+		if _, found := findInTree(categories, id); !found {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Parent category not found"})
+			return
+		}
+		if _, below := findInTree(category.Children, id); below || id == categoryId {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": entity.ErrCategoryCycle.Error()})
+			return
+		}
+		parentId = &id
+	}
+
+	command := category_command.NewUpdateCategoryCommand(
+		categoryId,
+		parentId,
+		req.Name,
+		req.Slug,
+	)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Category updated"})
+}
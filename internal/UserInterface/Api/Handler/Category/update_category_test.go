@@ -0,0 +1,139 @@
+package category
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type UpdateCategoryTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	ParentUuid uuid.UUID
+	ChildUuid  uuid.UUID
+	DesignUuid uuid.UUID
+}
+
+func (s *UpdateCategoryTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.updateCategoryCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM categories WHERE parent_id IS NOT NULL")
+	test.GetTestContainer().DB.Exec("DELETE FROM categories")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	adminUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'adminprovideruser', 'admin@example.com')
+	`, adminUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO user_roles (user_id, role, granted_at) VALUES (?, 'admin', NOW())", adminUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, uuid.New().String())
+	s.ParentUuid = uuid.New()
+	s.ChildUuid = uuid.New()
+	s.DesignUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO categories (id, created_at, updated_at, parent_id, name, slug, path)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', NULL, 'Programming', 'programming', ?)
+	`, s.ParentUuid.String(), "/"+s.ParentUuid.String()+"/")
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO categories (id, created_at, updated_at, parent_id, name, slug, path)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', ?, 'Go', 'go', ?)
+	`, s.ChildUuid.String(), s.ParentUuid.String(), "/"+s.ParentUuid.String()+"/"+s.ChildUuid.String()+"/")
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO categories (id, created_at, updated_at, parent_id, name, slug, path)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', NULL, 'Design', 'design', ?)
+	`, s.DesignUuid.String(), "/"+s.DesignUuid.String()+"/")
+}
+
+func (s *UpdateCategoryTestSuite) newRequest(providerUserId string, email string, categoryId uuid.UUID, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"PUT",
+		"/api/v1/categories/"+categoryId.String(),
+		strings.NewReader(body),
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: categoryId.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *UpdateCategoryTestSuite) TestUpdateCategory() {
+	s.newRequest("adminprovideruser", "admin@example.com", s.ParentUuid, `{"parent_id":"`+s.DesignUuid.String()+`","name":"Programming","slug":"programming"}`)
+
+	UpdateCategory(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Category updated"}`, s.W.Body.String())
+	count := test.GetCommandCount("updateCategoryCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *UpdateCategoryTestSuite) TestUpdateCategoryNotAdmin() {
+	s.newRequest("testprovideruser", "test@example.com", s.ParentUuid, `{"name":"Programming","slug":"programming"}`)
+
+	UpdateCategory(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to manage categories"}`, s.W.Body.String())
+	count := test.GetCommandCount("updateCategoryCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *UpdateCategoryTestSuite) TestUpdateCategoryBelowDescendant() {
+	s.newRequest("adminprovideruser", "admin@example.com", s.ParentUuid, `{"parent_id":"`+s.ChildUuid.String()+`","name":"Programming","slug":"programming"}`)
+
+	UpdateCategory(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"a category cannot be moved below itself or one of its descendants"}`, s.W.Body.String())
+	count := test.GetCommandCount("updateCategoryCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *UpdateCategoryTestSuite) TestUpdateCategorySlugTaken() {
+	s.newRequest("adminprovideruser", "admin@example.com", s.ChildUuid, `{"parent_id":"`+s.ParentUuid.String()+`","name":"Go","slug":"design"}`)
+
+	UpdateCategory(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Category slug is already taken"}`, s.W.Body.String())
+}
+
+func TestUpdateCategoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCategoryTestSuite))
+}
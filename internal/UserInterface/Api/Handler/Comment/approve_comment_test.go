@@ -0,0 +1,115 @@
+package comment
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type ApproveCommentTestSuite struct {
+	suite.Suite
+	CommandBus  *cqrs.CommandBus
+	QueryBus    query_bus.QueryBus
+	Ctx         *gin.Context
+	W           *httptest.ResponseRecorder
+	PubSubDb    *sql.DB
+	PostUuid    uuid.UUID
+	CommentUuid uuid.UUID
+}
+
+func (s *ApproveCommentTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.approveCommentCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM comments")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	postAuthorUuid := uuid.New()
+	commenterUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, postAuthorUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, commenterUuid.String())
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'published')`,
+		s.PostUuid.String(),
+		postAuthorUuid.String(),
+	)
+	s.CommentUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO comments (id, created_at, updated_at, post_id, author_id, content, status)
+	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', $2, $3, 'testcomment', 'pending')`,
+		s.CommentUuid.String(),
+		s.PostUuid.String(),
+		commenterUuid.String(),
+	)
+}
+
+func (s *ApproveCommentTestSuite) newRequest(providerUserId string, email string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/posts/"+s.PostUuid.String()+"/comments/"+s.CommentUuid.String()+"/approve",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.PostUuid.String()},
+		gin.Param{Key: "commentId", Value: s.CommentUuid.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *ApproveCommentTestSuite) TestApproveComment() {
+	s.newRequest("testprovideruser", "test@example.com")
+
+	ApproveComment(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Comment approved"}`, s.W.Body.String())
+	count := test.GetCommandCount("approveCommentCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *ApproveCommentTestSuite) TestApproveCommentNotPostAuthor() {
+	s.newRequest("otherprovideruser", "other@example.com")
+
+	ApproveComment(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to moderate comments of this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("approveCommentCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func TestApproveCommentTestSuite(t *testing.T) {
+	suite.Run(t, new(ApproveCommentTestSuite))
+}
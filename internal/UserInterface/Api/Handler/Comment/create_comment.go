@@ -0,0 +1,69 @@
+package comment
+
+import (
+	"errors"
+	comment_command "main/internal/Application/Command/Comment"
+	comment_query "main/internal/Application/Query/Comment"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func CreateComment(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.CreateCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	postView, ok := findVisiblePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	if postView.Status != string(entity.PostStatusPublished) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Comments can only be posted on published posts"})
+		return
+	}
+
+	var parentId *uuid.UUID
+	if req.ParentId != nil {
+		parsedParentId := uuid.MustParse(*req.ParentId)
+		parent, err := queryBus.Execute(ctx.Request.Context(), comment_query.NewGetCommentQuery(parsedParentId))
+		parentView, ok := parent.(view.CommentView)
+		if err != nil || !ok || parentView.PostId != postView.Id || parentView.Status != string(entity.CommentStatusApproved) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parent comment"})
+			return
+		}
+		parentId = &parsedParentId
+	}
+
+	command := comment_command.NewCreateCommentCommand(
+		uuid.MustParse(req.Id),
+		postView.Id,
+		userView.Id,
+		parentId,
+		req.Content,
+	)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Comment created"})
+}
@@ -0,0 +1,81 @@
+package comment
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	comment_query "main/internal/Application/Query/Comment"
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findModeratedComment loads the comment identified by the :commentId route
+// param on the post identified by :id and makes sure the current user wrote
+// the post, which makes them the moderator of its comments, or may moderate
+// the comments on every post. On failure the error response is already
+// written and false is returned.
+func findModeratedComment(ctx *gin.Context, queryBus query_bus.QueryBus) (view.CommentView, bool) {
+	postId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return view.CommentView{}, false
+	}
+
+	commentId, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return view.CommentView{}, false
+	}
+
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.CommentView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.CommentView{}, false
+	}
+
+	post, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostQuery(postId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return view.CommentView{}, false
+	}
+
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return view.CommentView{}, false
+	}
+
+	if postView.AuthorId != userView.Id && !authorization.Can(userView, entity.PermissionModerateComments) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to moderate comments of this post"})
+		return view.CommentView{}, false
+	}
+
+	comment, err := queryBus.Execute(ctx.Request.Context(), comment_query.NewGetCommentQuery(commentId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+		return view.CommentView{}, false
+	}
+
+	commentView, ok := comment.(view.CommentView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid comment data"})
+		return view.CommentView{}, false
+	}
+
+	if commentView.PostId != postId {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+		return view.CommentView{}, false
+	}
+
+	return commentView, true
+}
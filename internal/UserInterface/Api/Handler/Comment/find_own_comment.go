@@ -0,0 +1,64 @@
+package comment
+
+import (
+	"errors"
+	comment_query "main/internal/Application/Query/Comment"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findOwnComment loads the comment identified by the :commentId route param on
+// the post identified by :id and makes sure the current user wrote it. On
+// failure the error response is already written and false is returned.
+func findOwnComment(ctx *gin.Context, queryBus query_bus.QueryBus, action string) (view.CommentView, bool) {
+	postId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return view.CommentView{}, false
+	}
+
+	commentId, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return view.CommentView{}, false
+	}
+
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.CommentView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.CommentView{}, false
+	}
+
+	comment, err := queryBus.Execute(ctx.Request.Context(), comment_query.NewGetCommentQuery(commentId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+		return view.CommentView{}, false
+	}
+
+	commentView, ok := comment.(view.CommentView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid comment data"})
+		return view.CommentView{}, false
+	}
+
+	if commentView.PostId != postId {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+		return view.CommentView{}, false
+	}
+
+	if commentView.AuthorId != userView.Id {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to " + action + " this comment"})
+		return view.CommentView{}, false
+	}
+
+	return commentView, true
+}
@@ -0,0 +1,59 @@
+package comment
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findVisiblePost loads the post identified by the :id route param. Posts that
+// are not published are only visible to their author and collaborators. On
+// failure the error response is already written and false is returned.
+func findVisiblePost(ctx *gin.Context, queryBus query_bus.QueryBus) (view.PostView, bool) {
+	postId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return view.PostView{}, false
+	}
+
+	post, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostQuery(postId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return view.PostView{}, false
+	}
+
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return view.PostView{}, false
+	}
+
+	if postView.Status != string(entity.PostStatusPublished) {
+		user, err := session.GetCurrentUser(ctx, queryBus)
+		if err != nil || !takesPartIn(postView, user.Id) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return view.PostView{}, false
+		}
+	}
+
+	return postView, true
+}
+
+// takesPartIn tells whether the user wrote the post or collaborates on it.
+func takesPartIn(postView view.PostView, userId uuid.UUID) bool {
+	if postView.AuthorId == userId {
+		return true
+	}
+	for _, collaborator := range postView.Collaborators {
+		if collaborator.UserId == userId {
+			return true
+		}
+	}
+	return false
+}
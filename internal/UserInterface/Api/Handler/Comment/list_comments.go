@@ -0,0 +1,38 @@
+package comment
+
+import (
+	comment_query "main/internal/Application/Query/Comment"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ListComments(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	postView, ok := findVisiblePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	q := comment_query.NewListCommentsByPostQuery(postView.Id, pageInt, pageSizeInt)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
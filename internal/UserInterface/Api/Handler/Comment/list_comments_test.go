@@ -0,0 +1,124 @@
+package comment
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type ListCommentsTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+	PostUuid uuid.UUID
+	UserUuid uuid.UUID
+}
+
+func (s *ListCommentsTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM comments")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.UserUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, s.UserUuid.String())
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'published')`,
+		s.PostUuid.String(),
+		s.UserUuid.String(),
+	)
+}
+
+func (s *ListCommentsTestSuite) insertComment(id uuid.UUID, parentId *uuid.UUID, createdAt string, content string, status string) {
+	var parent any
+	if parentId != nil {
+		parent = parentId.String()
+	}
+	test.GetTestContainer().DB.Exec(`INSERT INTO comments (id, created_at, updated_at, post_id, author_id, parent_id, content, status)
+	VALUES ($1, $2, $2, $3, $4, $5, $6, $7)`,
+		id.String(),
+		createdAt,
+		s.PostUuid.String(),
+		s.UserUuid.String(),
+		parent,
+		content,
+		status,
+	)
+}
+
+func (s *ListCommentsTestSuite) newRequest(postId string, query string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts/"+postId+"/comments"+query,
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: postId,
+		},
+	}
+}
+
+func (s *ListCommentsTestSuite) TestListCommentsThreaded() {
+	firstUuid := uuid.New()
+	replyUuid := uuid.New()
+	s.insertComment(firstUuid, nil, "2021-01-02 00:00:00", "first", "approved")
+	s.insertComment(replyUuid, &firstUuid, "2021-01-03 00:00:00", "reply", "approved")
+	s.insertComment(uuid.New(), &replyUuid, "2021-01-04 00:00:00", "nested", "approved")
+	s.insertComment(uuid.New(), &firstUuid, "2021-01-04 00:00:00", "held", "pending")
+	s.insertComment(uuid.New(), nil, "2021-01-05 00:00:00", "second", "approved")
+	s.insertComment(uuid.New(), nil, "2021-01-01 00:00:00", "spam", "rejected")
+	s.newRequest(s.PostUuid.String(), "?page=1&pageSize=1")
+
+	ListComments(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	body := s.W.Body.String()
+	assert.Contains(s.T(), body, `"total":2`)
+	assert.Contains(s.T(), body, `"content":"first"`)
+	assert.Contains(s.T(), body, `"content":"reply"`)
+	assert.Contains(s.T(), body, `"content":"nested"`)
+	assert.NotContains(s.T(), body, `"content":"second"`)
+	assert.NotContains(s.T(), body, `"content":"held"`)
+	assert.NotContains(s.T(), body, `"content":"spam"`)
+	assert.Contains(s.T(), body, `"parent_id":"`+replyUuid.String()+`"`)
+}
+
+func (s *ListCommentsTestSuite) TestListCommentsDraftPost() {
+	test.GetTestContainer().DB.Exec("UPDATE posts SET status = 'draft'")
+	s.newRequest(s.PostUuid.String(), "")
+
+	ListComments(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
+func (s *ListCommentsTestSuite) TestListCommentsInvalidPostId() {
+	s.newRequest("invalid-uuid", "")
+
+	ListComments(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Invalid post ID"}`, s.W.Body.String())
+}
+
+func TestListCommentsTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCommentsTestSuite))
+}
@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"errors"
+	comment_query "main/internal/Application/Query/Comment"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ListPendingComments(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	user, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	q := comment_query.NewListPendingCommentsQuery(user.Id, pageInt, pageSizeInt)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,22 @@
+package comment
+
+import (
+	comment_command "main/internal/Application/Command/Comment"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+func RejectComment(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	commentView, ok := findModeratedComment(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	command := comment_command.NewRejectCommentCommand(commentView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Comment rejected"})
+}
@@ -0,0 +1,29 @@
+package comment
+
+import (
+	comment_command "main/internal/Application/Command/Comment"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+func UpdateComment(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.UpdateCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	commentView, ok := findOwnComment(ctx, queryBus, "edit")
+	if !ok {
+		return
+	}
+
+	command := comment_command.NewEditCommentCommand(commentView.Id, req.Content)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Comment updated"})
+}
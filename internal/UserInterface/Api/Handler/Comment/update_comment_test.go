@@ -0,0 +1,126 @@
+package comment
+
+import (
+	"bytes"
+	"database/sql"
+	"io"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type UpdateCommentTestSuite struct {
+	suite.Suite
+	CommandBus  *cqrs.CommandBus
+	QueryBus    query_bus.QueryBus
+	Ctx         *gin.Context
+	W           *httptest.ResponseRecorder
+	PubSubDb    *sql.DB
+	PostUuid    uuid.UUID
+	CommentUuid uuid.UUID
+}
+
+func (s *UpdateCommentTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.editCommentCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM comments")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, uuid.New().String())
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'published')`,
+		s.PostUuid.String(),
+		userUuid.String(),
+	)
+	s.CommentUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO comments (id, created_at, updated_at, post_id, author_id, content)
+	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', $2, $3, 'testcomment')`,
+		s.CommentUuid.String(),
+		s.PostUuid.String(),
+		userUuid.String(),
+	)
+}
+
+func (s *UpdateCommentTestSuite) newRequest(commentId string, providerUserId string, email string, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"PUT",
+		"/api/v1/posts/"+s.PostUuid.String()+"/comments/"+commentId,
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.PostUuid.String()},
+		gin.Param{Key: "commentId", Value: commentId},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(body))
+}
+
+func (s *UpdateCommentTestSuite) TestUpdateComment() {
+	s.newRequest(s.CommentUuid.String(), "testprovideruser", "test@example.com", `{"content": "edited"}`)
+
+	UpdateComment(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Comment updated"}`, s.W.Body.String())
+	count := test.GetCommandCount("editCommentCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *UpdateCommentTestSuite) TestUpdateCommentNotOwner() {
+	s.newRequest(s.CommentUuid.String(), "otherprovideruser", "other@example.com", `{"content": "edited"}`)
+
+	UpdateComment(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to edit this comment"}`, s.W.Body.String())
+	count := test.GetCommandCount("editCommentCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *UpdateCommentTestSuite) TestUpdateCommentNotFound() {
+	s.newRequest(uuid.New().String(), "testprovideruser", "test@example.com", `{"content": "edited"}`)
+
+	UpdateComment(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Comment not found"}`, s.W.Body.String())
+}
+
+func TestUpdateCommentTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCommentTestSuite))
+}
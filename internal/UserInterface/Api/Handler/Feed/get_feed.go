@@ -0,0 +1,77 @@
+package feed
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetFeed serves the feed of the latest published posts in the given format.
+// The :id and :tag route parameters select the per-author and per-tag feeds.
+// Readers polling with If-None-Match or If-Modified-Since get a 304 while the
+// feed is unchanged.
+func GetFeed(ctx *gin.Context, queryBus query_bus.QueryBus, format post_query.FeedFormat) {
+	authorId := uuid.Nil
+	if id := ctx.Param("id"); id != "" {
+		var err error
+		authorId, err = uuid.Parse(id)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid author ID"})
+			return
+		}
+	}
+
+	result, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostFeedQuery(string(format), authorId, ctx.Param("tag")))
+	if err != nil {
+		if authorId != uuid.Nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Author not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	feedView, ok := result.(view.FeedView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid feed data"})
+		return
+	}
+
+	ctx.Header("ETag", feedView.ETag)
+	if !feedView.LastModified.IsZero() {
+		ctx.Header("Last-Modified", feedView.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(ctx.Request, feedView) {
+		ctx.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	ctx.Data(http.StatusOK, feedView.ContentType, []byte(feedView.Body))
+}
+
+// notModified evaluates the conditional request headers. If-None-Match takes
+// precedence over If-Modified-Since, as RFC 9110 requires.
+func notModified(request *http.Request, feedView view.FeedView) bool {
+	if ifNoneMatch := request.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, etag := range strings.Split(ifNoneMatch, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == "*" || etag == feedView.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(request.Header.Get("If-Modified-Since"))
+	if err != nil || feedView.LastModified.IsZero() {
+		return false
+	}
+	return !feedView.LastModified.Truncate(time.Second).After(ifModifiedSince)
+}
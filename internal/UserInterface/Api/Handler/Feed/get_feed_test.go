@@ -0,0 +1,119 @@
+package feed
+
+import (
+	"context"
+	post_query "main/internal/Application/Query/Post"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	query_bus "main/internal/Infrastructure/QueryBus"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetFeedTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+	UserUuid uuid.UUID
+}
+
+func (s *GetFeedTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	if err := test.GetTestContainer().FeedCache.Invalidate(context.Background()); err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.UserUuid = userUuid
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email, name)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com', 'Test Author')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES (gen_random_uuid(), '2021-01-01 00:00:00', '2021-01-02 00:00:00', 'publishedslug', 'publishedtitle', 'publishedcontent', $1, 'published')", userUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES (gen_random_uuid(), '2021-01-01 00:00:00', '2021-01-03 00:00:00', 'draftslug', 'drafttitle', 'draftcontent', $1, 'draft')", userUuid.String())
+}
+
+func (s *GetFeedTestSuite) request(format post_query.FeedFormat, params gin.Params, headers map[string]string) {
+	s.Ctx.Request = httptest.NewRequest("GET", "/feeds/rss.xml", nil)
+	s.Ctx.Params = params
+	for name, value := range headers {
+		s.Ctx.Request.Header.Set(name, value)
+	}
+
+	GetFeed(s.Ctx, s.QueryBus, format)
+}
+
+func (s *GetFeedTestSuite) TestGetFeed() {
+	s.request(post_query.FeedFormatRss, nil, nil)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Equal(s.T(), "application/rss+xml; charset=utf-8", s.W.Header().Get("Content-Type"))
+	assert.NotEmpty(s.T(), s.W.Header().Get("ETag"))
+	assert.Equal(s.T(), "Sat, 02 Jan 2021 00:00:00 GMT", s.W.Header().Get("Last-Modified"))
+	assert.Contains(s.T(), s.W.Body.String(), "publishedtitle")
+	assert.NotContains(s.T(), s.W.Body.String(), "drafttitle")
+}
+
+func (s *GetFeedTestSuite) TestGetAuthorFeed() {
+	s.request(post_query.FeedFormatJSON, gin.Params{{Key: "id", Value: s.UserUuid.String()}}, nil)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Equal(s.T(), "application/feed+json; charset=utf-8", s.W.Header().Get("Content-Type"))
+	assert.Contains(s.T(), s.W.Body.String(), "Test Author")
+	assert.Contains(s.T(), s.W.Body.String(), "publishedtitle")
+}
+
+func (s *GetFeedTestSuite) TestGetFeedUnknownAuthor() {
+	s.request(post_query.FeedFormatRss, gin.Params{{Key: "id", Value: uuid.New().String()}}, nil)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func (s *GetFeedTestSuite) TestGetFeedInvalidAuthorId() {
+	s.request(post_query.FeedFormatRss, gin.Params{{Key: "id", Value: "invalid"}}, nil)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+}
+
+func (s *GetFeedTestSuite) TestGetFeedIfNoneMatch() {
+	s.request(post_query.FeedFormatAtom, nil, nil)
+	etag := s.W.Header().Get("ETag")
+
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	s.request(post_query.FeedFormatAtom, nil, map[string]string{"If-None-Match": etag})
+
+	assert.Equal(s.T(), http.StatusNotModified, s.W.Code)
+	assert.Empty(s.T(), s.W.Body.String())
+}
+
+func (s *GetFeedTestSuite) TestGetFeedIfModifiedSince() {
+	s.request(post_query.FeedFormatRss, nil, map[string]string{"If-Modified-Since": "Sat, 02 Jan 2021 00:00:00 GMT"})
+
+	assert.Equal(s.T(), http.StatusNotModified, s.W.Code)
+
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	s.request(post_query.FeedFormatRss, nil, map[string]string{"If-Modified-Since": "Fri, 01 Jan 2021 00:00:00 GMT"})
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+}
+
+func TestGetFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(GetFeedTestSuite))
+}
@@ -0,0 +1,33 @@
+package media
+
+import (
+	media_query "main/internal/Application/Query/Media"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetMedia(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	mediaId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media ID"})
+		return
+	}
+
+	result, err := queryBus.Execute(ctx.Request.Context(), media_query.NewGetMediaQuery(mediaId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Media not found"})
+		return
+	}
+
+	mediaView, ok := result.(view.MediaView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid media data"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mediaView)
+}
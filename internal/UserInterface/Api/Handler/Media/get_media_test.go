@@ -0,0 +1,79 @@
+package media
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetMediaTestSuite struct {
+	suite.Suite
+	QueryBus  query_bus.QueryBus
+	Ctx       *gin.Context
+	W         *httptest.ResponseRecorder
+	UserUuid  uuid.UUID
+	MediaUuid uuid.UUID
+}
+
+func (s *GetMediaTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+
+	test.GetTestContainer().DB.Exec("DELETE FROM media")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.UserUuid = uuid.New()
+	s.MediaUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, s.UserUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO media (id, created_at, owner_id, storage_key, file_name, content_type, size)
+		VALUES (?, '2021-01-01 00:00:00', ?, ?, 'photo.png', 'image/png', 1024)
+	`, s.MediaUuid.String(), s.UserUuid.String(), s.UserUuid.String()+"/"+s.MediaUuid.String()+".png")
+}
+
+func (s *GetMediaTestSuite) TestGetMedia() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/media/"+s.MediaUuid.String(), nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: s.MediaUuid.String()}}
+
+	GetMedia(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"id":"`+s.MediaUuid.String()+`"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"file_name":"photo.png"`)
+	assert.Contains(s.T(), s.W.Body.String(), "/"+s.UserUuid.String()+"/"+s.MediaUuid.String()+`.png"`)
+}
+
+func (s *GetMediaTestSuite) TestGetMediaNotFound() {
+	id := uuid.New().String()
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/media/"+id, nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: id}}
+
+	GetMedia(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func (s *GetMediaTestSuite) TestGetMediaInvalidId() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/media/invalid", nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: "invalid"}}
+
+	GetMedia(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+}
+
+func TestGetMediaTestSuite(t *testing.T) {
+	suite.Run(t, new(GetMediaTestSuite))
+}
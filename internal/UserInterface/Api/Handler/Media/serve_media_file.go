@@ -0,0 +1,39 @@
+package media
+
+import (
+	"errors"
+	repository "main/internal/Domain/Repository"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServeMediaFile streams files of the local storage. Keys never change
+// content, so the files may be cached for good.
+func ServeMediaFile(ctx *gin.Context, storage repository.Storage) {
+	key := strings.TrimPrefix(ctx.Param("key"), "/")
+
+	file, err := storage.Get(ctx.Request.Context(), key)
+	if errors.Is(err, repository.ErrStorageObjectNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	ctx.DataFromReader(http.StatusOK, -1, contentType, file, map[string]string{
+		"Cache-Control":          "public, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
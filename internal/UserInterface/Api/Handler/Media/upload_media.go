@@ -0,0 +1,82 @@
+package media
+
+import (
+	"errors"
+	media "main/internal/Application/Media"
+	media_query "main/internal/Application/Query/Media"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// multipartOverhead leaves room for the boundaries and part headers around
+// the file in the request body.
+const multipartOverhead = 1 << 20
+
+// UploadMedia expects the file in the "file" field of a multipart form.
+func UploadMedia(ctx *gin.Context, uploader media.MediaUploader, queryBus query_bus.QueryBus) {
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, uploader.MaxSize+multipartOverhead)
+
+	fileHeader, err := ctx.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": entity.ErrMediaTooLarge.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	uploaded, err := uploader.Upload(ctx.Request.Context(), uuid.New(), userView.Id, fileHeader.Filename, file)
+	switch {
+	case errors.Is(err, entity.ErrMediaTooLarge):
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, entity.ErrMediaTypeNotAllowed):
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, entity.ErrMediaQuotaExceeded):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := queryBus.Execute(ctx.Request.Context(), media_query.NewGetMediaQuery(uploaded.ID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	mediaView, ok := result.(view.MediaView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid media data"})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, mediaView)
+}
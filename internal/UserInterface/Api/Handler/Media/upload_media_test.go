@@ -0,0 +1,153 @@
+package media
+
+import (
+	"bytes"
+	"encoding/json"
+	view "main/internal/Application/View"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type UploadMediaTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+	UserUuid uuid.UUID
+}
+
+func (s *UploadMediaTestSuite) SetupTest() {
+	if os.Getenv("SESSION_NAME") == "" {
+		_ = os.Setenv("SESSION_NAME", "blog_session")
+	}
+
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+
+	test.GetTestContainer().DB.Exec("DELETE FROM media")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.UserUuid = userUuid
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+}
+
+func (s *UploadMediaTestSuite) request(fileName string, content []byte, authenticated bool) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		panic(err)
+	}
+	part.Write(content)
+	writer.Close()
+
+	s.Ctx.Request = httptest.NewRequest("POST", "/api/v1/media", body)
+	s.Ctx.Request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	if authenticated {
+		session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+		if err != nil {
+			panic(err)
+		}
+		session.Values["provider_user_id"] = "testprovideruser"
+		session.Values["email"] = "test@example.com"
+		if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+			panic(err)
+		}
+		s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+	}
+
+	UploadMedia(s.Ctx, test.GetTestContainer().MediaUploader, s.QueryBus)
+}
+
+func (s *UploadMediaTestSuite) TestUploadMedia() {
+	s.request("photo.png", pngHeader, true)
+
+	assert.Equal(s.T(), http.StatusCreated, s.W.Code)
+	var mediaView view.MediaView
+	assert.NoError(s.T(), json.Unmarshal(s.W.Body.Bytes(), &mediaView))
+	assert.Equal(s.T(), s.UserUuid, mediaView.OwnerId)
+	assert.Equal(s.T(), "photo.png", mediaView.FileName)
+	assert.Equal(s.T(), "image/png", mediaView.ContentType)
+	assert.Equal(s.T(), int64(len(pngHeader)), mediaView.Size)
+	assert.True(s.T(), strings.HasSuffix(mediaView.URL, "/"+s.UserUuid.String()+"/"+mediaView.Id.String()+".png"))
+
+	w := httptest.NewRecorder()
+	ctx := gin.CreateTestContextOnly(w, gin.Default())
+	key := s.UserUuid.String() + "/" + mediaView.Id.String() + ".png"
+	ctx.Request = httptest.NewRequest("GET", "/media/files/"+key, nil)
+	ctx.Params = gin.Params{{Key: "key", Value: "/" + key}}
+
+	ServeMediaFile(ctx, test.GetTestContainer().Storage)
+
+	assert.Equal(s.T(), http.StatusOK, w.Code)
+	assert.Equal(s.T(), "image/png", w.Header().Get("Content-Type"))
+	assert.Equal(s.T(), "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(s.T(), pngHeader, w.Body.Bytes())
+}
+
+func (s *UploadMediaTestSuite) TestUploadMediaNotAllowedType() {
+	s.request("photo.png", []byte(`<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"/>`), true)
+
+	assert.Equal(s.T(), http.StatusUnsupportedMediaType, s.W.Code)
+}
+
+func (s *UploadMediaTestSuite) TestUploadMediaTooLarge() {
+	content := append(append([]byte{}, pngHeader...), make([]byte, test.GetTestContainer().MediaUploader.MaxSize)...)
+
+	s.request("photo.png", content, true)
+
+	assert.Equal(s.T(), http.StatusRequestEntityTooLarge, s.W.Code)
+}
+
+func (s *UploadMediaTestSuite) TestUploadMediaMissingFile() {
+	s.Ctx.Request = httptest.NewRequest("POST", "/api/v1/media", strings.NewReader("{}"))
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "testprovideruser"
+	session.Values["email"] = "test@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+
+	UploadMedia(s.Ctx, test.GetTestContainer().MediaUploader, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+}
+
+func (s *UploadMediaTestSuite) TestUploadMediaUnauthenticated() {
+	s.request("photo.png", pngHeader, false)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, s.W.Code)
+}
+
+func TestUploadMediaTestSuite(t *testing.T) {
+	suite.Run(t, new(UploadMediaTestSuite))
+}
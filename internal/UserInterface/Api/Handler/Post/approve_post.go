@@ -0,0 +1,24 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// ApprovePost clears a post in review for publishing. A scheduled post is
+// published by the scheduler once it is approved.
+func ApprovePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, userView, ok := findReviewablePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	command := post_command.NewApprovePostCommand(postView.Id, userView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post approved"})
+}
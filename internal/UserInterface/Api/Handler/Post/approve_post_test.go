@@ -0,0 +1,148 @@
+package post
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type ApprovePostTestSuite struct {
+	suite.Suite
+	CommandBus   *cqrs.CommandBus
+	QueryBus     query_bus.QueryBus
+	Ctx          *gin.Context
+	W            *httptest.ResponseRecorder
+	PubSubDb     *sql.DB
+	PostUuid     uuid.UUID
+	AuthorUuid   uuid.UUID
+	ReviewerUuid uuid.UUID
+	OtherUuid    uuid.UUID
+}
+
+func (s *ApprovePostTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.approvePostCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.AuthorUuid = uuid.New()
+	s.ReviewerUuid = uuid.New()
+	s.OtherUuid = uuid.New()
+	for providerUserId, userUuid := range map[string]uuid.UUID{
+		"testprovideruser":     s.AuthorUuid,
+		"reviewerprovideruser": s.ReviewerUuid,
+		"otherprovideruser":    s.OtherUuid,
+	} {
+		test.GetTestContainer().DB.Exec(`
+			INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+			VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+		`, userUuid.String(), providerUserId, providerUserId+"@example.com")
+	}
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status, submitted_at)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'in_review', '2021-01-01 00:00:00')`,
+		s.PostUuid.String(),
+		s.AuthorUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO post_collaborators (post_id, user_id, role, created_at)
+	VALUES ($1, $2, 'reviewer', '2021-01-01 00:00:00')`,
+		s.PostUuid.String(),
+		s.ReviewerUuid.String(),
+	)
+}
+
+func (s *ApprovePostTestSuite) newRequest(providerUserId string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/posts/"+s.PostUuid.String()+"/approve",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.PostUuid.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = providerUserId + "@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *ApprovePostTestSuite) TestApprovePost() {
+	s.newRequest("reviewerprovideruser")
+
+	ApprovePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Post approved"}`, s.W.Body.String())
+	count := test.GetCommandCount("approvePostCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *ApprovePostTestSuite) TestApprovePostByAuthor() {
+	s.newRequest("testprovideruser")
+
+	ApprovePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to review this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("approvePostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *ApprovePostTestSuite) TestApprovePostByOtherUser() {
+	s.newRequest("otherprovideruser")
+
+	ApprovePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to review this post"}`, s.W.Body.String())
+}
+
+func (s *ApprovePostTestSuite) TestApprovePostByAdmin() {
+	test.GetTestContainer().DB.Exec("INSERT INTO user_roles (user_id, role, granted_at) VALUES (?, 'admin', NOW())", s.OtherUuid.String())
+	s.newRequest("otherprovideruser")
+
+	ApprovePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	count := test.GetCommandCount("approvePostCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *ApprovePostTestSuite) TestApprovePostNotInReview() {
+	test.GetTestContainer().DB.Exec("UPDATE posts SET status = 'draft' WHERE id = ?", s.PostUuid.String())
+	s.newRequest("reviewerprovideruser")
+
+	ApprovePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post is not in review"}`, s.W.Body.String())
+	count := test.GetCommandCount("approvePostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func TestApprovePostTestSuite(t *testing.T) {
+	suite.Run(t, new(ApprovePostTestSuite))
+}
@@ -0,0 +1,23 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+func ArchivePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findAccessiblePost(ctx, queryBus, "archive", entity.CollaboratorRole.CanManage)
+	if !ok {
+		return
+	}
+
+	command := post_command.NewArchivePostCommand(postView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post archived"})
+}
@@ -0,0 +1,41 @@
+package post
+
+import (
+	annotation_command "main/internal/Application/Command/Annotation"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateAnnotation leaves a review note on a passage of a post. The range is
+// checked against the current content, so a client working on an outdated
+// version gets a 409 rather than a misplaced annotation.
+func CreateAnnotation(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.CreateAnnotationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	postView, userView, ok := findAnnotatablePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	annotation, err := entity.NewAnnotation(uuid.MustParse(req.Id), time.Now(), postView.Id, userView.Id, postView.Content, *req.Start, req.End, req.Quote, req.Body)
+	if err != nil {
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	command := annotation_command.NewCreateAnnotationCommand(annotation.ID, annotation.PostId, annotation.AuthorId, annotation.Start, annotation.End, annotation.Quote, annotation.Body)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Annotation created"})
+}
@@ -0,0 +1,142 @@
+package post
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type CreateAnnotationTestSuite struct {
+	suite.Suite
+	CommandBus   *cqrs.CommandBus
+	QueryBus     query_bus.QueryBus
+	Ctx          *gin.Context
+	W            *httptest.ResponseRecorder
+	PubSubDb     *sql.DB
+	PostUuid     uuid.UUID
+	AuthorUuid   uuid.UUID
+	ReviewerUuid uuid.UUID
+	OtherUuid    uuid.UUID
+}
+
+func (s *CreateAnnotationTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.createAnnotationCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.AuthorUuid = uuid.New()
+	s.ReviewerUuid = uuid.New()
+	s.OtherUuid = uuid.New()
+	for providerUserId, userUuid := range map[string]uuid.UUID{
+		"testprovideruser":     s.AuthorUuid,
+		"reviewerprovideruser": s.ReviewerUuid,
+		"otherprovideruser":    s.OtherUuid,
+	} {
+		test.GetTestContainer().DB.Exec(`
+			INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+			VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+		`, userUuid.String(), providerUserId, providerUserId+"@example.com")
+	}
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'The quick brown fox', $2, 'draft')`,
+		s.PostUuid.String(),
+		s.AuthorUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO post_collaborators (post_id, user_id, role, created_at)
+	VALUES ($1, $2, 'reviewer', '2021-01-01 00:00:00')`,
+		s.PostUuid.String(),
+		s.ReviewerUuid.String(),
+	)
+}
+
+func (s *CreateAnnotationTestSuite) newRequest(providerUserId string, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/posts/"+s.PostUuid.String()+"/annotations",
+		strings.NewReader(body),
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.PostUuid.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = providerUserId + "@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *CreateAnnotationTestSuite) body(start int, end int, quote string) string {
+	return `{"id":"` + uuid.New().String() + `","start":` + strconv.Itoa(start) + `,"end":` + strconv.Itoa(end) + `,"quote":"` + quote + `","body":"Which fox?"}`
+}
+
+func (s *CreateAnnotationTestSuite) TestCreateAnnotation() {
+	s.newRequest("reviewerprovideruser", s.body(16, 19, "fox"))
+
+	CreateAnnotation(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Annotation created"}`, s.W.Body.String())
+	count := test.GetCommandCount("createAnnotationCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *CreateAnnotationTestSuite) TestCreateAnnotationQuoteMismatch() {
+	s.newRequest("testprovideruser", s.body(16, 19, "dog"))
+
+	CreateAnnotation(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"the quote does not match the annotated range of the post content"}`, s.W.Body.String())
+	count := test.GetCommandCount("createAnnotationCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *CreateAnnotationTestSuite) TestCreateAnnotationOutOfRange() {
+	s.newRequest("testprovideruser", s.body(16, 40, "fox"))
+
+	CreateAnnotation(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"the annotated range must be a non-empty part of the post content"}`, s.W.Body.String())
+}
+
+func (s *CreateAnnotationTestSuite) TestCreateAnnotationByOtherUser() {
+	s.newRequest("otherprovideruser", s.body(16, 19, "fox"))
+
+	CreateAnnotation(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to annotate this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("createAnnotationCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func TestCreateAnnotationTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateAnnotationTestSuite))
+}
@@ -1,16 +1,14 @@
 package post
 
 import (
+	"errors"
 	post_command "main/internal/Application/Command/Post"
-	user_query "main/internal/Application/Query/User"
-	view "main/internal/Application/View"
 	query_bus "main/internal/Infrastructure/QueryBus"
 	request "main/internal/UserInterface/Api/Request"
+	session "main/internal/UserInterface/Api/Session"
 	"net/http"
-	"os"
 
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
-	"github.com/markbates/goth/gothic"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -24,32 +22,23 @@ func CreatePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bu
 		return
 	}
 
-	session, err := gothic.Store.Get(ctx.Request, os.Getenv("SESSION_NAME"))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
-
-	providerUserId := session.Values["provider_user_id"]
-	email := session.Values["email"]
-
-	if providerUserId == nil || email == nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "User not found"})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	user, err := queryBus.Execute(
-		ctx.Request.Context(),
-		user_query.NewFindUserByQuery(providerUserId.(string), email.(string)),
-	)
-
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	coverMediaId, ok := findCoverMedia(ctx, queryBus, req.CoverMediaId, userView.Id)
+	if !ok {
 		return
 	}
 
-	if user == nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "User not found"})
+	categoryId, ok := findCategory(ctx, queryBus, req.CategoryId)
+	if !ok {
 		return
 	}
 
@@ -58,7 +47,16 @@ func CreatePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bu
 		req.Slug,
 		req.Title,
 		req.Content,
-		user.(view.UserView).Id,
+		req.ContentFormat,
+		req.Excerpt,
+		userView.Id,
+		req.PublishAt,
+		req.Tags,
+		coverMediaId,
+		req.SeoTitle,
+		req.MetaDescription,
+		req.CanonicalURL,
+		categoryId,
 	)
 
 	commandBus.Send(ctx.Request.Context(), command)
@@ -134,6 +134,74 @@ func (s *CreatePostTestSuite) TestCreatePostInvalidContentRequest() {
 	assert.Equal(s.T(), 0, count)
 }
 
+func (s *CreatePostTestSuite) TestCreatePostPublishAtInPast() {
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(`{
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"slug": "testslug",
+		"title": "testtitle",
+		"content": "testcontent",
+		"publish_at": "2021-01-01T00:00:00Z"
+	}`))
+
+	CreatePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Key: 'CreatePostRequest.PublishAt' Error:Field validation for 'PublishAt' failed on the 'gt' tag"}`, s.W.Body.String())
+	count := test.GetCommandCount("createPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *CreatePostTestSuite) TestCreatePostInvalidContentFormat() {
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(`{
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"slug": "testslug",
+		"title": "testtitle",
+		"content": "testcontent",
+		"content_format": "rtf"
+	}`))
+
+	CreatePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Key: 'CreatePostRequest.ContentFormat' Error:Field validation for 'ContentFormat' failed on the 'oneof' tag"}`, s.W.Body.String())
+	count := test.GetCommandCount("createPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *CreatePostTestSuite) TestCreatePostInvalidCanonicalURL() {
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(`{
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"slug": "testslug",
+		"title": "testtitle",
+		"content": "testcontent",
+		"canonical_url": "javascript:alert(1)"
+	}`))
+
+	CreatePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Key: 'CreatePostRequest.CanonicalURL' Error:Field validation for 'CanonicalURL' failed on the 'http_url' tag"}`, s.W.Body.String())
+	count := test.GetCommandCount("createPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *CreatePostTestSuite) TestCreatePostUnknownCoverMedia() {
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(`{
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"slug": "testslug",
+		"title": "testtitle",
+		"content": "testcontent",
+		"cover_media_id": "923e4567-e89b-12d3-a456-426614174000"
+	}`))
+
+	CreatePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Cover media not found"}`, s.W.Body.String())
+	count := test.GetCommandCount("createPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
 func TestCreatePostTestSuite(t *testing.T) {
 	suite.Run(t, new(CreatePostTestSuite))
 }
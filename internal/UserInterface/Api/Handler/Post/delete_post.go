@@ -3,16 +3,22 @@ package post
 import (
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
 	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
-func DeletePost(ctx *gin.Context, commandBus *cqrs.CommandBus) {
-	id := ctx.Param("id")
+// DeletePost moves a post to the trash on behalf of its author or a
+// co-author.
+func DeletePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findAccessiblePost(ctx, queryBus, "delete", entity.CollaboratorRole.CanManage)
+	if !ok {
+		return
+	}
 
-	command := post_command.NewDeletePostCommand(uuid.MustParse(id))
+	command := post_command.NewDeletePostCommand(postView.Id)
 	commandBus.Send(ctx.Request.Context(), command)
 
 	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post deleted"})
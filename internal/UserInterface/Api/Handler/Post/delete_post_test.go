@@ -3,12 +3,15 @@ package post
 import (
 	"database/sql"
 	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
 	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +21,7 @@ import (
 type DeletePostTestSuite struct {
 	suite.Suite
 	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
 	Ctx        *gin.Context
 	W          *httptest.ResponseRecorder
 	PubSubDb   *sql.DB
@@ -26,11 +30,13 @@ type DeletePostTestSuite struct {
 
 func (s *DeletePostTestSuite) SetupTest() {
 	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
 	s.W = httptest.NewRecorder()
 	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
 	gin.SetMode(gin.TestMode)
 	s.PubSubDb = test.GetPubSubDb()
 	s.PubSubDb.Exec("DELETE FROM `watermill_commands.deletePostCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
 	test.GetTestContainer().DB.Exec("DELETE FROM users")
 	userUuid, err := uuid.NewRandom()
 	if err != nil {
@@ -52,7 +58,23 @@ func (s *DeletePostTestSuite) SetupTest() {
 	)
 }
 
-func (s *DeletePostTestSuite) TestDeletePost() {
+// addCollaborator creates a user with the given provider user id and invites
+// them to the post in role.
+func (s *DeletePostTestSuite) addCollaborator(providerUserId string, email string, role string) {
+	userUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+	`, userUuid.String(), providerUserId, email)
+	test.GetTestContainer().DB.Exec(`INSERT INTO post_collaborators (post_id, user_id, role, created_at)
+	VALUES ($1, $2, $3, '2021-01-01 00:00:00')`,
+		s.PostUuid.String(),
+		userUuid.String(),
+		role,
+	)
+}
+
+func (s *DeletePostTestSuite) newRequest(providerUserId string, email string) {
 	s.Ctx.Request = httptest.NewRequest(
 		"DELETE",
 		"/api/v1/posts/"+s.PostUuid.String(),
@@ -64,9 +86,23 @@ func (s *DeletePostTestSuite) TestDeletePost() {
 			Value: s.PostUuid.String(),
 		},
 	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
 	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+}
 
-	DeletePost(s.Ctx, s.CommandBus)
+func (s *DeletePostTestSuite) TestDeletePost() {
+	s.newRequest("testprovideruser", "test@example.com")
+
+	DeletePost(s.Ctx, s.CommandBus, s.QueryBus)
 
 	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
 	assert.Equal(s.T(), `{"message":"Post deleted"}`, s.W.Body.String())
@@ -74,6 +110,29 @@ func (s *DeletePostTestSuite) TestDeletePost() {
 	assert.Equal(s.T(), 1, count)
 }
 
+func (s *DeletePostTestSuite) TestDeletePostCoAuthor() {
+	s.addCollaborator("coauthorprovideruser", "coauthor@example.com", "co-author")
+	s.newRequest("coauthorprovideruser", "coauthor@example.com")
+
+	DeletePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	count := test.GetCommandCount("deletePostCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *DeletePostTestSuite) TestDeletePostEditor() {
+	s.addCollaborator("editorprovideruser", "editor@example.com", "editor")
+	s.newRequest("editorprovideruser", "editor@example.com")
+
+	DeletePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to delete this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("deletePostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
 func TestDeletePostTestSuite(t *testing.T) {
 	suite.Run(t, new(DeletePostTestSuite))
 }
@@ -0,0 +1,38 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func DiffPostRevisions(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	from, err := strconv.Atoi(ctx.Query("from"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from revision"})
+		return
+	}
+
+	to, err := strconv.Atoi(ctx.Query("to"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to revision"})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "view revisions of", anyCollaborator)
+	if !ok {
+		return
+	}
+
+	q := post_query.NewDiffPostRevisionsQuery(postView.Id, from, to)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,61 @@
+package post
+
+import (
+	authorization "main/internal/Application/Authorization"
+	annotation_query "main/internal/Application/Query/Annotation"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findAnnotatablePost loads the post identified by the :id route param and
+// makes sure the current user takes part in its review: the author, every
+// collaborator and users allowed to review any
+// post do. On failure the error response is already
+// written and false is returned.
+func findAnnotatablePost(ctx *gin.Context, queryBus query_bus.QueryBus) (view.PostView, view.UserView, bool) {
+	postView, userView, ok := findPostAndCurrentUser(ctx, queryBus)
+	if !ok {
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	if !authorization.Can(userView, entity.PermissionReviewAnyPost) && !canAccess(postView, userView, anyCollaborator) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to annotate this post"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	return postView, userView, true
+}
+
+// findAnnotation loads the annotation identified by the :annotationId route
+// param, which must belong to the post.
+func findAnnotation(ctx *gin.Context, queryBus query_bus.QueryBus, postView view.PostView) (view.AnnotationView, bool) {
+	annotationId, err := uuid.Parse(ctx.Param("annotationId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid annotation ID"})
+		return view.AnnotationView{}, false
+	}
+
+	annotation, err := queryBus.Execute(ctx.Request.Context(), annotation_query.NewGetAnnotationQuery(annotationId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Annotation not found"})
+		return view.AnnotationView{}, false
+	}
+
+	annotationView, ok := annotation.(view.AnnotationView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid annotation data"})
+		return view.AnnotationView{}, false
+	}
+
+	if annotationView.PostId != postView.Id {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Annotation not found"})
+		return view.AnnotationView{}, false
+	}
+
+	return annotationView, true
+}
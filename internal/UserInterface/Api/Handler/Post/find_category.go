@@ -0,0 +1,27 @@
+package post
+
+import (
+	category_query "main/internal/Application/Query/Category"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findCategory resolves the category_id of a create or update request. An
+// empty id means no category. On failure the error response is already
+// written and false is returned.
+func findCategory(ctx *gin.Context, queryBus query_bus.QueryBus, categoryId string) (*uuid.UUID, bool) {
+	if categoryId == "" {
+		return nil, true
+	}
+	id := uuid.MustParse(categoryId)
+
+	if _, err := queryBus.Execute(ctx.Request.Context(), category_query.NewGetCategoryQuery(id)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Category not found"})
+		return nil, false
+	}
+
+	return &id, true
+}
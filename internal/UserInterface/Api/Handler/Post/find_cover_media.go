@@ -0,0 +1,41 @@
+package post
+
+import (
+	media_query "main/internal/Application/Query/Media"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findCoverMedia resolves the cover_media_id of a create or update request.
+// Authors can only use their own uploads as cover. An empty id means no
+// cover. On failure the error response is already written and false is
+// returned.
+func findCoverMedia(ctx *gin.Context, queryBus query_bus.QueryBus, coverMediaId string, authorId uuid.UUID) (*uuid.UUID, bool) {
+	if coverMediaId == "" {
+		return nil, true
+	}
+	mediaId := uuid.MustParse(coverMediaId)
+
+	result, err := queryBus.Execute(ctx.Request.Context(), media_query.NewGetMediaQuery(mediaId))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Cover media not found"})
+		return nil, false
+	}
+
+	mediaView, ok := result.(view.MediaView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid media data"})
+		return nil, false
+	}
+
+	if mediaView.OwnerId != authorId {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to use this media as cover"})
+		return nil, false
+	}
+
+	return &mediaId, true
+}
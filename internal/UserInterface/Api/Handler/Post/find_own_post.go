@@ -0,0 +1,110 @@
+package post
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// collaboratorAccess tells whether a collaborator with the given role may
+// perform an action on a post. The author may perform every action.
+type collaboratorAccess func(role entity.CollaboratorRole) bool
+
+// anyCollaborator lets every collaborator through, e.g. to read a draft.
+func anyCollaborator(role entity.CollaboratorRole) bool {
+	return true
+}
+
+// findOwnPost loads the post identified by the :id route param and makes sure
+// the current user is its author. On failure the error response is already
+// written and false is returned.
+func findOwnPost(ctx *gin.Context, queryBus query_bus.QueryBus, action string) (view.PostView, bool) {
+	return findAccessiblePost(ctx, queryBus, action, nil)
+}
+
+// findAccessiblePost works like findOwnPost, but also lets collaborators
+// through whose role is granted access. A nil access admits the author only.
+func findAccessiblePost(ctx *gin.Context, queryBus query_bus.QueryBus, action string, access collaboratorAccess) (view.PostView, bool) {
+	postView, userView, ok := findPostAndCurrentUser(ctx, queryBus)
+	if !ok {
+		return view.PostView{}, false
+	}
+
+	if !canAccess(postView, userView, access) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to " + action + " this post"})
+		return view.PostView{}, false
+	}
+
+	return postView, true
+}
+
+// findPostAndCurrentUser loads the post identified by the :id route param and
+// the current user, leaving access checks to the caller.
+func findPostAndCurrentUser(ctx *gin.Context, queryBus query_bus.QueryBus) (view.PostView, view.UserView, bool) {
+	return loadPostAndCurrentUser(ctx, queryBus, func(postId uuid.UUID) any {
+		return post_query.NewGetPostQuery(postId)
+	})
+}
+
+// loadPostAndCurrentUser works like findPostAndCurrentUser, loading the post
+// with the query built by getPost.
+func loadPostAndCurrentUser(ctx *gin.Context, queryBus query_bus.QueryBus, getPost func(postId uuid.UUID) any) (view.PostView, view.UserView, bool) {
+	postId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.PostView{}, view.UserView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	post, err := queryBus.Execute(ctx.Request.Context(), getPost(postId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	return postView, userView, true
+}
+
+// canAccess tells whether the user is the author of the post or one of its
+// collaborators whose role is granted access. Users allowed to edit any post
+// are let through wherever a co-author would be.
+func canAccess(postView view.PostView, userView view.UserView, access collaboratorAccess) bool {
+	if postView.AuthorId == userView.Id {
+		return true
+	}
+	if access == nil {
+		return false
+	}
+	if access(entity.CollaboratorRoleCoAuthor) && authorization.Can(userView, entity.PermissionEditAnyPost) {
+		return true
+	}
+	for _, collaborator := range postView.Collaborators {
+		if collaborator.UserId == userView.Id {
+			return access(entity.CollaboratorRole(collaborator.Role))
+		}
+	}
+	return false
+}
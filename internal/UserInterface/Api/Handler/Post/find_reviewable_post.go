@@ -0,0 +1,46 @@
+package post
+
+import (
+	authorization "main/internal/Application/Authorization"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// findReviewablePost loads the post identified by the :id route param and
+// makes sure the current user may review it: users allowed to review any
+// post, their own included, and the editors and reviewers of the post may,
+// its other authors do not. The post must be in review. On failure the error
+// response is already written and false is returned.
+func findReviewablePost(ctx *gin.Context, queryBus query_bus.QueryBus) (view.PostView, view.UserView, bool) {
+	postView, userView, ok := findPostAndCurrentUser(ctx, queryBus)
+	if !ok {
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	if !canReview(postView, userView) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to review this post"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	if postView.Status != string(entity.PostStatusInReview) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Post is not in review"})
+		return view.PostView{}, view.UserView{}, false
+	}
+
+	return postView, userView, true
+}
+
+func canReview(postView view.PostView, userView view.UserView) bool {
+	if authorization.Can(userView, entity.PermissionReviewAnyPost) {
+		return true
+	}
+	if slices.Contains(postView.AuthorIds, userView.Id) {
+		return false
+	}
+	return canAccess(postView, userView, entity.CollaboratorRole.CanReview)
+}
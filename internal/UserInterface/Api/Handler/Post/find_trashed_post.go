@@ -0,0 +1,31 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findTrashedPost loads the trashed post identified by the :id route param and
+// makes sure the current user may delete it in the first place. On failure the error response is already written and
+// false is returned.
+func findTrashedPost(ctx *gin.Context, queryBus query_bus.QueryBus, action string) (view.PostView, bool) {
+	postView, userView, ok := loadPostAndCurrentUser(ctx, queryBus, func(postId uuid.UUID) any {
+		return post_query.NewGetTrashedPostQuery(postId)
+	})
+	if !ok {
+		return view.PostView{}, false
+	}
+
+	if !canAccess(postView, userView, entity.CollaboratorRole.CanManage) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to " + action + " this post"})
+		return view.PostView{}, false
+	}
+
+	return postView, true
+}
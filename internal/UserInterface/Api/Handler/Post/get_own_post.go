@@ -0,0 +1,19 @@
+package post
+
+import (
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetOwnPost returns a post in any status to its author and collaborators,
+// e.g. to edit a draft.
+func GetOwnPost(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	postView, ok := findAccessiblePost(ctx, queryBus, "view", anyCollaborator)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, postView)
+}
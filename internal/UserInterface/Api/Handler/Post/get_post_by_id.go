@@ -2,6 +2,8 @@ package post
 
 import (
 	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
 	query_bus "main/internal/Infrastructure/QueryBus"
 	"net/http"
 
@@ -26,5 +28,16 @@ func GetPostById(ctx *gin.Context, queryBus query_bus.QueryBus) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, post)
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return
+	}
+
+	if postView.Status != string(entity.PostStatusPublished) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, postView)
 }
@@ -5,11 +5,13 @@ import (
 	test "main/internal/Infrastructure/DependencyInjection/Test"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	query_bus "main/internal/Infrastructure/QueryBus"
 
 	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/gin-gonic/gin"
@@ -18,11 +20,12 @@ import (
 
 type GetPostByIdTestSuite struct {
 	suite.Suite
-	QueryBus query_bus.QueryBus
-	Ctx      *gin.Context
-	W        *httptest.ResponseRecorder
-	PubSubDb *sql.DB
-	PostUuid uuid.UUID
+	QueryBus  query_bus.QueryBus
+	Ctx       *gin.Context
+	W         *httptest.ResponseRecorder
+	PubSubDb  *sql.DB
+	PostUuid  uuid.UUID
+	DraftUuid uuid.UUID
 }
 
 func (s *GetPostByIdTestSuite) SetupTest() {
@@ -46,7 +49,13 @@ func (s *GetPostByIdTestSuite) SetupTest() {
 		panic(err)
 	}
 	s.PostUuid = postUuid
-	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2)", postUuid.String(), userUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'published')", postUuid.String(), userUuid.String())
+	draftUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.DraftUuid = draftUuid
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'draftslug', 'drafttitle', 'draftcontent', $2, 'draft')", draftUuid.String(), userUuid.String())
 }
 
 func (s *GetPostByIdTestSuite) TestGetPostById() {
@@ -72,6 +81,56 @@ func (s *GetPostByIdTestSuite) TestGetPostById() {
 	assert.Contains(s.T(), s.W.Body.String(), `"content":"testcontent"`)
 }
 
+func (s *GetPostByIdTestSuite) TestGetPostByIdDraftHiddenFromAnonymous() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts/"+s.DraftUuid.String(),
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: s.DraftUuid.String(),
+		},
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	GetPostById(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
+func (s *GetPostByIdTestSuite) TestGetPostByIdDraftHiddenFromAuthor() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts/"+s.DraftUuid.String(),
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: s.DraftUuid.String(),
+		},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "testprovideruser"
+	session.Values["email"] = "test@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+
+	GetPostById(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
 func (s *GetPostByIdTestSuite) TestGetPostByIdInvalidUUID() {
 	s.Ctx.Request = httptest.NewRequest(
 		"GET",
@@ -0,0 +1,46 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const postBySlugPath = "/api/v1/posts/by-slug/"
+
+// GetPostBySlug serves a published post by its slug. Slugs the post had before
+// being renamed answer with a permanent redirect to the current one so that
+// shared links keep working.
+func GetPostBySlug(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	slug := ctx.Param("slug")
+
+	post, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostBySlugQuery(slug))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return
+	}
+
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return
+	}
+
+	if postView.Status != string(entity.PostStatusPublished) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return
+	}
+
+	if postView.Slug != slug {
+		location := postBySlugPath + postView.Slug
+		ctx.Header("Location", location)
+		ctx.JSON(http.StatusMovedPermanently, gin.H{"slug": postView.Slug, "location": location})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, postView)
+}
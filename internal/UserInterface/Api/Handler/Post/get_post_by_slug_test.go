@@ -0,0 +1,105 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	query_bus "main/internal/Infrastructure/QueryBus"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetPostBySlugTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+	PostUuid uuid.UUID
+}
+
+func (s *GetPostBySlugTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	postUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.PostUuid = postUuid
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'published')", postUuid.String(), userUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO slug_history (slug, post_id, created_at) VALUES ('oldslug', $1, '2021-01-01 00:00:00')", postUuid.String())
+	draftUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'draftslug', 'drafttitle', 'draftcontent', $2, 'draft')", draftUuid.String(), userUuid.String())
+}
+
+func (s *GetPostBySlugTestSuite) request(slug string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts/by-slug/"+slug,
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "slug",
+			Value: slug,
+		},
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	GetPostBySlug(s.Ctx, s.QueryBus)
+}
+
+func (s *GetPostBySlugTestSuite) TestGetPostBySlug() {
+	s.request("testslug")
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"id":"`+s.PostUuid.String()+`"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"testslug"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"title":"testtitle"`)
+}
+
+func (s *GetPostBySlugTestSuite) TestGetPostBySlugRedirectsOldSlug() {
+	s.request("oldslug")
+
+	assert.Equal(s.T(), http.StatusMovedPermanently, s.W.Code)
+	assert.Equal(s.T(), "/api/v1/posts/by-slug/testslug", s.W.Header().Get("Location"))
+	assert.Equal(s.T(), `{"location":"/api/v1/posts/by-slug/testslug","slug":"testslug"}`, s.W.Body.String())
+}
+
+func (s *GetPostBySlugTestSuite) TestGetPostBySlugDraftHidden() {
+	s.request("draftslug")
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
+func (s *GetPostBySlugTestSuite) TestGetPostBySlugNotFound() {
+	s.request("unknownslug")
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
+func TestGetPostBySlugTestSuite(t *testing.T) {
+	suite.Run(t, new(GetPostBySlugTestSuite))
+}
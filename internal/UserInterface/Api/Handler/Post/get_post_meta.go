@@ -0,0 +1,56 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetPostMeta serves the Open Graph and Twitter Card metadata of a published
+// post for server side rendering of link previews. Old slugs redirect like
+// GetPostBySlug does.
+func GetPostMeta(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	slug := ctx.Param("slug")
+
+	post, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostBySlugQuery(slug))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return
+	}
+
+	postView, ok := post.(view.PostView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post data"})
+		return
+	}
+
+	if postView.Status != string(entity.PostStatusPublished) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+		return
+	}
+
+	if postView.Slug != slug {
+		location := postBySlugPath + postView.Slug + "/meta"
+		ctx.Header("Location", location)
+		ctx.JSON(http.StatusMovedPermanently, gin.H{"slug": postView.Slug, "location": location})
+		return
+	}
+
+	meta, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostMetaQuery(postView.Id))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	metaView, ok := meta.(view.PostMetaView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid post meta data"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, metaView)
+}
@@ -0,0 +1,87 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	query_bus "main/internal/Infrastructure/QueryBus"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetPostMetaTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+}
+
+func (s *GetPostMetaTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	postUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, excerpt, author_id, status, seo_title) VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', 'testexcerpt', $2, 'published', 'seotitle')", postUuid.String(), userUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO slug_history (slug, post_id, created_at) VALUES ('oldslug', $1, '2021-01-01 00:00:00')", postUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES (gen_random_uuid(), '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'draftslug', 'drafttitle', 'draftcontent', $1, 'draft')", userUuid.String())
+}
+
+func (s *GetPostMetaTestSuite) request(slug string) {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/posts/by-slug/"+slug+"/meta", nil)
+	s.Ctx.Params = gin.Params{gin.Param{Key: "slug", Value: slug}}
+
+	GetPostMeta(s.Ctx, s.QueryBus)
+}
+
+func (s *GetPostMetaTestSuite) TestGetPostMeta() {
+	s.request("testslug")
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"title":"seotitle"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"description":"testexcerpt"`)
+	assert.Contains(s.T(), s.W.Body.String(), `{"property":"og:title","content":"seotitle"}`)
+	assert.Contains(s.T(), s.W.Body.String(), `{"name":"twitter:card","content":"summary"}`)
+}
+
+func (s *GetPostMetaTestSuite) TestGetPostMetaOldSlug() {
+	s.request("oldslug")
+
+	assert.Equal(s.T(), http.StatusMovedPermanently, s.W.Code)
+	assert.Equal(s.T(), "/api/v1/posts/by-slug/testslug/meta", s.W.Header().Get("Location"))
+}
+
+func (s *GetPostMetaTestSuite) TestGetPostMetaDraft() {
+	s.request("draftslug")
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func (s *GetPostMetaTestSuite) TestGetPostMetaNotFound() {
+	s.request("unknownslug")
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func TestGetPostMetaTestSuite(t *testing.T) {
+	suite.Run(t, new(GetPostMetaTestSuite))
+}
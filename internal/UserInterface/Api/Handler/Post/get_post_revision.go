@@ -0,0 +1,32 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetPostRevision(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	revision, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision"})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "view revisions of", anyCollaborator)
+	if !ok {
+		return
+	}
+
+	q := post_query.NewGetPostRevisionQuery(postView.Id, revision)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,47 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	user_query "main/internal/Application/Query/User"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// InvitePostCollaborator lets another user work on a post in the given role,
+// or changes the role of an existing collaborator. Only the author and the
+// co-authors manage collaborators.
+func InvitePostCollaborator(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.InvitePostCollaboratorRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "manage collaborators of", entity.CollaboratorRole.CanManage)
+	if !ok {
+		return
+	}
+
+	userId := uuid.MustParse(req.UserId)
+	if userId == postView.AuthorId {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": entity.ErrCollaboratorIsAuthor.Error()})
+		return
+	}
+
+	if _, err := queryBus.Execute(ctx.Request.Context(), user_query.NewGetAuthorQuery(userId)); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "User not found"})
+		return
+	}
+
+	command := post_command.NewInvitePostCollaboratorCommand(postView.Id, userId, req.Role)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Collaborator invited"})
+}
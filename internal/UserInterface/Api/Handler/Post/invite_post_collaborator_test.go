@@ -0,0 +1,135 @@
+package post
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type InvitePostCollaboratorTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	PostUuid   uuid.UUID
+	AuthorUuid uuid.UUID
+	EditorUuid uuid.UUID
+	OtherUuid  uuid.UUID
+}
+
+func (s *InvitePostCollaboratorTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.invitePostCollaboratorCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.AuthorUuid = uuid.New()
+	s.EditorUuid = uuid.New()
+	s.OtherUuid = uuid.New()
+	for providerUserId, userUuid := range map[string]uuid.UUID{
+		"testprovideruser":   s.AuthorUuid,
+		"editorprovideruser": s.EditorUuid,
+		"otherprovideruser":  s.OtherUuid,
+	} {
+		test.GetTestContainer().DB.Exec(`
+			INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+			VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+		`, userUuid.String(), providerUserId, providerUserId+"@example.com")
+	}
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'draft')`,
+		s.PostUuid.String(),
+		s.AuthorUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO post_collaborators (post_id, user_id, role, created_at)
+	VALUES ($1, $2, 'editor', '2021-01-01 00:00:00')`,
+		s.PostUuid.String(),
+		s.EditorUuid.String(),
+	)
+}
+
+func (s *InvitePostCollaboratorTestSuite) newRequest(providerUserId string, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/posts/"+s.PostUuid.String()+"/collaborators",
+		strings.NewReader(body),
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.PostUuid.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = providerUserId + "@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *InvitePostCollaboratorTestSuite) TestInvitePostCollaborator() {
+	s.newRequest("testprovideruser", `{"user_id":"`+s.OtherUuid.String()+`","role":"co-author"}`)
+
+	InvitePostCollaborator(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Collaborator invited"}`, s.W.Body.String())
+	count := test.GetCommandCount("invitePostCollaboratorCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *InvitePostCollaboratorTestSuite) TestInvitePostCollaboratorByEditor() {
+	s.newRequest("editorprovideruser", `{"user_id":"`+s.OtherUuid.String()+`","role":"reviewer"}`)
+
+	InvitePostCollaborator(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to manage collaborators of this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("invitePostCollaboratorCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *InvitePostCollaboratorTestSuite) TestInvitePostCollaboratorAuthor() {
+	s.newRequest("testprovideruser", `{"user_id":"`+s.AuthorUuid.String()+`","role":"editor"}`)
+
+	InvitePostCollaborator(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"the author of a post cannot be invited as collaborator"}`, s.W.Body.String())
+}
+
+func (s *InvitePostCollaboratorTestSuite) TestInvitePostCollaboratorUnknownUser() {
+	s.newRequest("testprovideruser", `{"user_id":"`+uuid.New().String()+`","role":"editor"}`)
+
+	InvitePostCollaborator(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"User not found"}`, s.W.Body.String())
+}
+
+func TestInvitePostCollaboratorTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitePostCollaboratorTestSuite))
+}
@@ -0,0 +1,26 @@
+package post
+
+import (
+	annotation_query "main/internal/Application/Query/Annotation"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListAnnotations returns the open annotations of a post in the order of
+// their passages.
+func ListAnnotations(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	postView, _, ok := findAnnotatablePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	result, err := queryBus.Execute(ctx.Request.Context(), annotation_query.NewListOpenAnnotationsQuery(postView.Id))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,121 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListOwnPostsTestSuite struct {
+	suite.Suite
+	QueryBus  query_bus.QueryBus
+	Ctx       *gin.Context
+	W         *httptest.ResponseRecorder
+	DraftUuid uuid.UUID
+}
+
+func (s *ListOwnPostsTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid := uuid.New()
+	otherUserUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email, name)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser1', 'test1@example.com', 'author1')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email, name)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser2', 'test2@example.com', 'author2')
+	`, otherUserUuid.String())
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'slug1', 'First Post', 'This is the first post content', $2, 'published')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+	s.DraftUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', 'draft1', 'Draft Post', 'This is a draft post content', $2, 'draft')`,
+		s.DraftUuid.String(),
+		userUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-03 00:00:00', '2021-01-03 00:00:00', 'draft2', 'Other Draft', 'This is somebody else''s draft', $2, 'draft')`,
+		uuid.New().String(),
+		otherUserUuid.String(),
+	)
+}
+
+func (s *ListOwnPostsTestSuite) signIn(url string) {
+	s.Ctx.Request = httptest.NewRequest("GET", url, nil)
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "testprovideruser1"
+	session.Values["email"] = "test1@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *ListOwnPostsTestSuite) TestListOwnPosts() {
+	s.signIn("/api/v1/users/me/posts")
+
+	ListOwnPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":2`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"slug1"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"draft1"`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"draft2"`)
+}
+
+func (s *ListOwnPostsTestSuite) TestListOwnPostsByStatus() {
+	s.signIn("/api/v1/users/me/posts?status=draft")
+
+	ListOwnPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":1`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"draft1"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"status":"draft"`)
+}
+
+func (s *ListOwnPostsTestSuite) TestListOwnPostsInvalidStatus() {
+	s.signIn("/api/v1/users/me/posts?status=deleted")
+
+	ListOwnPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Invalid status"}`, s.W.Body.String())
+}
+
+func (s *ListOwnPostsTestSuite) TestGetOwnPostDraft() {
+	s.signIn("/api/v1/users/me/posts/" + s.DraftUuid.String())
+	s.Ctx.Params = gin.Params{gin.Param{Key: "id", Value: s.DraftUuid.String()}}
+
+	GetOwnPost(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"draft1"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"status":"draft"`)
+}
+
+func TestListOwnPostsTestSuite(t *testing.T) {
+	suite.Run(t, new(ListOwnPostsTestSuite))
+}
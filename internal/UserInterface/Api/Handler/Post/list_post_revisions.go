@@ -0,0 +1,38 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ListPostRevisions(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "view revisions of", anyCollaborator)
+	if !ok {
+		return
+	}
+
+	q := post_query.NewListPostRevisionsQuery(postView.Id, pageInt, pageSizeInt)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
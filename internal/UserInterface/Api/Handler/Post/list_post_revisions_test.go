@@ -0,0 +1,108 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type ListPostRevisionsTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+	PostUuid uuid.UUID
+}
+
+func (s *ListPostRevisionsTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM post_revisions")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, uuid.New().String())
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-02 00:00:00', 'newslug', 'newtitle', 'newcontent', $2, 'published')`,
+		s.PostUuid.String(),
+		userUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO post_revisions (created_at, post_id, revision, slug, title, content)
+	VALUES ('2021-01-01 00:00:00', $1, 1, 'oldslug', 'oldtitle', 'oldcontent'),
+	('2021-01-02 00:00:00', $1, 2, 'newslug', 'newtitle', 'newcontent')`,
+		s.PostUuid.String(),
+	)
+}
+
+func (s *ListPostRevisionsTestSuite) newRequest(providerUserId string, email string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts/"+s.PostUuid.String()+"/revisions",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: s.PostUuid.String(),
+		},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *ListPostRevisionsTestSuite) TestListPostRevisions() {
+	s.newRequest("testprovideruser", "test@example.com")
+
+	ListPostRevisions(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":2`)
+	assert.Contains(s.T(), s.W.Body.String(), `"revision":1`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"oldslug"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"revision":2`)
+}
+
+func (s *ListPostRevisionsTestSuite) TestListPostRevisionsNotOwner() {
+	s.newRequest("otherprovideruser", "other@example.com")
+
+	ListPostRevisions(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to view revisions of this post"}`, s.W.Body.String())
+}
+
+func TestListPostRevisionsTestSuite(t *testing.T) {
+	suite.Run(t, new(ListPostRevisionsTestSuite))
+}
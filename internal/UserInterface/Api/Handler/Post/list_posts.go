@@ -1,10 +1,14 @@
 package post
 
 import (
+	query "main/internal/Application/Query"
 	post_query "main/internal/Application/Query/Post"
+	entity "main/internal/Domain/Entity"
+	repository "main/internal/Domain/Repository"
 	query_bus "main/internal/Infrastructure/QueryBus"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,6 +19,11 @@ func ListPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
 	slug := ctx.Query("slug")
 	text := ctx.Query("text")
 	author := ctx.Query("author")
+	tagsAny := splitQueryList(ctx.Query("tags"))
+	tagsAll := splitQueryList(ctx.Query("allTags"))
+	category := ctx.Query("category")
+	sort := ctx.Query("sort")
+	includeContent := ctx.Query("includeContent") == "true"
 
 	var result any
 	var err error
@@ -31,7 +40,27 @@ func ListPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
 		return
 	}
 
-	q := post_query.NewFindAllByQuery(pageInt, pageSizeInt, slug, text, author)
+	if sort != "" && !repository.PostSort(sort).IsValid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort"})
+		return
+	}
+
+	// This endpoint is public, so it only ever lists published posts; authors
+	// find their other posts under /users/me/posts.
+	q := post_query.NewFindAllByQuery(post_query.Filters{
+		PaginationFilters: query.PaginationFilters{
+			Page:     pageInt,
+			PageSize: pageSizeInt,
+		},
+		Slug:     slug,
+		Text:     text,
+		Author:   author,
+		Status:   string(entity.PostStatusPublished),
+		TagsAny:  tagsAny,
+		TagsAll:  tagsAll,
+		Category: category,
+		Sort:     sort,
+	}, includeContent)
 	result, err = queryBus.Execute(ctx.Request.Context(), q)
 
 	if err != nil {
@@ -41,3 +70,11 @@ func ListPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
 
 	ctx.JSON(http.StatusOK, result)
 }
+
+// splitQueryList turns a comma separated query parameter into a list.
+func splitQueryList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
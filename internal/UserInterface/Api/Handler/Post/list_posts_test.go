@@ -5,12 +5,14 @@ import (
 	test "main/internal/Infrastructure/DependencyInjection/Test"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strconv"
 	"testing"
 
 	query_bus "main/internal/Infrastructure/QueryBus"
 
 	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/gin-gonic/gin"
@@ -57,8 +59,8 @@ func (s *ListPostsTestSuite) SetupTest() {
 		panic(err)
 	}
 	s.PostUuid1 = postUuid1
-	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id)
-	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'slug1', 'First Post', 'This is the first post content', $2)`,
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'slug1', 'First Post', 'This is the first post content', $2, 'published')`,
 		postUuid1.String(),
 		userUuid1.String(),
 	)
@@ -68,8 +70,8 @@ func (s *ListPostsTestSuite) SetupTest() {
 		panic(err)
 	}
 	s.PostUuid2 = postUuid2
-	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id)
-	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', 'slug2', 'Second Post', 'This is the second post content', $2)`,
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', 'slug2', 'Second Post', 'This is the second post content', $2, 'published')`,
 		postUuid2.String(),
 		userUuid2.String(),
 	)
@@ -79,11 +81,21 @@ func (s *ListPostsTestSuite) SetupTest() {
 		panic(err)
 	}
 	s.PostUuid3 = postUuid3
-	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id)
-	VALUES ($1, '2021-01-03 00:00:00', '2021-01-03 00:00:00', 'slug3', 'Third Post', 'This is the third post content', $2)`,
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-03 00:00:00', '2021-01-03 00:00:00', 'slug3', 'Third Post', 'This is the third post content', $2, 'published')`,
 		postUuid3.String(),
 		userUuid1.String(),
 	)
+
+	draftUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-04 00:00:00', '2021-01-04 00:00:00', 'draft1', 'Draft Post', 'This is a draft post content', $2, 'draft')`,
+		draftUuid.String(),
+		userUuid1.String(),
+	)
 }
 
 func (s *ListPostsTestSuite) TestListPosts() {
@@ -105,6 +117,91 @@ func (s *ListPostsTestSuite) TestListPosts() {
 	assert.Contains(s.T(), s.W.Body.String(), `"title":"Third Post"`)
 }
 
+func (s *ListPostsTestSuite) TestListPostsHidesDraftsFromAnonymous() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts?page=1&pageSize=10",
+		nil,
+	)
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	ListPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":3`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"draft1"`)
+}
+
+func (s *ListPostsTestSuite) TestListPostsHidesDraftsFromAuthor() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts?page=1&pageSize=10",
+		nil,
+	)
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "testprovideruser1"
+	session.Values["email"] = "test1@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+
+	ListPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":3`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"draft1"`)
+}
+
+func (s *ListPostsTestSuite) TestListPostsInvalidSort() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts?page=1&pageSize=10&sort=popular",
+		nil,
+	)
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	ListPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Invalid sort"}`, s.W.Body.String())
+}
+
+func (s *ListPostsTestSuite) TestListPostsSortNewest() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts?page=1&pageSize=1&sort=newest",
+		nil,
+	)
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	ListPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"slug3"`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"slug1"`)
+}
+
+func (s *ListPostsTestSuite) TestListPostsByTextMatchesWordForms() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/posts?page=1&pageSize=10&text=posts+-second",
+		nil,
+	)
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	ListPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"slug1"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"slug3"`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"slug2"`)
+}
+
 func (s *ListPostsTestSuite) TestListPostsByText() {
 	s.Ctx.Request = httptest.NewRequest(
 		"GET",
@@ -0,0 +1,56 @@
+package post
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	post_query "main/internal/Application/Query/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListReviewQueue lists the posts waiting for the current user's review,
+// oldest submission first. Users allowed to review any post see every post
+// in review.
+func ListReviewQueue(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	user, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	reviewerId := user.Id
+	if authorization.Can(user, entity.PermissionReviewAnyPost) {
+		reviewerId = uuid.Nil
+	}
+
+	q := post_query.NewFindReviewQueueQuery(pageInt, pageSizeInt, reviewerId)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,96 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type ListScheduledPostsTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+}
+
+func (s *ListScheduledPostsTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status, publish_at)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'scheduled', 'Scheduled Post', 'This post is scheduled', $2, 'draft', '2099-01-01 00:00:00')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'unscheduled', 'Plain Draft', 'This draft is not scheduled', $2, 'draft')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+}
+
+func (s *ListScheduledPostsTestSuite) TestListScheduledPosts() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/users/me/scheduled-posts?page=1&pageSize=10",
+		nil,
+	)
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "testprovideruser"
+	session.Values["email"] = "test@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+
+	ListScheduledPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":1`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"scheduled"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"publish_at":"2099-01-01`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"unscheduled"`)
+}
+
+func (s *ListScheduledPostsTestSuite) TestListScheduledPostsUnauthenticated() {
+	s.Ctx.Request = httptest.NewRequest(
+		"GET",
+		"/api/v1/users/me/scheduled-posts",
+		nil,
+	)
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+
+	ListScheduledPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, s.W.Code)
+	assert.Equal(s.T(), `{"error":"User not authenticated"}`, s.W.Body.String())
+}
+
+func TestListScheduledPostsTestSuite(t *testing.T) {
+	suite.Run(t, new(ListScheduledPostsTestSuite))
+}
@@ -0,0 +1,31 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// PublishPost publishes an approved post. Archived posts are unpublished and
+// reviewed again first.
+func PublishPost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findAccessiblePost(ctx, queryBus, "publish", entity.CollaboratorRole.CanManage)
+	if !ok {
+		return
+	}
+
+	status := entity.PostStatus(postView.Status)
+	if status != entity.PostStatusApproved && status != entity.PostStatusPublished {
+		ctx.JSON(http.StatusConflict, gin.H{"error": entity.ErrPostNotApproved.Error()})
+		return
+	}
+
+	command := post_command.NewPublishPostCommand(postView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post published"})
+}
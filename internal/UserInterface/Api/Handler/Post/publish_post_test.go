@@ -0,0 +1,146 @@
+package post
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type PublishPostTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	PostUuid   uuid.UUID
+}
+
+func (s *PublishPostTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.publishPostCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	postUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.PostUuid = postUuid
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'approved')`,
+		postUuid.String(),
+		userUuid.String(),
+	)
+}
+
+func (s *PublishPostTestSuite) newRequest(postId string, providerUserId string, email string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/posts/"+postId+"/publish",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: postId,
+		},
+	}
+	if providerUserId != "" {
+		session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+		if err != nil {
+			panic(err)
+		}
+		session.Values["provider_user_id"] = providerUserId
+		session.Values["email"] = email
+		if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+			panic(err)
+		}
+		s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+}
+
+func (s *PublishPostTestSuite) TestPublishPost() {
+	s.newRequest(s.PostUuid.String(), "testprovideruser", "test@example.com")
+
+	PublishPost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Post published"}`, s.W.Body.String())
+	count := test.GetCommandCount("publishPostCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *PublishPostTestSuite) TestPublishPostNotApproved() {
+	test.GetTestContainer().DB.Exec("UPDATE posts SET status = 'in_review' WHERE id = ?", s.PostUuid.String())
+	s.newRequest(s.PostUuid.String(), "testprovideruser", "test@example.com")
+
+	PublishPost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"only approved posts can be published"}`, s.W.Body.String())
+	count := test.GetCommandCount("publishPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *PublishPostTestSuite) TestPublishPostInvalidPostId() {
+	s.newRequest("invalid-uuid", "testprovideruser", "test@example.com")
+
+	PublishPost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Invalid post ID"}`, s.W.Body.String())
+}
+
+func (s *PublishPostTestSuite) TestPublishPostNotOwner() {
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, uuid.New().String())
+	s.newRequest(s.PostUuid.String(), "otherprovideruser", "other@example.com")
+
+	PublishPost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to publish this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("publishPostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *PublishPostTestSuite) TestPublishPostUnauthenticated() {
+	s.newRequest(s.PostUuid.String(), "", "")
+
+	PublishPost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, s.W.Code)
+	assert.Equal(s.T(), `{"error":"User not authenticated"}`, s.W.Body.String())
+}
+
+func TestPublishPostTestSuite(t *testing.T) {
+	suite.Run(t, new(PublishPostTestSuite))
+}
@@ -0,0 +1,24 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// PurgePost deletes a trashed post for good, without waiting for the
+// retention period to pass.
+func PurgePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findTrashedPost(ctx, queryBus, "purge")
+	if !ok {
+		return
+	}
+
+	command := post_command.NewPurgePostCommand(postView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post purged"})
+}
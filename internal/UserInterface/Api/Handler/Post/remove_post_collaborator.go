@@ -0,0 +1,43 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func RemovePostCollaborator(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	userId, err := uuid.Parse(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "manage collaborators of", entity.CollaboratorRole.CanManage)
+	if !ok {
+		return
+	}
+
+	found := false
+	for _, collaborator := range postView.Collaborators {
+		if collaborator.UserId == userId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User is not a collaborator of the post"})
+		return
+	}
+
+	command := post_command.NewRemovePostCollaboratorCommand(postView.Id, userId)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Collaborator removed"})
+}
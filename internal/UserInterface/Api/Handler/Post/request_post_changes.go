@@ -0,0 +1,31 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestPostChanges sends a post in review back to its authors with the
+// notes of the reviewer.
+func RequestPostChanges(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.RequestPostChangesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	postView, userView, ok := findReviewablePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	command := post_command.NewRequestPostChangesCommand(postView.Id, userView.Id, req.Notes)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Changes requested"})
+}
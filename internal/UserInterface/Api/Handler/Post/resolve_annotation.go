@@ -0,0 +1,27 @@
+package post
+
+import (
+	annotation_command "main/internal/Application/Command/Annotation"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+func ResolveAnnotation(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, userView, ok := findAnnotatablePost(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	annotationView, ok := findAnnotation(ctx, queryBus, postView)
+	if !ok {
+		return
+	}
+
+	command := annotation_command.NewResolveAnnotationCommand(annotationView.Id, userView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Annotation resolved"})
+}
@@ -0,0 +1,24 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// RestorePost takes a post out of the trash with the status it had when it
+// was deleted.
+func RestorePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findTrashedPost(ctx, queryBus, "restore")
+	if !ok {
+		return
+	}
+
+	command := post_command.NewRestorePostCommand(postView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post restored"})
+}
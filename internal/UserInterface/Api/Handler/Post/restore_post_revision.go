@@ -0,0 +1,36 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	post_query "main/internal/Application/Query/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"strconv"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+func RestorePostRevision(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	revision, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision"})
+		return
+	}
+
+	postView, ok := findAccessiblePost(ctx, queryBus, "restore", entity.CollaboratorRole.CanEdit)
+	if !ok {
+		return
+	}
+
+	if _, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostRevisionQuery(postView.Id, revision)); err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Revision not found"})
+		return
+	}
+
+	command := post_command.NewRestorePostRevisionCommand(postView.Id, revision)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post revision restored"})
+}
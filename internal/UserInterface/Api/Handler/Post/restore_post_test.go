@@ -0,0 +1,126 @@
+package post
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type RestorePostTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	PostUuid   uuid.UUID
+}
+
+func (s *RestorePostTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.restorePostCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, uuid.New().String())
+	postUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	s.PostUuid = postUuid
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, deleted_at)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, '2021-01-02 00:00:00')`,
+		postUuid.String(),
+		userUuid.String(),
+	)
+}
+
+func (s *RestorePostTestSuite) newRequest(providerUserId string, email string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/users/me/trash/"+s.PostUuid.String()+"/restore",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{
+			Key:   "id",
+			Value: s.PostUuid.String(),
+		},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+}
+
+func (s *RestorePostTestSuite) TestRestorePost() {
+	s.newRequest("testprovideruser", "test@example.com")
+
+	RestorePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Post restored"}`, s.W.Body.String())
+	count := test.GetCommandCount("restorePostCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *RestorePostTestSuite) TestRestorePostNotAuthor() {
+	s.newRequest("otherprovideruser", "other@example.com")
+
+	RestorePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to restore this post"}`, s.W.Body.String())
+	count := test.GetCommandCount("restorePostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *RestorePostTestSuite) TestRestorePostNotInTrash() {
+	test.GetTestContainer().DB.Exec("UPDATE posts SET deleted_at = NULL")
+	s.newRequest("testprovideruser", "test@example.com")
+
+	RestorePost(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+	count := test.GetCommandCount("restorePostCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func TestRestorePostTestSuite(t *testing.T) {
+	suite.Run(t, new(RestorePostTestSuite))
+}
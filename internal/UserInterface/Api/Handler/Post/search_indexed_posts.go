@@ -0,0 +1,62 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxFuzziness is the largest edit distance the search index supports.
+const maxFuzziness = 2
+
+func SearchIndexedPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	text := strings.TrimSpace(ctx.Query("q"))
+	author := ctx.Query("author")
+	tags := splitQueryList(ctx.Query("tags"))
+
+	if text == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Missing search query"})
+		return
+	}
+
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	fuzziness := -1
+	if value := ctx.DefaultQuery("fuzziness", "auto"); value != "auto" {
+		fuzziness, err = strconv.Atoi(value)
+		if err != nil || fuzziness < 0 || fuzziness > maxFuzziness {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid fuzziness"})
+			return
+		}
+	}
+
+	viewerId := uuid.Nil
+	if user, err := session.GetCurrentUser(ctx, queryBus); err == nil {
+		viewerId = user.Id
+	}
+
+	q := post_query.NewSearchPostsQuery(pageInt, pageSizeInt, text, fuzziness, author, tags, viewerId)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,55 @@
+package post
+
+import (
+	post_query "main/internal/Application/Query/Post"
+	repository "main/internal/Domain/Repository"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func SearchPosts(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	text := strings.TrimSpace(ctx.Query("q"))
+	sort := ctx.Query("sort")
+
+	if text == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Missing search query"})
+		return
+	}
+
+	pageInt, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+
+	pageSizeInt, err := strconv.Atoi(ctx.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pageSize"})
+		return
+	}
+
+	if sort != "" && !repository.PostSort(sort).IsValid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort"})
+		return
+	}
+
+	viewerId := uuid.Nil
+	if user, err := session.GetCurrentUser(ctx, queryBus); err == nil {
+		viewerId = user.Id
+	}
+
+	q := post_query.NewFullTextSearchQuery(pageInt, pageSizeInt, text, sort, viewerId)
+	result, err := queryBus.Execute(ctx.Request.Context(), q)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
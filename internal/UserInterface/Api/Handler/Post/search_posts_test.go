@@ -0,0 +1,99 @@
+package post
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchPostsTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+}
+
+func (s *SearchPostsTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email, name)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser1', 'test1@example.com', 'author1')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'slug1', 'Generics in Go', 'A short tour of type parameters', $2, 'published')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-02 00:00:00', '2021-01-02 00:00:00', 'slug2', 'Interfaces in Go', 'Interfaces existed long before generics arrived', $2, 'published')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-03 00:00:00', '2021-01-03 00:00:00', 'slug3', 'Draft about generics', 'Not ready yet', $2, 'draft')`,
+		uuid.New().String(),
+		userUuid.String(),
+	)
+}
+
+func (s *SearchPostsTestSuite) TestSearchPostsRanksTitleMatchesFirst() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/posts/search?q=generics", nil)
+
+	SearchPosts(s.Ctx, s.QueryBus)
+
+	body := s.W.Body.String()
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), body, `"total":2`)
+	assert.NotContains(s.T(), body, `"slug":"slug3"`)
+	assert.Less(s.T(), strings.Index(body, `"slug":"slug1"`), strings.Index(body, `"slug":"slug2"`))
+	assert.Contains(s.T(), body, `"title":"\u003cmark\u003eGenerics\u003c/mark\u003e in Go"`)
+}
+
+func (s *SearchPostsTestSuite) TestSearchPostsExcludedTerm() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/posts/search?q=generics+-interfaces", nil)
+
+	SearchPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"total":1`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"slug1"`)
+}
+
+func (s *SearchPostsTestSuite) TestSearchPostsMissingQuery() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/posts/search", nil)
+
+	SearchPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Missing search query"}`, s.W.Body.String())
+}
+
+func (s *SearchPostsTestSuite) TestSearchPostsInvalidSort() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/posts/search?q=generics&sort=popular", nil)
+
+	SearchPosts(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Invalid sort"}`, s.W.Body.String())
+}
+
+func TestSearchPostsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchPostsTestSuite))
+}
@@ -0,0 +1,31 @@
+package post
+
+import (
+	post_command "main/internal/Application/Command/Post"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// SubmitPostForReview hands a draft, or a post whose changes were requested,
+// to the reviewers. Editors may submit the posts they work on.
+func SubmitPostForReview(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postView, ok := findAccessiblePost(ctx, queryBus, "submit", entity.CollaboratorRole.CanEdit)
+	if !ok {
+		return
+	}
+
+	status := entity.PostStatus(postView.Status)
+	if status != entity.PostStatusInReview && !status.CanTransitionTo(entity.PostStatusInReview) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": entity.ErrInvalidPostStatusTransition.Error()})
+		return
+	}
+
+	command := post_command.NewSubmitPostForReviewCommand(postView.Id)
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post submitted for review"})
+}
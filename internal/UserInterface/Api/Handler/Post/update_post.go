@@ -1,17 +1,17 @@
 package post
 
 import (
+	"errors"
 	post_command "main/internal/Application/Command/Post"
 	post_query "main/internal/Application/Query/Post"
-	user_query "main/internal/Application/Query/User"
 	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
 	query_bus "main/internal/Infrastructure/QueryBus"
 	request "main/internal/UserInterface/Api/Request"
+	session "main/internal/UserInterface/Api/Session"
 	"net/http"
-	"os"
 
 	"github.com/ThreeDotsLabs/watermill/components/cqrs"
-	"github.com/markbates/goth/gothic"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -31,35 +31,16 @@ func UpdatePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bu
 		return
 	}
 
-	session, err := gothic.Store.Get(ctx.Request, os.Getenv("SESSION_NAME"))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	providerUserId := session.Values["provider_user_id"]
-	email := session.Values["email"]
-
-	if providerUserId == nil || email == nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
-
-	user, err := queryBus.Execute(
-		ctx.Request.Context(),
-		user_query.NewFindUserByQuery(providerUserId.(string), email.(string)),
-	)
-
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	if user == nil {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
-		return
-	}
-
 	post, err := queryBus.Execute(
 		ctx.Request.Context(),
 		post_query.NewGetPostQuery(postId),
@@ -76,14 +57,27 @@ func UpdatePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bu
 		return
 	}
 
-	userView, ok := user.(view.UserView)
-	if !ok {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user data"})
+	if !canAccess(postView, userView, entity.CollaboratorRole.CanEdit) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to update this post"})
 		return
 	}
 
-	if postView.AuthorId != userView.Id {
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to update this post"})
+	if req.PublishAt != nil && !entity.PostStatus(postView.Status).IsPending() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": entity.ErrPostNotSchedulable.Error()})
+		return
+	}
+
+	// A kept cover may have been uploaded by another author of the post.
+	coverMediaId := postView.CoverMediaId
+	if coverMediaId == nil || req.CoverMediaId != coverMediaId.String() {
+		coverMediaId, ok = findCoverMedia(ctx, queryBus, req.CoverMediaId, userView.Id)
+		if !ok {
+			return
+		}
+	}
+
+	categoryId, ok := findCategory(ctx, queryBus, req.CategoryId)
+	if !ok {
 		return
 	}
 
@@ -92,6 +86,15 @@ func UpdatePost(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bu
 		req.Slug,
 		req.Title,
 		req.Content,
+		req.ContentFormat,
+		req.Excerpt,
+		req.PublishAt,
+		req.Tags,
+		coverMediaId,
+		req.SeoTitle,
+		req.MetaDescription,
+		req.CanonicalURL,
+		categoryId,
 	)
 
 	commandBus.Send(ctx.Request.Context(), command)
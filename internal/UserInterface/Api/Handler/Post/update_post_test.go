@@ -355,6 +355,66 @@ func (s *UpdatePostTestSuite) TestUpdatePostUnauthorized() {
 	assert.Equal(s.T(), 0, count)
 }
 
+func (s *UpdatePostTestSuite) TestUpdatePostCollaborators() {
+	tests := []struct {
+		role           string
+		expectedStatus int
+	}{
+		{role: "co-author", expectedStatus: http.StatusAccepted},
+		{role: "editor", expectedStatus: http.StatusAccepted},
+		{role: "reviewer", expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.role, func() {
+			collaboratorUuid := uuid.New()
+			test.GetTestContainer().DB.Exec(`
+				INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+				VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+			`, collaboratorUuid.String(), tt.role+"provideruser", tt.role+"@example.com")
+			test.GetTestContainer().DB.Exec(`INSERT INTO post_collaborators (post_id, user_id, role, created_at)
+			VALUES ($1, $2, $3, '2021-01-01 00:00:00')`,
+				s.PostUuid.String(),
+				collaboratorUuid.String(),
+				tt.role,
+			)
+
+			w := httptest.NewRecorder()
+			ctx := gin.CreateTestContextOnly(w, gin.Default())
+			ctx.Request = httptest.NewRequest(
+				"PUT",
+				"/api/v1/posts/"+s.PostUuid.String(),
+				io.NopCloser(bytes.NewBufferString(`{
+				"slug": "updatedslug",
+				"title": "updatedtitle",
+				"content": "updatedcontent"
+			}`)),
+			)
+			ctx.Params = gin.Params{
+				gin.Param{
+					Key:   "id",
+					Value: s.PostUuid.String(),
+				},
+			}
+			session, err := gothic.Store.New(ctx.Request, os.Getenv("SESSION_NAME"))
+			if err != nil {
+				panic(err)
+			}
+			session.Values["provider_user_id"] = tt.role + "provideruser"
+			session.Values["email"] = tt.role + "@example.com"
+			if err := session.Save(ctx.Request, w); err != nil {
+				panic(err)
+			}
+			ctx.Request.Header.Set("Content-Type", "application/json")
+			ctx.Request.Header.Set("Cookie", w.Header().Get("Set-Cookie"))
+
+			UpdatePost(ctx, s.CommandBus, s.QueryBus)
+
+			assert.Equal(s.T(), tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func (s *UpdatePostTestSuite) TestUpdatePostUnauthenticated() {
 	s.Ctx.Request = httptest.NewRequest(
 		"PUT",
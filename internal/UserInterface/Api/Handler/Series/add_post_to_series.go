@@ -0,0 +1,53 @@
+package series
+
+import (
+	series_command "main/internal/Application/Command/Series"
+	post_query "main/internal/Application/Query/Post"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AddPostToSeries appends one of the author's posts to the end of their
+// series. A post can only be part of one series at a time.
+func AddPostToSeries(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.AddPostToSeriesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	seriesView, ok := findOwnSeries(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	postId := uuid.MustParse(req.PostId)
+	post, err := queryBus.Execute(ctx.Request.Context(), post_query.NewGetPostQuery(postId))
+	postView, ok := post.(view.PostView)
+	if err != nil || !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Post not found"})
+		return
+	}
+
+	if postView.AuthorId != seriesView.AuthorId {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to add this post to a series"})
+		return
+	}
+
+	if postView.Series != nil {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Post is already part of a series"})
+		return
+	}
+
+	command := series_command.NewAddPostToSeriesCommand(seriesView.Id, postId)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post added to series"})
+}
@@ -0,0 +1,143 @@
+package series
+
+import (
+	"database/sql"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type AddPostToSeriesTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	SeriesUuid uuid.UUID
+	PostUuid   uuid.UUID
+	AuthorUuid uuid.UUID
+}
+
+func (s *AddPostToSeriesTestSuite) SetupTest() {
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.addPostToSeriesCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM series")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.AuthorUuid = uuid.New()
+	otherUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, s.AuthorUuid.String())
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'otherprovideruser', 'other@example.com')
+	`, otherUuid.String())
+	s.SeriesUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO series (id, created_at, updated_at, author_id, title)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', ?, 'Go tutorial')
+	`, s.SeriesUuid.String(), s.AuthorUuid.String())
+	s.PostUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+	VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'testslug', 'testtitle', 'testcontent', $2, 'draft')`,
+		s.PostUuid.String(),
+		s.AuthorUuid.String(),
+	)
+}
+
+func (s *AddPostToSeriesTestSuite) newRequest(providerUserId string, email string, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/series/"+s.SeriesUuid.String()+"/posts",
+		strings.NewReader(body),
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: s.SeriesUuid.String()},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = providerUserId
+	session.Values["email"] = email
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+}
+
+func (s *AddPostToSeriesTestSuite) TestAddPostToSeries() {
+	s.newRequest("testprovideruser", "test@example.com", `{"post_id":"`+s.PostUuid.String()+`"}`)
+
+	AddPostToSeries(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Post added to series"}`, s.W.Body.String())
+	count := test.GetCommandCount("addPostToSeriesCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *AddPostToSeriesTestSuite) TestAddPostToSeriesNotSeriesAuthor() {
+	s.newRequest("otherprovideruser", "other@example.com", `{"post_id":"`+s.PostUuid.String()+`"}`)
+
+	AddPostToSeries(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusForbidden, s.W.Code)
+	assert.Equal(s.T(), `{"error":"You are not authorized to edit this series"}`, s.W.Body.String())
+	count := test.GetCommandCount("addPostToSeriesCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *AddPostToSeriesTestSuite) TestAddPostToSeriesAlreadyInSeries() {
+	otherSeriesUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO series (id, created_at, updated_at, author_id, title)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', ?, 'Other series')
+	`, otherSeriesUuid.String(), s.AuthorUuid.String())
+	test.GetTestContainer().DB.Exec(`INSERT INTO series_posts (series_id, post_id, position) VALUES (?, ?, 1)`,
+		otherSeriesUuid.String(),
+		s.PostUuid.String(),
+	)
+	s.newRequest("testprovideruser", "test@example.com", `{"post_id":"`+s.PostUuid.String()+`"}`)
+
+	AddPostToSeries(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusConflict, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post is already part of a series"}`, s.W.Body.String())
+	count := test.GetCommandCount("addPostToSeriesCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *AddPostToSeriesTestSuite) TestAddPostToSeriesUnknownPost() {
+	s.newRequest("testprovideruser", "test@example.com", `{"post_id":"`+uuid.New().String()+`"}`)
+
+	AddPostToSeries(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	assert.Equal(s.T(), `{"error":"Post not found"}`, s.W.Body.String())
+}
+
+func TestAddPostToSeriesTestSuite(t *testing.T) {
+	suite.Run(t, new(AddPostToSeriesTestSuite))
+}
@@ -0,0 +1,53 @@
+package series
+
+import (
+	"errors"
+	series_query "main/internal/Application/Query/Series"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findOwnSeries loads the series identified by the :id route param and makes
+// sure the current user is its author. On failure the error response is
+// already written and false is returned.
+func findOwnSeries(ctx *gin.Context, queryBus query_bus.QueryBus) (view.SeriesView, bool) {
+	seriesId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid series ID"})
+		return view.SeriesView{}, false
+	}
+
+	userView, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.SeriesView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.SeriesView{}, false
+	}
+
+	series, err := queryBus.Execute(ctx.Request.Context(), series_query.NewGetSeriesQuery(seriesId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Series not found"})
+		return view.SeriesView{}, false
+	}
+
+	seriesView, ok := series.(view.SeriesView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid series data"})
+		return view.SeriesView{}, false
+	}
+
+	if seriesView.AuthorId != userView.Id {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "You are not authorized to edit this series"})
+		return view.SeriesView{}, false
+	}
+
+	return seriesView, true
+}
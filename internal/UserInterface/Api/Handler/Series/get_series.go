@@ -0,0 +1,47 @@
+package series
+
+import (
+	series_query "main/internal/Application/Query/Series"
+	view "main/internal/Application/View"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetSeries lists the posts of a series in reading order. Readers other than
+// the author only see the published posts, which keep their positions.
+func GetSeries(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	seriesId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid series ID"})
+		return
+	}
+
+	series, err := queryBus.Execute(ctx.Request.Context(), series_query.NewGetSeriesQuery(seriesId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Series not found"})
+		return
+	}
+
+	seriesView, ok := series.(view.SeriesView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid series data"})
+		return
+	}
+
+	if user, err := session.GetCurrentUser(ctx, queryBus); err != nil || user.Id != seriesView.AuthorId {
+		posts := make([]view.SeriesPostView, 0, len(seriesView.Posts))
+		for _, post := range seriesView.Posts {
+			if post.Status == string(entity.PostStatusPublished) {
+				posts = append(posts, post)
+			}
+		}
+		seriesView.Posts = posts
+	}
+
+	ctx.JSON(http.StatusOK, seriesView)
+}
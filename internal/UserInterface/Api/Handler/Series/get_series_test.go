@@ -0,0 +1,103 @@
+package series
+
+import (
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetSeriesTestSuite struct {
+	suite.Suite
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	SeriesUuid uuid.UUID
+}
+
+func (s *GetSeriesTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+
+	test.GetTestContainer().DB.Exec("DELETE FROM series")
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid := uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	s.SeriesUuid = uuid.New()
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO series (id, created_at, updated_at, author_id, title, description)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', ?, 'Go tutorial', 'Learn Go step by step')
+	`, s.SeriesUuid.String(), userUuid.String())
+	for i, post := range []struct {
+		slug   string
+		status string
+	}{
+		{"part-1", "published"},
+		{"part-2", "draft"},
+		{"part-3", "published"},
+	} {
+		postUuid := uuid.New()
+		test.GetTestContainer().DB.Exec(`INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status)
+		VALUES ($1, '2021-01-01 00:00:00', '2021-01-01 00:00:00', $2, $2, 'testcontent', $3, $4)`,
+			postUuid.String(),
+			post.slug,
+			userUuid.String(),
+			post.status,
+		)
+		test.GetTestContainer().DB.Exec(`INSERT INTO series_posts (series_id, post_id, position) VALUES (?, ?, ?)`,
+			s.SeriesUuid.String(),
+			postUuid.String(),
+			i+1,
+		)
+	}
+}
+
+func (s *GetSeriesTestSuite) TestGetSeries() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/series/"+s.SeriesUuid.String(), nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: s.SeriesUuid.String()}}
+
+	GetSeries(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Contains(s.T(), s.W.Body.String(), `"title":"Go tutorial"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"part-1"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"slug":"part-3"`)
+	assert.Contains(s.T(), s.W.Body.String(), `"position":3`)
+	assert.NotContains(s.T(), s.W.Body.String(), `"slug":"part-2"`)
+}
+
+func (s *GetSeriesTestSuite) TestGetSeriesNotFound() {
+	id := uuid.New().String()
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/series/"+id, nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: id}}
+
+	GetSeries(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func (s *GetSeriesTestSuite) TestGetSeriesInvalidId() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/api/v1/series/invalid", nil)
+	s.Ctx.Params = gin.Params{{Key: "id", Value: "invalid"}}
+
+	GetSeries(s.Ctx, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+}
+
+func TestGetSeriesTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSeriesTestSuite))
+}
@@ -0,0 +1,37 @@
+package series
+
+import (
+	series_command "main/internal/Application/Command/Series"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"slices"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func RemovePostFromSeries(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	postId, err := uuid.Parse(ctx.Param("postId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	seriesView, ok := findOwnSeries(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	if !slices.ContainsFunc(seriesView.Posts, func(post view.SeriesPostView) bool { return post.Id == postId }) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post is not part of the series"})
+		return
+	}
+
+	command := series_command.NewRemovePostFromSeriesCommand(seriesView.Id, postId)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Post removed from series"})
+}
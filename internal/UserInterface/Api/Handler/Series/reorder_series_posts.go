@@ -0,0 +1,51 @@
+package series
+
+import (
+	series_command "main/internal/Application/Command/Series"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReorderSeriesPosts puts the posts of a series in the given order, which has
+// to list every post of the series exactly once.
+func ReorderSeriesPosts(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.ReorderSeriesPostsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	seriesView, ok := findOwnSeries(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	members := make(map[uuid.UUID]bool, len(seriesView.Posts))
+	for _, post := range seriesView.Posts {
+		members[post.Id] = true
+	}
+	postIds := make([]uuid.UUID, len(req.PostIds))
+	for i, id := range req.PostIds {
+		postIds[i] = uuid.MustParse(id)
+		if !members[postIds[i]] {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Post IDs must list every post of the series exactly once"})
+			return
+		}
+		delete(members, postIds[i])
+	}
+	if len(members) > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Post IDs must list every post of the series exactly once"})
+		return
+	}
+
+	command := series_command.NewReorderSeriesPostsCommand(seriesView.Id, postIds)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Series reordered"})
+}
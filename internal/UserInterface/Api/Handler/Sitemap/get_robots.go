@@ -0,0 +1,20 @@
+package sitemap
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetRobots allows crawlers everywhere except for the API and the login flow,
+// and points them at the sitemap.
+func GetRobots(ctx *gin.Context, sitemapURL string) {
+	robots := "User-agent: *\n" +
+		"Disallow: /api/\n" +
+		"Disallow: /auth/\n" +
+		"Allow: /\n" +
+		"\n" +
+		"Sitemap: " + sitemapURL + "\n"
+
+	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(robots))
+}
@@ -0,0 +1,25 @@
+package sitemap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRobots(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx := gin.CreateTestContextOnly(w, gin.Default())
+	ctx.Request = httptest.NewRequest("GET", "/robots.txt", nil)
+
+	GetRobots(ctx, "https://api.example.com/sitemap.xml")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "User-agent: *\n")
+	assert.Contains(t, w.Body.String(), "Disallow: /api/\n")
+	assert.Contains(t, w.Body.String(), "Sitemap: https://api.example.com/sitemap.xml\n")
+}
@@ -0,0 +1,34 @@
+package sitemap
+
+import (
+	"errors"
+	sitemap_query "main/internal/Application/Query/Sitemap"
+	view "main/internal/Application/View"
+	repository "main/internal/Domain/Repository"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSitemap serves the sitemap file stored under name, which is either
+// sitemap.xml or one of the sitemaps/:file parts it links to.
+func GetSitemap(ctx *gin.Context, queryBus query_bus.QueryBus, name string) {
+	result, err := queryBus.Execute(ctx.Request.Context(), sitemap_query.NewGetSitemapQuery(name))
+	if errors.Is(err, repository.ErrSitemapFileNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Sitemap not found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	sitemapView, ok := result.(view.SitemapView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid sitemap data"})
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/xml; charset=utf-8", []byte(sitemapView.Content))
+}
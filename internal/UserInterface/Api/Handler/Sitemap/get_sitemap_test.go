@@ -0,0 +1,70 @@
+package sitemap
+
+import (
+	"context"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	query_bus "main/internal/Infrastructure/QueryBus"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetSitemapTestSuite struct {
+	suite.Suite
+	QueryBus query_bus.QueryBus
+	Ctx      *gin.Context
+	W        *httptest.ResponseRecorder
+}
+
+func (s *GetSitemapTestSuite) SetupTest() {
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	userUuid, err := uuid.NewRandom()
+	if err != nil {
+		panic(err)
+	}
+	test.GetTestContainer().DB.Exec(`
+		INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+		VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', 'testprovideruser', 'test@example.com')
+	`, userUuid.String())
+	test.GetTestContainer().DB.Exec("DELETE FROM posts")
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES (gen_random_uuid(), '2021-01-01 00:00:00', '2021-01-02 00:00:00', 'publishedslug', 'publishedtitle', 'publishedcontent', $1, 'published')", userUuid.String())
+	test.GetTestContainer().DB.Exec("INSERT INTO posts (id, created_at, updated_at, slug, title, content, author_id, status) VALUES (gen_random_uuid(), '2021-01-01 00:00:00', '2021-01-02 00:00:00', 'draftslug', 'drafttitle', 'draftcontent', $1, 'draft')", userUuid.String())
+	if err := test.GetTestContainer().SitemapGenerator.Generate(context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func (s *GetSitemapTestSuite) TestGetSitemap() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/sitemap.xml", nil)
+
+	GetSitemap(s.Ctx, s.QueryBus, "sitemap.xml")
+
+	assert.Equal(s.T(), http.StatusOK, s.W.Code)
+	assert.Equal(s.T(), "application/xml; charset=utf-8", s.W.Header().Get("Content-Type"))
+	assert.Contains(s.T(), s.W.Body.String(), "/posts/publishedslug</loc>")
+	assert.Contains(s.T(), s.W.Body.String(), "<lastmod>2021-01-02T00:00:00Z</lastmod>")
+	assert.NotContains(s.T(), s.W.Body.String(), "draftslug")
+}
+
+func (s *GetSitemapTestSuite) TestGetSitemapUnknownFile() {
+	s.Ctx.Request = httptest.NewRequest("GET", "/sitemaps/1.xml", nil)
+
+	GetSitemap(s.Ctx, s.QueryBus, "sitemaps/1.xml")
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+}
+
+func TestGetSitemapTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSitemapTestSuite))
+}
@@ -0,0 +1,19 @@
+package tag
+
+import (
+	tag_query "main/internal/Application/Query/Tag"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ListTags(ctx *gin.Context, queryBus query_bus.QueryBus) {
+	result, err := queryBus.Execute(ctx.Request.Context(), tag_query.NewListTagsQuery())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"errors"
+	user_query "main/internal/Application/Query/User"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// findManagedUser loads the user identified by the :id route param, whose
+// roles are managed, and the current user managing them. The permission to
+// manage roles is checked by the route. On failure the error response is
+// already written and false is returned.
+func findManagedUser(ctx *gin.Context, queryBus query_bus.QueryBus) (view.UserView, view.UserView, bool) {
+	userId, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return view.UserView{}, view.UserView{}, false
+	}
+
+	currentUser, err := session.GetCurrentUser(ctx, queryBus)
+	if errors.Is(err, session.ErrUserNotAuthenticated) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return view.UserView{}, view.UserView{}, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return view.UserView{}, view.UserView{}, false
+	}
+
+	user, err := queryBus.Execute(ctx.Request.Context(), user_query.NewGetUserQuery(userId))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return view.UserView{}, view.UserView{}, false
+	}
+
+	userView, ok := user.(view.UserView)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user data"})
+		return view.UserView{}, view.UserView{}, false
+	}
+
+	return userView, currentUser, true
+}
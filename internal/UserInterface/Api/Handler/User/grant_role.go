@@ -0,0 +1,32 @@
+package user
+
+import (
+	user_command "main/internal/Application/Command/User"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	request "main/internal/UserInterface/Api/Request"
+	"net/http"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// GrantRole gives a user a role on behalf of an admin. Granting a role the
+// user already has changes nothing.
+func GrantRole(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	var req request.GrantRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userView, currentUser, ok := findManagedUser(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	command := user_command.NewGrantRoleCommand(userView.Id, req.Role, currentUser.Id)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Role granted"})
+}
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"bytes"
+	"database/sql"
+	"io"
+	test "main/internal/Infrastructure/DependencyInjection/Test"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/google/uuid"
+	"github.com/markbates/goth/gothic"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GrantRoleTestSuite struct {
+	suite.Suite
+	CommandBus *cqrs.CommandBus
+	QueryBus   query_bus.QueryBus
+	Ctx        *gin.Context
+	W          *httptest.ResponseRecorder
+	PubSubDb   *sql.DB
+	AdminUuid  uuid.UUID
+	UserUuid   uuid.UUID
+}
+
+func (s *GrantRoleTestSuite) SetupTest() {
+	if os.Getenv("SESSION_NAME") == "" {
+		_ = os.Setenv("SESSION_NAME", "blog_session")
+	}
+
+	s.CommandBus = test.GetTestContainer().CommandBus
+	s.QueryBus = test.GetTestContainer().QueryBus
+	s.W = httptest.NewRecorder()
+	s.Ctx = gin.CreateTestContextOnly(s.W, gin.Default())
+	gin.SetMode(gin.TestMode)
+	s.PubSubDb = test.GetPubSubDb()
+	s.PubSubDb.Exec("DELETE FROM `watermill_commands.GrantRoleCommand`")
+	test.GetTestContainer().DB.Exec("DELETE FROM users")
+	s.AdminUuid = uuid.New()
+	s.UserUuid = uuid.New()
+	for providerUserId, userUuid := range map[string]uuid.UUID{
+		"adminprovideruser": s.AdminUuid,
+		"testprovideruser":  s.UserUuid,
+	} {
+		test.GetTestContainer().DB.Exec(`
+			INSERT INTO users (id, created_at, updated_at, provider, provider_user_id, email)
+			VALUES (?, '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'test', ?, ?)
+		`, userUuid.String(), providerUserId, providerUserId+"@example.com")
+	}
+	test.GetTestContainer().DB.Exec("INSERT INTO user_roles (user_id, role, granted_at) VALUES (?, 'admin', NOW())", s.AdminUuid.String())
+}
+
+func (s *GrantRoleTestSuite) newRequest(userId string, body string) {
+	s.Ctx.Request = httptest.NewRequest(
+		"POST",
+		"/api/v1/users/"+userId+"/roles",
+		nil,
+	)
+	s.Ctx.Params = gin.Params{
+		gin.Param{Key: "id", Value: userId},
+	}
+	session, err := gothic.Store.New(s.Ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		panic(err)
+	}
+	session.Values["provider_user_id"] = "adminprovideruser"
+	session.Values["email"] = "adminprovideruser@example.com"
+	if err := session.Save(s.Ctx.Request, s.Ctx.Writer); err != nil {
+		panic(err)
+	}
+	s.Ctx.Request.Header.Set("Content-Type", "application/json")
+	s.Ctx.Request.Header.Set("Cookie", s.Ctx.Writer.Header().Get("Set-Cookie"))
+	s.Ctx.Request.Body = io.NopCloser(bytes.NewBufferString(body))
+}
+
+func (s *GrantRoleTestSuite) TestGrantRole() {
+	s.newRequest(s.UserUuid.String(), `{"role":"editor"}`)
+
+	GrantRole(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusAccepted, s.W.Code)
+	assert.Equal(s.T(), `{"message":"Role granted"}`, s.W.Body.String())
+	count := test.GetCommandCount("GrantRoleCommand")
+	assert.Equal(s.T(), 1, count)
+}
+
+func (s *GrantRoleTestSuite) TestGrantRoleInvalidRole() {
+	s.newRequest(s.UserUuid.String(), `{"role":"owner"}`)
+
+	GrantRole(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusBadRequest, s.W.Code)
+	count := test.GetCommandCount("GrantRoleCommand")
+	assert.Equal(s.T(), 0, count)
+}
+
+func (s *GrantRoleTestSuite) TestGrantRoleUserNotFound() {
+	s.newRequest(uuid.New().String(), `{"role":"editor"}`)
+
+	GrantRole(s.Ctx, s.CommandBus, s.QueryBus)
+
+	assert.Equal(s.T(), http.StatusNotFound, s.W.Code)
+	assert.Equal(s.T(), `{"error":"User not found"}`, s.W.Body.String())
+}
+
+func TestGrantRoleTestSuite(t *testing.T) {
+	suite.Run(t, new(GrantRoleTestSuite))
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	user_command "main/internal/Application/Command/User"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"net/http"
+	"slices"
+
+	"github.com/ThreeDotsLabs/watermill/components/cqrs"
+	"github.com/gin-gonic/gin"
+)
+
+// RevokeRole takes a role away from a user on behalf of an admin. Admins
+// cannot revoke their own admin role.
+func RevokeRole(ctx *gin.Context, commandBus *cqrs.CommandBus, queryBus query_bus.QueryBus) {
+	role := entity.Role(ctx.Param("role"))
+	if !role.IsValid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": entity.ErrInvalidRole.Error()})
+		return
+	}
+
+	userView, currentUser, ok := findManagedUser(ctx, queryBus)
+	if !ok {
+		return
+	}
+
+	if role == entity.RoleAdmin && userView.Id == currentUser.Id {
+		ctx.JSON(http.StatusConflict, gin.H{"error": entity.ErrCannotRevokeOwnAdminRole.Error()})
+		return
+	}
+	if !slices.Contains(userView.Roles, string(role)) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": entity.ErrRoleNotGranted.Error()})
+		return
+	}
+
+	command := user_command.NewRevokeRoleCommand(userView.Id, string(role), currentUser.Id)
+
+	commandBus.Send(ctx.Request.Context(), command)
+
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "Role revoked"})
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	entity "main/internal/Domain/Entity"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	session "main/internal/UserInterface/Api/Session"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission lets the request through when one of the roles of the
+// current user grants the permission. Checks that depend on the resource,
+// e.g. being the author of a post, are left to the handlers.
+func RequirePermission(queryBus query_bus.QueryBus, permission entity.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userView, err := session.GetCurrentUser(ctx, queryBus)
+		if errors.Is(err, session.ErrUserNotAuthenticated) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if !authorization.Can(userView, permission) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "You are not authorized to perform this action"})
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,5 @@
+package request
+
+type AddPostToSeriesRequest struct {
+	PostId string `json:"post_id" binding:"required,uuid"`
+}
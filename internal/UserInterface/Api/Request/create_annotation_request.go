@@ -0,0 +1,11 @@
+package request
+
+// CreateAnnotationRequest anchors an annotation to the characters Start to
+// End, exclusive, of the post content. Quote must repeat them.
+type CreateAnnotationRequest struct {
+	Id    string `binding:"required,uuid"`
+	Start *int   `binding:"required,min=0"`
+	End   int    `binding:"required,min=1"`
+	Quote string `binding:"required"`
+	Body  string `binding:"required,min=1,max=5000"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type CreateCommentRequest struct {
+	Id       string  `binding:"required,uuid"`
+	ParentId *string `json:"parent_id" binding:"omitempty,uuid"`
+	Content  string  `binding:"required,min=1,max=5000"`
+}
@@ -1,8 +1,19 @@
 package request
 
+import "time"
+
 type CreatePostRequest struct {
-	Id      string `binding:"required,uuid"`
-	Slug    string `binding:"required,min=3,max=255,alphanum"`
-	Title   string `binding:"required,min=3,max=255"`
-	Content string `binding:"required,min=10,max=10000"`
+	Id              string     `binding:"required,uuid"`
+	Slug            string     `binding:"required,min=3,max=255,alphanum"`
+	Title           string     `binding:"required,min=3,max=255"`
+	Content         string     `binding:"required,min=10,max=10000"`
+	ContentFormat   string     `json:"content_format" binding:"omitempty,oneof=markdown html plain"`
+	Excerpt         string     `binding:"omitempty,max=280"`
+	PublishAt       *time.Time `json:"publish_at" binding:"omitempty,gt"`
+	Tags            []string   `binding:"omitempty,max=10,dive,min=2,max=32"`
+	CoverMediaId    string     `json:"cover_media_id" binding:"omitempty,uuid"`
+	SeoTitle        string     `json:"seo_title" binding:"omitempty,max=70"`
+	MetaDescription string     `json:"meta_description" binding:"omitempty,max=160"`
+	CanonicalURL    string     `json:"canonical_url" binding:"omitempty,http_url,max=2048"`
+	CategoryId      string     `json:"category_id" binding:"omitempty,uuid"`
 }
@@ -0,0 +1,7 @@
+package request
+
+type CreateSeriesRequest struct {
+	Id          string `binding:"required,uuid"`
+	Title       string `binding:"required,min=1,max=200"`
+	Description string `binding:"max=2000"`
+}
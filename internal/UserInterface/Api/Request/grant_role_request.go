@@ -0,0 +1,5 @@
+package request
+
+type GrantRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin editor author reader"`
+}
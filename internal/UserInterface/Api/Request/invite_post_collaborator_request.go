@@ -0,0 +1,6 @@
+package request
+
+type InvitePostCollaboratorRequest struct {
+	UserId string `json:"user_id" binding:"required,uuid"`
+	Role   string `binding:"required,oneof=co-author editor reviewer"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type ReorderSeriesPostsRequest struct {
+	PostIds []string `json:"post_ids" binding:"required,min=1,dive,uuid"`
+}
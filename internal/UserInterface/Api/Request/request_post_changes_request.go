@@ -0,0 +1,5 @@
+package request
+
+type RequestPostChangesRequest struct {
+	Notes string `binding:"required,min=1,max=5000"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type UpdateCategoryRequest struct {
+	ParentId *string `json:"parent_id" binding:"omitempty,uuid"`
+	Name     string  `binding:"required,min=1,max=100"`
+	Slug     string  `binding:"required,min=2,max=100,alphanum"`
+}
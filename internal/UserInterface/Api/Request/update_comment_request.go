@@ -0,0 +1,5 @@
+package request
+
+type UpdateCommentRequest struct {
+	Content string `binding:"required,min=1,max=5000"`
+}
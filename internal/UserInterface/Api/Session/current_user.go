@@ -0,0 +1,53 @@
+package session
+
+import (
+	"errors"
+	authorization "main/internal/Application/Authorization"
+	user_query "main/internal/Application/Query/User"
+	view "main/internal/Application/View"
+	query_bus "main/internal/Infrastructure/QueryBus"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/markbates/goth/gothic"
+)
+
+var ErrUserNotAuthenticated = errors.New("User not authenticated")
+
+// GetCurrentUser resolves the user stored in the OAuth session cookie.
+// ErrUserNotAuthenticated is returned when the session carries no user.
+// The user becomes the actor of the commands sent with the request context.
+func GetCurrentUser(ctx *gin.Context, queryBus query_bus.QueryBus) (view.UserView, error) {
+	session, err := gothic.Store.Get(ctx.Request, os.Getenv("SESSION_NAME"))
+	if err != nil {
+		return view.UserView{}, err
+	}
+
+	providerUserId, ok := session.Values["provider_user_id"].(string)
+	if !ok {
+		return view.UserView{}, ErrUserNotAuthenticated
+	}
+	email, ok := session.Values["email"].(string)
+	if !ok {
+		return view.UserView{}, ErrUserNotAuthenticated
+	}
+
+	user, err := queryBus.Execute(
+		ctx.Request.Context(),
+		user_query.NewFindUserByQuery(providerUserId, email),
+	)
+	if err != nil {
+		return view.UserView{}, err
+	}
+
+	userView, ok := user.(view.UserView)
+	if !ok {
+		return view.UserView{}, errors.New("Invalid user data")
+	}
+
+	ctx.Request = ctx.Request.WithContext(
+		authorization.WithActor(ctx.Request.Context(), authorization.NewUserActor(userView.Id)),
+	)
+
+	return userView, nil
+}